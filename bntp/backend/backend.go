@@ -21,10 +21,14 @@
 package backend
 
 import (
+	"context"
+
 	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
 	"github.com/JonasMuehlmann/bntp.go/internal/marshallers"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+	"github.com/hashicorp/go-multierror"
 )
 
 type Backend struct {
@@ -37,3 +41,28 @@ type Backend struct {
 	Unmarshallers     map[string]marshallers.Unmarshaller
 	DBProviderSchemas map[string]string
 }
+
+// InUnitOfWork calls fn with a context, through which all manager operations take part in one unit of work.
+// The unit of work is committed if fn succeeds and rolled back otherwise.
+// File writes of the DocumentContentManager are staged and only applied on commit.
+// If ctx already carries a unit of work, fn joins it and completing it is left to its creator.
+func (backend *Backend) InUnitOfWork(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := repository.UnitOfWorkFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	unitOfWork := repository.NewUnitOfWork()
+	defer unitOfWork.Rollback()
+
+	err := fn(repository.ContextWithUnitOfWork(ctx, unitOfWork))
+	if err != nil {
+		rollbackErr := unitOfWork.Rollback()
+		if rollbackErr != nil {
+			err = multierror.Append(err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return unitOfWork.Commit()
+}
@@ -0,0 +1,98 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"golang.org/x/exp/slices"
+)
+
+// DocumentGraph returns the link graph of the documents matching filter, or of all documents if filter is nil,
+// with the nodes colored by the tags at colorTagPaths.
+func (backend *Backend) DocumentGraph(ctx context.Context, filter *domain.DocumentFilter, colorTagPaths []string) (*libdocuments.DocumentGraph, error) {
+	// Unknown tags would silently leave nodes uncolored
+	if len(colorTagPaths) > 0 {
+		_, err := backend.TagsFromPaths(ctx, colorTagPaths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var documents []*domain.Document
+	var err error
+
+	if filter == nil {
+		documents, err = backend.DocumentManager.GetAll(ctx)
+	} else {
+		documents, err = backend.DocumentManager.GetWhere(ctx, filter)
+	}
+
+	if err != nil && !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+		return nil, err
+	}
+
+	tagPaths, err := backend.documentTagPaths(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := libdocuments.NewDocumentGraph(documents, tagPaths)
+	graph.ColorByTags(colorTagPaths)
+
+	return graph, nil
+}
+
+// documentTagPaths returns the paths of the tags of documents by their IDs.
+func (backend *Backend) documentTagPaths(ctx context.Context, documents []*domain.Document) (map[int64]string, error) {
+	tagIDs := []int64{}
+
+	for _, document := range documents {
+		for _, tagID := range document.TagIDs {
+			if !slices.Contains(tagIDs, tagID) {
+				tagIDs = append(tagIDs, tagID)
+			}
+		}
+	}
+
+	tagPaths := make(map[int64]string, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return tagPaths, nil
+	}
+
+	tags, err := backend.TagManager.GetFromIDs(ctx, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		tagPaths[tag.ID], err = backend.TagManager.MarshalPath(ctx, tag, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tagPaths, nil
+}
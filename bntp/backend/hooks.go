@@ -0,0 +1,43 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+)
+
+// Hooks registered here can be referenced by name in the hooks configuration of the respective manager.
+// Registration has to happen before the configuration is loaded.
+var (
+	BookmarkHookRegistry        = bntp.NewHookRegistry[domain.Bookmark]()
+	TagHookRegistry             = bntp.NewHookRegistry[domain.Tag]()
+	DocumentHookRegistry        = bntp.NewHookRegistry[domain.Document]()
+	DocumentContentHookRegistry = bntp.NewHookRegistry[string]()
+)
+
+// IsHookRegistered checks if any of the hook registries knows a hook called name.
+func IsHookRegistered(name string) bool {
+	return BookmarkHookRegistry.Has(name) ||
+		TagHookRegistry.Has(name) ||
+		DocumentHookRegistry.Has(name) ||
+		DocumentContentHookRegistry.Has(name)
+}
@@ -0,0 +1,194 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/barweiss/go-tuple"
+	"golang.org/x/exp/slices"
+)
+
+// AddDocumentLinks links the document at sourcePath to the documents at destinationPaths
+// and writes the links and backlinks to the documents' contents in one unit of work.
+func (backend *Backend) AddDocumentLinks(ctx context.Context, sourcePath string, destinationPaths []string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		source, destinations, err := backend.documentLinkEnds(ctx, sourcePath, destinationPaths)
+		if err != nil {
+			return err
+		}
+
+		for _, destination := range destinations {
+			if slices.Contains(source.LinkedDocumentIDs, destination.ID) {
+				return helper.DuplicateInsertionError{}
+			}
+
+			source.LinkedDocumentIDs = append(source.LinkedDocumentIDs, destination.ID)
+		}
+
+		err = backend.DocumentManager.Replace(ctx, []*domain.Document{source})
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentContentManager.AddLinks(ctx, []tuple.T2[string, []string]{{V1: source.Path, V2: destinationPaths}})
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentContentManager.AddBackLinks(ctx, destinationBacklinks(source, destinations))
+	})
+}
+
+// RemoveDocumentLinks unlinks the document at sourcePath from the documents at destinationPaths
+// and removes the links and backlinks from the documents' contents in one unit of work.
+func (backend *Backend) RemoveDocumentLinks(ctx context.Context, sourcePath string, destinationPaths []string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		source, destinations, err := backend.documentLinkEnds(ctx, sourcePath, destinationPaths)
+		if err != nil {
+			return err
+		}
+
+		for _, destination := range destinations {
+			i := slices.Index(source.LinkedDocumentIDs, destination.ID)
+			if i == -1 {
+				return helper.IneffectiveOperationError{Inner: helper.NonExistentDependencyError{}}
+			}
+
+			source.LinkedDocumentIDs = slices.Delete(source.LinkedDocumentIDs, i, i+1)
+		}
+
+		err = backend.DocumentManager.Replace(ctx, []*domain.Document{source})
+		if err != nil {
+			return err
+		}
+
+		// Contents might have been edited by hand, the links table takes precedence
+		err = backend.DocumentContentManager.RemoveLinks(ctx, []tuple.T2[string, []string]{{V1: source.Path, V2: destinationPaths}})
+		if err != nil && !errors.Is(err, libdocuments.EmptyEntitiesListError{}) {
+			return err
+		}
+
+		err = backend.DocumentContentManager.RemoveBackLinks(ctx, destinationBacklinks(source, destinations))
+		if err != nil && !errors.Is(err, libdocuments.EmptyEntitiesListError{}) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// EditDocumentLink changes the destination of the link from the document at sourcePath in one unit of work.
+func (backend *Backend) EditDocumentLink(ctx context.Context, sourcePath string, oldDestinationPath string, newDestinationPath string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		err := backend.RemoveDocumentLinks(ctx, sourcePath, []string{oldDestinationPath})
+		if err != nil {
+			return err
+		}
+
+		return backend.AddDocumentLinks(ctx, sourcePath, []string{newDestinationPath})
+	})
+}
+
+// GetDocumentLinks returns the documents the document at path links to and the documents linking to it.
+// Trashed documents are left out.
+func (backend *Backend) GetDocumentLinks(ctx context.Context, path string) (links []*domain.Document, backlinks []*domain.Document, err error) {
+	document, err := backend.documentFromPath(ctx, path)
+	if err != nil {
+		return
+	}
+
+	links, err = backend.linkedDocuments(ctx, document.LinkedDocumentIDs)
+	if err != nil {
+		return
+	}
+
+	backlinks, err = backend.linkedDocuments(ctx, document.BacklinkedDocumentsIDs)
+
+	return
+}
+
+// linkedDocuments returns the documents with ids, which are not trashed.
+func (backend *Backend) linkedDocuments(ctx context.Context, ids []int64) ([]*domain.Document, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	documents, err := backend.DocumentManager.GetFromIDs(ctx, ids)
+	// All linked documents being trashed is not an error
+	if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+		return nil, nil
+	}
+
+	return documents, err
+}
+
+// documentLinkEnds returns the documents at sourcePath and destinationPaths.
+func (backend *Backend) documentLinkEnds(ctx context.Context, sourcePath string, destinationPaths []string) (source *domain.Document, destinations []*domain.Document, err error) {
+	if len(destinationPaths) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+
+		return
+	}
+
+	if slices.Contains(destinationPaths, "") {
+		err = helper.NilInputError{}
+
+		return
+	}
+
+	source, err = backend.documentFromPath(ctx, sourcePath)
+	if err != nil {
+		return
+	}
+
+	destinations, err = backend.DocumentManager.GetWhere(ctx, &domain.DocumentFilter{
+		Path: optional.Make(model.FilterOperation[string]{
+			Operator: model.FilterIn,
+			Operand:  model.ListOperand[string]{Operands: destinationPaths},
+		}),
+	})
+	if err != nil {
+		return
+	}
+
+	if len(destinations) != len(destinationPaths) {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentDependencyError{}}
+	}
+
+	return
+}
+
+// destinationBacklinks returns the backlink to source for each of destinations.
+func destinationBacklinks(source *domain.Document, destinations []*domain.Document) []tuple.T2[string, []string] {
+	pathBacklinks, _ := goaoi.TransformCopySliceUnsafe(destinations, func(destination *domain.Document) tuple.T2[string, []string] {
+		return tuple.T2[string, []string]{V1: destination.Path, V2: []string{source.Path}}
+	})
+
+	return pathBacklinks
+}
@@ -0,0 +1,184 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/barweiss/go-tuple"
+	"golang.org/x/exp/slices"
+)
+
+// MoveDocument moves the document at oldPath and its content to newPath in one unit of work.
+// The relative links in the moved document and the links to it in the documents it links to or is linked from are rewritten,
+// numAffectedRecords is the number of rewritten other documents.
+// Documents linking to it are found through the links table and by parsing the contents, which might not be synced yet.
+func (backend *Backend) MoveDocument(ctx context.Context, oldPath string, newPath string) (numAffectedRecords int64, err error) {
+	if oldPath == "" || newPath == "" {
+		return 0, helper.NilInputError{}
+	}
+
+	oldPath = filepath.Clean(oldPath)
+	newPath = filepath.Clean(newPath)
+
+	if oldPath == newPath {
+		return 0, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	err = backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		document, err := backend.documentFromPath(ctx, oldPath)
+		if err != nil {
+			return err
+		}
+
+		doesExist, err := backend.DocumentManager.DoesExistWhere(ctx, documentPathFilter(newPath))
+		if err != nil {
+			return err
+		}
+		if doesExist {
+			return helper.DuplicateInsertionError{}
+		}
+
+		document.Path = newPath
+
+		err = backend.DocumentManager.Replace(ctx, []*domain.Document{document})
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentContentManager.Move(ctx, []tuple.T2[string, string]{{V1: oldPath, V2: newPath}})
+		if err != nil {
+			return err
+		}
+
+		pathChanges := map[string]string{oldPath: newPath}
+
+		_, err = backend.rewriteDocumentLinks(ctx, oldPath, newPath, pathChanges)
+		if err != nil {
+			return err
+		}
+
+		// Linked documents list the moved document in their backlinks, backlinked ones in their links
+		referencingIDs := []int64{}
+		for _, id := range append(slices.Clone(document.LinkedDocumentIDs), document.BacklinkedDocumentsIDs...) {
+			if id != document.ID && !slices.Contains(referencingIDs, id) {
+				referencingIDs = append(referencingIDs, id)
+			}
+		}
+
+		referencingPaths := []string{}
+
+		if len(referencingIDs) > 0 {
+			referencingDocuments, err := backend.DocumentManager.GetFromIDs(ctx, referencingIDs)
+			if err != nil && !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+				return err
+			}
+
+			for _, referencingDocument := range referencingDocuments {
+				referencingPaths = append(referencingPaths, referencingDocument.Path)
+			}
+		}
+
+		// The links table might be stale, so the contents are searched for links to the moved document as well
+		linkingPaths, err := backend.documentsLinkingTo(ctx, oldPath, document.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, linkingPath := range linkingPaths {
+			if !slices.Contains(referencingPaths, linkingPath) {
+				referencingPaths = append(referencingPaths, linkingPath)
+			}
+		}
+
+		for _, referencingPath := range referencingPaths {
+			isRewritten, err := backend.rewriteDocumentLinks(ctx, referencingPath, referencingPath, pathChanges)
+			if err != nil {
+				return err
+			}
+
+			if isRewritten {
+				numAffectedRecords++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		numAffectedRecords = 0
+	}
+
+	return
+}
+
+// documentsLinkingTo returns the paths of the documents except the one with excludedID, whose contents link to path.
+// Documents whose contents can not be read are skipped.
+func (backend *Backend) documentsLinkingTo(ctx context.Context, path string, excludedID int64) ([]string, error) {
+	documents, err := backend.DocumentManager.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := []string{}
+
+	for _, document := range documents {
+		if document.ID == excludedID {
+			continue
+		}
+
+		contents, err := backend.DocumentContentManager.Get(ctx, []string{document.Path})
+		if err != nil {
+			backend.DocumentContentManager.Logger.Warnf("Skipping document %v while searching links to %v: %v", document.Path, path, err)
+
+			continue
+		}
+
+		if slices.Contains(libdocuments.ResolveLinks(document.Path, libdocuments.ParseLinks(contents[0])), path) {
+			paths = append(paths, document.Path)
+		}
+	}
+
+	return paths, nil
+}
+
+// rewriteDocumentLinks rewrites the links in the content of the document moved from oldPath to newPath
+// for the documents moved according to pathChanges and reports whether the content changed.
+// Documents without content are skipped, since there is nothing to rewrite.
+func (backend *Backend) rewriteDocumentLinks(ctx context.Context, oldPath string, newPath string, pathChanges map[string]string) (bool, error) {
+	contents, err := backend.DocumentContentManager.Get(ctx, []string{newPath})
+	if err != nil {
+		backend.DocumentContentManager.Logger.Warnf("Skipping rewriting links of document %v: %v", newPath, err)
+
+		return false, nil
+	}
+
+	newContent := libdocuments.RewriteLinks(contents[0], oldPath, newPath, pathChanges)
+	if newContent == contents[0] {
+		return false, nil
+	}
+
+	return true, backend.DocumentContentManager.Update(ctx, []tuple.T2[string, string]{{V1: newPath, V2: newContent}})
+}
@@ -0,0 +1,547 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/barweiss/go-tuple"
+	"golang.org/x/exp/slices"
+)
+
+// RenameTag renames the tag at tagPath to newName in one unit of work.
+// The "# Tags" lines of the documents tagged with the tag or its descendants are rewritten,
+// numAffectedRecords is the number of documents and bookmarks tagged with them.
+func (backend *Backend) RenameTag(ctx context.Context, tagPath string, newName string) (numAffectedRecords int64, err error) {
+	if tagPath == "" || newName == "" {
+		return 0, helper.NilInputError{}
+	}
+	if strings.Contains(newName, libtags.PathSeparator) {
+		return 0, libtags.InvalidTagNameError{Name: newName}
+	}
+
+	return backend.changeTagSubtree(ctx, tagPath, func(ctx context.Context, subtree []*domain.Tag) (map[int64]int64, error) {
+		tag := subtree[0]
+
+		if tag.Tag == newName {
+			return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+		}
+
+		doesExist, err := backend.doesTagExist(ctx, tag.ParentPathIDs, newName)
+		if err != nil {
+			return nil, err
+		}
+		if doesExist {
+			return nil, helper.DuplicateInsertionError{}
+		}
+
+		tag.Tag = newName
+
+		return nil, backend.TagManager.Replace(ctx, []*domain.Tag{tag})
+	})
+}
+
+// MoveTag moves the tag at tagPath and its descendants below the tag at newParentPath in one unit of work,
+// the tag becomes a root tag if newParentPath is empty.
+// The "# Tags" lines of the documents tagged with the tag or its descendants are rewritten,
+// numAffectedRecords is the number of documents and bookmarks tagged with them.
+func (backend *Backend) MoveTag(ctx context.Context, tagPath string, newParentPath string) (numAffectedRecords int64, err error) {
+	if tagPath == "" {
+		return 0, helper.NilInputError{}
+	}
+
+	return backend.changeTagSubtree(ctx, tagPath, func(ctx context.Context, subtree []*domain.Tag) (map[int64]int64, error) {
+		tag := subtree[0]
+		parentPathIDs := []int64{}
+
+		var newParent *domain.Tag
+
+		if newParentPath != "" {
+			var err error
+
+			newParent, err = backend.TagManager.UnmarshalPath(ctx, newParentPath)
+			if err != nil {
+				return nil, err
+			}
+
+			if newParent.ID == tag.ID || slices.Contains(newParent.ParentPathIDs, tag.ID) {
+				return nil, libtags.InvalidTagParentError{Path: tagPath, ParentPath: newParentPath}
+			}
+
+			parentPathIDs = append(slices.Clone(newParent.ParentPathIDs), newParent.ID)
+		}
+
+		if slices.Equal(tag.ParentPathIDs, parentPathIDs) {
+			return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+		}
+
+		doesExist, err := backend.doesTagExist(ctx, parentPathIDs, tag.Tag)
+		if err != nil {
+			return nil, err
+		}
+		if doesExist {
+			return nil, helper.DuplicateInsertionError{}
+		}
+
+		err = backend.removeSubtag(ctx, tag)
+		if err != nil {
+			return nil, err
+		}
+
+		err = backend.reparentTag(ctx, subtree, parentPathIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		if newParent == nil {
+			return nil, nil
+		}
+
+		newParent.SubtagIDs = append(slices.Clone(newParent.SubtagIDs), tag.ID)
+
+		return nil, backend.TagManager.Replace(ctx, []*domain.Tag{newParent})
+	})
+}
+
+// MergeTag merges the tag at sourcePath into the tag at destinationPath in one unit of work.
+// The children of the source tag are moved below the destination tag and merged with its children of the same name,
+// the documents and bookmarks tagged with a merged tag are tagged with the one it was merged into instead.
+// The "# Tags" lines of the documents tagged with the source tag or its descendants are rewritten,
+// numAffectedRecords is the number of documents and bookmarks tagged with them.
+func (backend *Backend) MergeTag(ctx context.Context, sourcePath string, destinationPath string) (numAffectedRecords int64, err error) {
+	if sourcePath == "" || destinationPath == "" {
+		return 0, helper.NilInputError{}
+	}
+
+	return backend.changeTagSubtree(ctx, sourcePath, func(ctx context.Context, subtree []*domain.Tag) (map[int64]int64, error) {
+		source := subtree[0]
+
+		destination, err := backend.TagManager.UnmarshalPath(ctx, destinationPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if destination.ID == source.ID || slices.Contains(destination.ParentPathIDs, source.ID) {
+			return nil, libtags.InvalidTagParentError{Path: sourcePath, ParentPath: destinationPath}
+		}
+
+		tagIDChanges := make(map[int64]int64, len(subtree))
+
+		err = backend.mergeTag(ctx, source, destination, tagIDChanges)
+		if err != nil {
+			return nil, err
+		}
+
+		// The destination might be the parent of the source, so it has to be removed afterwards
+		return tagIDChanges, backend.removeSubtag(ctx, source)
+	})
+}
+
+// changeTagSubtree runs change on the tag at tagPath and its descendants in one unit of work, parents come before their children.
+// change returns the IDs of the tags merged into other tags and the IDs of the tags they were merged into.
+// The documents and bookmarks tagged with a merged tag are repointed before it is deleted and the "# Tags" lines
+// of the documents tagged with the changed tags are rewritten to their new paths.
+func (backend *Backend) changeTagSubtree(ctx context.Context, tagPath string, change func(ctx context.Context, subtree []*domain.Tag) (map[int64]int64, error)) (numAffectedRecords int64, err error) {
+	err = backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		tag, err := backend.TagManager.UnmarshalPath(ctx, tagPath)
+		if err != nil {
+			return err
+		}
+
+		subtree, err := backend.tagSubtree(ctx, tag)
+		if err != nil {
+			return err
+		}
+
+		subtreeIDs := make([]int64, 0, len(subtree))
+		oldTagPaths := make(map[int64]string, len(subtree))
+
+		for _, subtag := range subtree {
+			subtreeIDs = append(subtreeIDs, subtag.ID)
+
+			oldTagPaths[subtag.ID], err = backend.TagManager.MarshalPath(ctx, subtag, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		documents, err := backend.taggedDocuments(ctx, subtreeIDs)
+		if err != nil {
+			return err
+		}
+
+		bookmarks, err := backend.taggedBookmarks(ctx, subtreeIDs)
+		if err != nil {
+			return err
+		}
+
+		tagIDChanges, err := change(ctx, subtree)
+		if err != nil {
+			return err
+		}
+
+		if len(tagIDChanges) > 0 {
+			err = backend.repointTags(ctx, documents, bookmarks, tagIDChanges)
+			if err != nil {
+				return err
+			}
+
+			mergedTags := []*domain.Tag{}
+			for _, subtag := range subtree {
+				if _, ok := tagIDChanges[subtag.ID]; ok {
+					mergedTags = append(mergedTags, subtag)
+				}
+			}
+
+			err = backend.TagManager.Delete(ctx, mergedTags)
+			if err != nil {
+				return err
+			}
+		}
+
+		newTagIDs := make([]int64, 0, len(subtree))
+		for _, id := range subtreeIDs {
+			if newID, ok := tagIDChanges[id]; ok {
+				id = newID
+			}
+
+			if !slices.Contains(newTagIDs, id) {
+				newTagIDs = append(newTagIDs, id)
+			}
+		}
+
+		newTags, err := backend.TagManager.GetFromIDs(ctx, newTagIDs)
+		if err != nil {
+			return err
+		}
+
+		newTagPaths := make(map[int64]string, len(newTags))
+		for _, newTag := range newTags {
+			newTagPaths[newTag.ID], err = backend.TagManager.MarshalPath(ctx, newTag, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		tagPathChanges := make(map[string]string, len(subtree))
+		for _, id := range subtreeIDs {
+			newID := id
+			if mergedID, ok := tagIDChanges[id]; ok {
+				newID = mergedID
+			}
+
+			if oldTagPaths[id] != newTagPaths[newID] {
+				tagPathChanges[oldTagPaths[id]] = newTagPaths[newID]
+			}
+		}
+
+		for _, document := range documents {
+			err = backend.rewriteDocumentTags(ctx, document.Path, tagPathChanges)
+			if err != nil {
+				return err
+			}
+		}
+
+		numAffectedRecords = int64(len(documents) + len(bookmarks))
+
+		return nil
+	})
+	if err != nil {
+		numAffectedRecords = 0
+	}
+
+	return
+}
+
+// mergeTag merges source into destination, recording the merged tags in tagIDChanges.
+// The children of source are moved below destination or merged into its children of the same name.
+func (backend *Backend) mergeTag(ctx context.Context, source *domain.Tag, destination *domain.Tag, tagIDChanges map[int64]int64) error {
+	tagIDChanges[source.ID] = destination.ID
+
+	if len(source.SubtagIDs) == 0 {
+		return nil
+	}
+
+	children, err := backend.TagManager.GetFromIDs(ctx, source.SubtagIDs)
+	if err != nil {
+		return err
+	}
+
+	destinationChildren := []*domain.Tag{}
+	if len(destination.SubtagIDs) > 0 {
+		destinationChildren, err = backend.TagManager.GetFromIDs(ctx, destination.SubtagIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	destination.SubtagIDs = slices.Clone(destination.SubtagIDs)
+
+	for _, child := range children {
+		i := slices.IndexFunc(destinationChildren, func(destinationChild *domain.Tag) bool { return destinationChild.Tag == child.Tag })
+		if i != -1 {
+			err = backend.mergeTag(ctx, child, destinationChildren[i], tagIDChanges)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		childSubtree, err := backend.tagSubtree(ctx, child)
+		if err != nil {
+			return err
+		}
+
+		err = backend.reparentTag(ctx, childSubtree, append(slices.Clone(destination.ParentPathIDs), destination.ID))
+		if err != nil {
+			return err
+		}
+
+		destination.SubtagIDs = append(destination.SubtagIDs, child.ID)
+	}
+
+	return backend.TagManager.Replace(ctx, []*domain.Tag{destination})
+}
+
+// tagSubtree returns tag and its descendants, parents come before their children.
+func (backend *Backend) tagSubtree(ctx context.Context, tag *domain.Tag) ([]*domain.Tag, error) {
+	subtree := []*domain.Tag{tag}
+
+	for i := 0; i < len(subtree); i++ {
+		if len(subtree[i].SubtagIDs) == 0 {
+			continue
+		}
+
+		children, err := backend.TagManager.GetFromIDs(ctx, subtree[i].SubtagIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		subtree = append(subtree, children...)
+	}
+
+	return subtree, nil
+}
+
+// reparentTag places the first tag of subtree below the tag at the end of parentPathIDs and updates the paths of its descendants.
+// The subtags of the old and new parent are not updated.
+func (backend *Backend) reparentTag(ctx context.Context, subtree []*domain.Tag, parentPathIDs []int64) error {
+	oldDepth := len(subtree[0].ParentPathIDs)
+
+	for _, subtag := range subtree {
+		subtag.ParentPathIDs = append(slices.Clone(parentPathIDs), subtag.ParentPathIDs[oldDepth:]...)
+	}
+
+	return backend.TagManager.Replace(ctx, subtree)
+}
+
+// removeSubtag removes tag from the subtags of its parent, if it has one.
+func (backend *Backend) removeSubtag(ctx context.Context, tag *domain.Tag) error {
+	if len(tag.ParentPathIDs) == 0 {
+		return nil
+	}
+
+	parents, err := backend.TagManager.GetFromIDs(ctx, tag.ParentPathIDs[len(tag.ParentPathIDs)-1:])
+	if err != nil {
+		return err
+	}
+
+	parent := parents[0]
+	parent.SubtagIDs = slices.Clone(parent.SubtagIDs)
+
+	i := slices.Index(parent.SubtagIDs, tag.ID)
+	if i != -1 {
+		parent.SubtagIDs = slices.Delete(parent.SubtagIDs, i, i+1)
+	}
+
+	return backend.TagManager.Replace(ctx, []*domain.Tag{parent})
+}
+
+// doesTagExist reports whether a tag called name exists below the tag at the end of parentPathIDs.
+func (backend *Backend) doesTagExist(ctx context.Context, parentPathIDs []int64, name string) (bool, error) {
+	filter := &domain.TagFilter{Tag: optional.Make(model.FilterOperation[string]{
+		Operator: model.FilterEqual,
+		Operand:  model.ScalarOperand[string]{Operand: name},
+	})}
+
+	doesExist, err := backend.TagManager.DoesExistWhere(ctx, filter)
+	if err != nil || !doesExist {
+		return false, err
+	}
+
+	// Tag names are not unique, only their paths are
+	candidates, err := backend.TagManager.GetWhere(ctx, filter)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.IndexFunc(candidates, func(candidate *domain.Tag) bool { return slices.Equal(candidate.ParentPathIDs, parentPathIDs) }) != -1, nil
+}
+
+// taggedDocuments returns the documents, including trashed ones, tagged with any of the tags with tagIDs.
+func (backend *Backend) taggedDocuments(ctx context.Context, tagIDs []int64) ([]*domain.Document, error) {
+	filter := &domain.DocumentFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+		Operator: model.FilterContainsAny,
+		Operand:  model.ListOperand[int64]{Operands: tagIDs},
+	})}
+
+	documents := []*domain.Document{}
+
+	for _, filter := range []*domain.DocumentFilter{filter, libdocuments.TrashedFilter(filter)} {
+		doesExist, err := backend.DocumentManager.DoesExistWhere(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if !doesExist {
+			continue
+		}
+
+		filteredDocuments, err := backend.DocumentManager.GetWhere(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, filteredDocuments...)
+	}
+
+	return documents, nil
+}
+
+// taggedBookmarks returns the bookmarks, including trashed ones, tagged with any of the tags with tagIDs.
+func (backend *Backend) taggedBookmarks(ctx context.Context, tagIDs []int64) ([]*domain.Bookmark, error) {
+	filter := &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+		Operator: model.FilterContainsAny,
+		Operand:  model.ListOperand[int64]{Operands: tagIDs},
+	})}
+
+	bookmarks := []*domain.Bookmark{}
+
+	for _, filter := range []*domain.BookmarkFilter{filter, libbookmarks.TrashedFilter(filter)} {
+		doesExist, err := backend.BookmarkManager.DoesExistWhere(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if !doesExist {
+			continue
+		}
+
+		filteredBookmarks, err := backend.BookmarkManager.GetWhere(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		bookmarks = append(bookmarks, filteredBookmarks...)
+	}
+
+	return bookmarks, nil
+}
+
+// repointTags replaces the IDs of merged tags in the tags of documents and bookmarks with the IDs of the tags they were merged into.
+func (backend *Backend) repointTags(ctx context.Context, documents []*domain.Document, bookmarks []*domain.Bookmark, tagIDChanges map[int64]int64) error {
+	repointedDocuments := []*domain.Document{}
+	for _, document := range documents {
+		var isRepointed bool
+
+		document.TagIDs, isRepointed = repointTagIDs(document.TagIDs, tagIDChanges)
+		if isRepointed {
+			repointedDocuments = append(repointedDocuments, document)
+		}
+	}
+
+	if len(repointedDocuments) > 0 {
+		err := backend.DocumentManager.Replace(ctx, repointedDocuments)
+		if err != nil {
+			return err
+		}
+	}
+
+	repointedBookmarks := []*domain.Bookmark{}
+	for _, bookmark := range bookmarks {
+		var isRepointed bool
+
+		bookmark.TagIDs, isRepointed = repointTagIDs(bookmark.TagIDs, tagIDChanges)
+		if isRepointed {
+			repointedBookmarks = append(repointedBookmarks, bookmark)
+		}
+	}
+
+	if len(repointedBookmarks) > 0 {
+		return backend.BookmarkManager.Replace(ctx, repointedBookmarks)
+	}
+
+	return nil
+}
+
+// repointTagIDs returns tagIDs with the IDs in tagIDChanges replaced and whether any were replaced.
+func repointTagIDs(tagIDs []int64, tagIDChanges map[int64]int64) ([]int64, bool) {
+	newTagIDs := make([]int64, 0, len(tagIDs))
+	isRepointed := false
+
+	for _, id := range tagIDs {
+		if newID, ok := tagIDChanges[id]; ok {
+			id = newID
+			isRepointed = true
+		}
+
+		if !slices.Contains(newTagIDs, id) {
+			newTagIDs = append(newTagIDs, id)
+		}
+	}
+
+	return newTagIDs, isRepointed
+}
+
+// rewriteDocumentTags rewrites the "# Tags" line of the document at path according to tagChanges.
+// Documents without content or "# Tags" heading are skipped, since there is nothing to rewrite.
+func (backend *Backend) rewriteDocumentTags(ctx context.Context, path string, tagChanges map[string]string) error {
+	if len(tagChanges) == 0 {
+		return nil
+	}
+
+	contents, err := backend.DocumentContentManager.Get(ctx, []string{path})
+	if err != nil {
+		backend.DocumentContentManager.Logger.Warnf("Skipping rewriting tags of document %v: %v", path, err)
+
+		return nil
+	}
+
+	newContent, err := libdocuments.RenameTags(contents[0], tagChanges)
+	if errors.Is(err, libdocuments.DocumentSyntaxError{}) {
+		backend.DocumentContentManager.Logger.Warnf("Skipping rewriting tags of document %v: %v", path, err)
+
+		return nil
+	}
+	if err != nil || newContent == contents[0] {
+		return err
+	}
+
+	return backend.DocumentContentManager.Update(ctx, []tuple.T2[string, string]{{V1: path, V2: newContent}})
+}
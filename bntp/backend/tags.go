@@ -0,0 +1,287 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/barweiss/go-tuple"
+	"golang.org/x/exp/slices"
+)
+
+// AddDocumentTags tags the document at path with the tags at tagPaths
+// and writes the tag paths to the document's "# Tags" line in one unit of work.
+func (backend *Backend) AddDocumentTags(ctx context.Context, path string, tagPaths []string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		document, err := backend.documentFromPath(ctx, path)
+		if err != nil {
+			return err
+		}
+
+		tags, err := backend.TagsFromPaths(ctx, tagPaths)
+		if err != nil {
+			return err
+		}
+
+		document.TagIDs, err = addTagIDs(document.TagIDs, tags)
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentManager.Replace(ctx, []*domain.Document{document})
+		if err != nil {
+			return err
+		}
+
+		// Shortened paths are written as full paths, since they might become ambiguous later on
+		fullTagPaths, err := backend.tagPaths(ctx, tags)
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentContentManager.AddTags(ctx, []tuple.T2[string, []string]{{V1: document.Path, V2: fullTagPaths}})
+	})
+}
+
+// RemoveDocumentTags removes the tags at tagPaths from the document at path
+// and from the document's "# Tags" line in one unit of work.
+func (backend *Backend) RemoveDocumentTags(ctx context.Context, path string, tagPaths []string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		document, err := backend.documentFromPath(ctx, path)
+		if err != nil {
+			return err
+		}
+
+		tags, err := backend.TagsFromPaths(ctx, tagPaths)
+		if err != nil {
+			return err
+		}
+
+		document.TagIDs, err = removeTagIDs(document.TagIDs, tags)
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentManager.Replace(ctx, []*domain.Document{document})
+		if err != nil {
+			return err
+		}
+
+		fullTagPaths, err := backend.tagPaths(ctx, tags)
+		if err != nil {
+			return err
+		}
+
+		for _, tagPath := range tagPaths {
+			if !slices.Contains(fullTagPaths, tagPath) {
+				fullTagPaths = append(fullTagPaths, tagPath)
+			}
+		}
+
+		// Contents might have been edited by hand, the document contexts take precedence
+		err = backend.DocumentContentManager.RemoveTags(ctx, []tuple.T2[string, []string]{{V1: document.Path, V2: fullTagPaths}})
+		if err != nil && !errors.Is(err, libdocuments.EmptyEntitiesListError{}) {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// EditDocumentTag replaces the tag at oldTagPath of the document at path with the tag at newTagPath in one unit of work.
+func (backend *Backend) EditDocumentTag(ctx context.Context, path string, oldTagPath string, newTagPath string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		err := backend.RemoveDocumentTags(ctx, path, []string{oldTagPath})
+		if err != nil {
+			return err
+		}
+
+		return backend.AddDocumentTags(ctx, path, []string{newTagPath})
+	})
+}
+
+// GetDocumentTags returns the tags of the document at path.
+func (backend *Backend) GetDocumentTags(ctx context.Context, path string) ([]*domain.Tag, error) {
+	document, err := backend.documentFromPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(document.TagIDs) == 0 {
+		return []*domain.Tag{}, nil
+	}
+
+	return backend.TagManager.GetFromIDs(ctx, document.TagIDs)
+}
+
+// AddBookmarkTags tags the bookmark with the given url with the tags at tagPaths.
+func (backend *Backend) AddBookmarkTags(ctx context.Context, url string, tagPaths []string) error {
+	bookmark, err := backend.bookmarkFromURL(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	tags, err := backend.TagsFromPaths(ctx, tagPaths)
+	if err != nil {
+		return err
+	}
+
+	bookmark.TagIDs, err = addTagIDs(bookmark.TagIDs, tags)
+	if err != nil {
+		return err
+	}
+
+	return backend.BookmarkManager.Replace(ctx, []*domain.Bookmark{bookmark})
+}
+
+// RemoveBookmarkTags removes the tags at tagPaths from the bookmark with the given url.
+func (backend *Backend) RemoveBookmarkTags(ctx context.Context, url string, tagPaths []string) error {
+	bookmark, err := backend.bookmarkFromURL(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	tags, err := backend.TagsFromPaths(ctx, tagPaths)
+	if err != nil {
+		return err
+	}
+
+	bookmark.TagIDs, err = removeTagIDs(bookmark.TagIDs, tags)
+	if err != nil {
+		return err
+	}
+
+	return backend.BookmarkManager.Replace(ctx, []*domain.Bookmark{bookmark})
+}
+
+// EditBookmarkTag replaces the tag at oldTagPath of the bookmark with the given url with the tag at newTagPath.
+func (backend *Backend) EditBookmarkTag(ctx context.Context, url string, oldTagPath string, newTagPath string) error {
+	bookmark, err := backend.bookmarkFromURL(ctx, url)
+	if err != nil {
+		return err
+	}
+
+	tags, err := backend.TagsFromPaths(ctx, []string{oldTagPath, newTagPath})
+	if err != nil {
+		return err
+	}
+
+	bookmark.TagIDs, err = removeTagIDs(bookmark.TagIDs, tags[:1])
+	if err != nil {
+		return err
+	}
+
+	bookmark.TagIDs, err = addTagIDs(bookmark.TagIDs, tags[1:])
+	if err != nil {
+		return err
+	}
+
+	return backend.BookmarkManager.Replace(ctx, []*domain.Bookmark{bookmark})
+}
+
+// GetBookmarkTags returns the tags of the bookmark with the given url.
+func (backend *Backend) GetBookmarkTags(ctx context.Context, url string) ([]*domain.Tag, error) {
+	bookmark, err := backend.bookmarkFromURL(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bookmark.TagIDs) == 0 {
+		return []*domain.Tag{}, nil
+	}
+
+	return backend.TagManager.GetFromIDs(ctx, bookmark.TagIDs)
+}
+
+// TagsFromPaths returns the tags at tagPaths, which may be shortened, in the same order.
+func (backend *Backend) TagsFromPaths(ctx context.Context, tagPaths []string) ([]*domain.Tag, error) {
+	if len(tagPaths) == 0 {
+		return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	return goaoi.TransformCopySlice(tagPaths, func(tagPath string) (*domain.Tag, error) {
+		return backend.TagManager.UnmarshalPath(ctx, tagPath)
+	})
+}
+
+// tagPaths returns the full paths of tags in the same order.
+func (backend *Backend) tagPaths(ctx context.Context, tags []*domain.Tag) ([]string, error) {
+	return goaoi.TransformCopySlice(tags, func(tag *domain.Tag) (string, error) {
+		return backend.TagManager.MarshalPath(ctx, tag, false)
+	})
+}
+
+// documentFromPath returns the document at path.
+func (backend *Backend) documentFromPath(ctx context.Context, path string) (*domain.Document, error) {
+	if path == "" {
+		return nil, helper.NilInputError{}
+	}
+
+	return backend.DocumentManager.GetFirstWhere(ctx, documentPathFilter(path))
+}
+
+// bookmarkFromURL returns the bookmark with the given url.
+func (backend *Backend) bookmarkFromURL(ctx context.Context, url string) (*domain.Bookmark, error) {
+	if url == "" {
+		return nil, helper.NilInputError{}
+	}
+
+	return backend.BookmarkManager.GetFirstWhere(ctx, &domain.BookmarkFilter{
+		URL: optional.Make(model.FilterOperation[string]{
+			Operator: model.FilterEqual,
+			Operand:  model.ScalarOperand[string]{Operand: url},
+		}),
+	})
+}
+
+// addTagIDs appends the IDs of tags to tagIDs, none of which may be in tagIDs already.
+func addTagIDs(tagIDs []int64, tags []*domain.Tag) ([]int64, error) {
+	for _, tag := range tags {
+		if slices.Contains(tagIDs, tag.ID) {
+			return nil, helper.DuplicateInsertionError{}
+		}
+
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, nil
+}
+
+// removeTagIDs removes the IDs of tags from tagIDs, all of which must be in tagIDs.
+func removeTagIDs(tagIDs []int64, tags []*domain.Tag) ([]int64, error) {
+	for _, tag := range tags {
+		i := slices.Index(tagIDs, tag.ID)
+		if i == -1 {
+			return nil, helper.IneffectiveOperationError{Inner: helper.NonExistentDependencyError{}}
+		}
+
+		tagIDs = slices.Delete(tagIDs, i, i+1)
+	}
+
+	return tagIDs, nil
+}
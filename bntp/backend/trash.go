@@ -0,0 +1,175 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+)
+
+// TrashDocuments moves documents and their contents to the trash in one unit of work.
+func (backend *Backend) TrashDocuments(ctx context.Context, documents []*domain.Document) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		paths, err := backend.documentPaths(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentManager.Delete(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentContentManager.Trash(ctx, paths)
+	})
+}
+
+// TrashDocumentsWhere moves the documents matching documentFilter and their contents to the trash in one unit of work.
+func (backend *Backend) TrashDocumentsWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	err = backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		documents, err := backend.DocumentManager.GetWhere(ctx, documentFilter)
+		// No matching documents is not an error for bulk operations
+		if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		numAffectedRecords = int64(len(documents))
+
+		return backend.TrashDocuments(ctx, documents)
+	})
+
+	return
+}
+
+// RestoreDocuments moves trashed documents and their contents out of the trash in one unit of work.
+func (backend *Backend) RestoreDocuments(ctx context.Context, documents []*domain.Document) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		paths, err := backend.documentPaths(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentManager.Restore(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentContentManager.Restore(ctx, paths)
+	})
+}
+
+// RestoreDocumentsWhere moves the trashed documents matching documentFilter and their contents out of the trash in one unit of work.
+func (backend *Backend) RestoreDocumentsWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	err = backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		documents, err := backend.DocumentManager.GetWhere(ctx, libdocuments.TrashedFilter(documentFilter))
+		// No matching documents is not an error for bulk operations
+		if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		numAffectedRecords = int64(len(documents))
+
+		return backend.RestoreDocuments(ctx, documents)
+	})
+
+	return
+}
+
+// PurgeDocuments permanently deletes documents and their trashed contents in one unit of work.
+func (backend *Backend) PurgeDocuments(ctx context.Context, documents []*domain.Document) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		paths, err := backend.documentPaths(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		err = backend.DocumentManager.Purge(ctx, documents)
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentContentManager.Purge(ctx, paths)
+	})
+}
+
+// PurgeDocumentsWhere permanently deletes the trashed documents matching documentFilter and their contents in one unit of work.
+func (backend *Backend) PurgeDocumentsWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	err = backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		documents, err := backend.DocumentManager.GetWhere(ctx, libdocuments.TrashedFilter(documentFilter))
+		// No matching documents is not an error for bulk operations
+		if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		numAffectedRecords = int64(len(documents))
+
+		return backend.PurgeDocuments(ctx, documents)
+	})
+
+	return
+}
+
+// documentPaths returns the stored paths of documents, which might be incomplete.
+func (backend *Backend) documentPaths(ctx context.Context, documents []*domain.Document) ([]string, error) {
+	if len(documents) == 0 {
+		return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	ids, err := goaoi.TransformCopySlice(documents, func(document *domain.Document) (int64, error) {
+		if document == nil {
+			return 0, helper.NilInputError{}
+		}
+
+		return document.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The documents might be trashed already, e.g. when restoring or purging them
+	storedDocuments, err := backend.DocumentManager.GetWhere(ctx, libdocuments.IncludeTrashed(&domain.DocumentFilter{
+		ID: optional.Make(model.FilterOperation[int64]{
+			Operator: model.FilterIn,
+			Operand:  model.ListOperand[int64]{Operands: ids},
+		}),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return goaoi.TransformCopySliceUnsafe(storedDocuments, (*domain.Document).GetPath)
+}
@@ -0,0 +1,509 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package backend
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/afero"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// DefaultWatchInterval is the time between two scans of the watched directories.
+	DefaultWatchInterval = time.Second
+	// DefaultWatchDebounce is the time the watched directories have to stay unchanged before changes are applied.
+	DefaultWatchDebounce = 2 * time.Second
+)
+
+type DocumentEventKind string
+
+const (
+	DocumentCreated  DocumentEventKind = "created"
+	DocumentModified DocumentEventKind = "modified"
+	DocumentRenamed  DocumentEventKind = "renamed"
+	DocumentDeleted  DocumentEventKind = "deleted"
+)
+
+// DocumentEvent describes a change of a document file, OldPath is only set for renames.
+type DocumentEvent struct {
+	Kind    DocumentEventKind
+	Path    string
+	OldPath string
+}
+
+type watchedFile struct {
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+}
+
+type watchSnapshot map[string]watchedFile
+
+// DocumentWatcher keeps the documents in the watched directories in sync with the database.
+// Since afero offers no change notifications, the directories are scanned every Interval.
+// Changes are applied once the directories stayed unchanged for Debounce,
+// so that bursts of events, like editors saving through temporary files, are applied at once.
+type DocumentWatcher struct {
+	Backend  *Backend
+	Fs       afero.Fs
+	Logger   *log.Logger
+	Roots    []string
+	Interval time.Duration
+	Debounce time.Duration
+
+	// applied is the state of the directories, which has been written to the database.
+	applied watchSnapshot
+	// latest is the state of the directories at the last scan.
+	latest     watchSnapshot
+	lastChange time.Time
+}
+
+func NewDocumentWatcher(backend *Backend, fs afero.Fs, logger *log.Logger, roots []string) (*DocumentWatcher, error) {
+	if backend == nil || fs == nil || logger == nil {
+		return nil, helper.NilInputError{}
+	}
+
+	if len(roots) == 0 {
+		return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	return &DocumentWatcher{
+		Backend:  backend,
+		Fs:       fs,
+		Logger:   logger,
+		Roots:    roots,
+		Interval: DefaultWatchInterval,
+		Debounce: DefaultWatchDebounce,
+	}, nil
+}
+
+// Run polls the watched directories until ctx is done.
+// Only the initial scan's error is returned, later errors are logged.
+func (watcher *DocumentWatcher) Run(ctx context.Context) error {
+	err := watcher.Poll(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(watcher.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			err = watcher.Poll(ctx, now)
+			if err != nil {
+				watcher.Logger.Error(err)
+			}
+		}
+	}
+}
+
+// Poll scans the watched directories and applies the changes since the last applied scan,
+// if nothing changed during the debounce period before now.
+// The first poll applies the changes made while the directories were not watched.
+func (watcher *DocumentWatcher) Poll(ctx context.Context, now time.Time) error {
+	snapshot, err := watcher.scan()
+	if err != nil {
+		return err
+	}
+
+	if watcher.applied == nil {
+		err = watcher.reconcile(ctx, snapshot)
+		if err != nil {
+			return err
+		}
+
+		watcher.applied = snapshot
+		watcher.latest = snapshot
+
+		return nil
+	}
+
+	if !snapshot.equal(watcher.latest) {
+		watcher.latest = snapshot
+		watcher.lastChange = now
+	}
+
+	if snapshot.equal(watcher.applied) || now.Sub(watcher.lastChange) < watcher.Debounce {
+		return nil
+	}
+
+	events := diffSnapshots(watcher.applied, snapshot)
+	watcher.applied = snapshot
+
+	watcher.apply(ctx, events)
+
+	return nil
+}
+
+// reconcile diffs snapshot against the registered documents below the watched directories.
+// Unknown files are registered, documents whose files are gone are trashed and all others are synced.
+func (watcher *DocumentWatcher) reconcile(ctx context.Context, snapshot watchSnapshot) error {
+	documents, err := watcher.Backend.DocumentManager.GetAll(ctx)
+	if err != nil && !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+		return err
+	}
+
+	registered := map[string]bool{}
+	events := []DocumentEvent{}
+
+	for _, document := range documents {
+		if !watcher.isWatched(document.Path) {
+			continue
+		}
+
+		registered[document.Path] = true
+
+		if _, ok := snapshot[document.Path]; !ok {
+			events = append(events, DocumentEvent{Kind: DocumentDeleted, Path: document.Path})
+		}
+	}
+
+	for path := range snapshot {
+		if registered[path] {
+			events = append(events, DocumentEvent{Kind: DocumentModified, Path: path})
+		} else {
+			events = append(events, DocumentEvent{Kind: DocumentCreated, Path: path})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Path < events[j].Path })
+
+	watcher.apply(ctx, events)
+
+	return nil
+}
+
+// apply writes events to the database, documents whose events fail to apply are logged and skipped.
+// Tags and links are synced after all documents are registered, so links between new documents are found.
+func (watcher *DocumentWatcher) apply(ctx context.Context, events []DocumentEvent) {
+	toSync := []string{}
+
+	for _, event := range events {
+		watcher.Logger.Infof("Document %v %v", event.Path, event.Kind)
+
+		var err error
+
+		switch event.Kind {
+		case DocumentCreated:
+			err = watcher.Backend.RegisterDocument(ctx, event.Path)
+		case DocumentRenamed:
+			err = watcher.Backend.RenameDocument(ctx, event.OldPath, event.Path)
+		case DocumentDeleted:
+			err = watcher.Backend.UnregisterDocument(ctx, event.Path)
+		}
+
+		if err != nil {
+			watcher.Logger.Errorf("Failed to apply %v event of document %v: %v", event.Kind, event.Path, err)
+
+			continue
+		}
+
+		if event.Kind != DocumentDeleted {
+			toSync = append(toSync, event.Path)
+		}
+	}
+
+	for _, path := range toSync {
+		err := watcher.Backend.SyncDocument(ctx, path)
+		if err != nil {
+			watcher.Logger.Errorf("Failed to sync document %v: %v", path, err)
+		}
+	}
+}
+
+// scan records the Markdown files below the watched directories, skipping hidden directories like the trash.
+// Contents are only hashed if their size or modification time changed since the last scan.
+func (watcher *DocumentWatcher) scan() (watchSnapshot, error) {
+	snapshot := watchSnapshot{}
+
+	for _, root := range watcher.Roots {
+		err := afero.Walk(watcher.Fs, root, func(path string, info fs.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() {
+				if path != root && strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if filepath.Ext(path) != ".md" {
+				return nil
+			}
+
+			file := watchedFile{modTime: info.ModTime(), size: info.Size()}
+
+			if previous, ok := watcher.latest[path]; ok && previous.modTime.Equal(file.modTime) && previous.size == file.size {
+				file.hash = previous.hash
+			} else {
+				content, err := afero.ReadFile(watcher.Fs, path)
+				if err != nil {
+					return err
+				}
+
+				file.hash = sha256.Sum256(content)
+			}
+
+			snapshot[path] = file
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// isWatched reports whether scan would record path.
+func (watcher *DocumentWatcher) isWatched(path string) bool {
+	if filepath.Ext(path) != ".md" {
+		return false
+	}
+
+	for _, root := range watcher.Roots {
+		relPath, err := filepath.Rel(root, path)
+		if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		dirs := strings.Split(filepath.Dir(relPath), string(filepath.Separator))
+		if slices.IndexFunc(dirs, func(dir string) bool { return strings.HasPrefix(dir, ".") && dir != "." }) == -1 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (snapshot watchSnapshot) equal(other watchSnapshot) bool {
+	if len(snapshot) != len(other) {
+		return false
+	}
+
+	for path, file := range snapshot {
+		otherFile, ok := other[path]
+		if !ok || otherFile.hash != file.hash || otherFile.size != file.size || !otherFile.modTime.Equal(file.modTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// diffSnapshots returns the events turning old into new.
+// A deleted and a created file with the same contents are considered a rename.
+func diffSnapshots(old watchSnapshot, new watchSnapshot) []DocumentEvent {
+	deleted := []string{}
+	created := []string{}
+	events := []DocumentEvent{}
+
+	for path := range old {
+		if _, ok := new[path]; !ok {
+			deleted = append(deleted, path)
+		}
+	}
+
+	for path, file := range new {
+		oldFile, ok := old[path]
+		if !ok {
+			created = append(created, path)
+		} else if oldFile.hash != file.hash {
+			events = append(events, DocumentEvent{Kind: DocumentModified, Path: path})
+		}
+	}
+
+	sort.Strings(deleted)
+	sort.Strings(created)
+
+	for _, oldPath := range deleted {
+		iCreated := slices.IndexFunc(created, func(path string) bool { return new[path].hash == old[oldPath].hash })
+		if iCreated == -1 {
+			events = append(events, DocumentEvent{Kind: DocumentDeleted, Path: oldPath})
+
+			continue
+		}
+
+		events = append(events, DocumentEvent{Kind: DocumentRenamed, Path: created[iCreated], OldPath: oldPath})
+		created = slices.Delete(created, iCreated, iCreated+1)
+	}
+
+	for _, path := range created {
+		events = append(events, DocumentEvent{Kind: DocumentCreated, Path: path})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Path < events[j].Path })
+
+	return events
+}
+
+// RegisterDocument adds a document for path, restoring a trashed one if it exists.
+// Documents which are already registered are left untouched.
+func (backend *Backend) RegisterDocument(ctx context.Context, path string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		_, err := backend.documentFromPath(ctx, path)
+		if err == nil || !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return err
+		}
+
+		trashed, err := backend.DocumentManager.GetFirstWhere(ctx, libdocuments.TrashedFilter(documentPathFilter(path)))
+		if err == nil {
+			return backend.DocumentManager.Restore(ctx, []*domain.Document{trashed})
+		}
+		if !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return err
+		}
+
+		return backend.DocumentManager.Add(ctx, []*domain.Document{{Path: path}})
+	})
+}
+
+// RenameDocument updates the path of the document at oldPath to newPath without touching any contents.
+// A document is registered for newPath if none exists for oldPath.
+func (backend *Backend) RenameDocument(ctx context.Context, oldPath string, newPath string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		document, err := backend.documentFromPath(ctx, oldPath)
+		if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return backend.RegisterDocument(ctx, newPath)
+		}
+		if err != nil {
+			return err
+		}
+
+		document.Path = newPath
+
+		return backend.DocumentManager.Replace(ctx, []*domain.Document{document})
+	})
+}
+
+// UnregisterDocument moves the document at path to the trash, leaving the already removed contents alone.
+// Paths without a document are ignored.
+func (backend *Backend) UnregisterDocument(ctx context.Context, path string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		document, err := backend.documentFromPath(ctx, path)
+		if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return backend.DocumentManager.Delete(ctx, []*domain.Document{document})
+	})
+}
+
+// SyncDocument replaces the tags and links of the document at path with the ones parsed from its contents.
+// Unknown tags are logged and skipped, the tags are left alone if the document has no tags header.
+func (backend *Backend) SyncDocument(ctx context.Context, path string) error {
+	return backend.InUnitOfWork(ctx, func(ctx context.Context) error {
+		contents, err := backend.DocumentContentManager.Get(ctx, []string{path})
+		if err != nil {
+			return err
+		}
+
+		err = backend.syncDocumentTags(ctx, path, contents[0])
+		if err != nil {
+			return err
+		}
+
+		_, err = backend.DocumentContentManager.SyncLinks(ctx, []string{path}, &backend.DocumentManager)
+
+		return err
+	})
+}
+
+// syncDocumentTags replaces the tags of the document at path with the ones parsed from content.
+func (backend *Backend) syncDocumentTags(ctx context.Context, path string, content string) error {
+	tagPaths, err := libdocuments.ParseTags(content)
+	if errors.Is(err, libdocuments.TagsHeaderNotFoundError{}) {
+		backend.DocumentManager.Logger.Warnf("Skipping syncing tags of document %v: %v", path, err)
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tagIDs := []int64{}
+
+	for _, tagPath := range tagPaths {
+		tag, err := backend.TagManager.UnmarshalPath(ctx, tagPath)
+		if errors.Is(err, libtags.UnknownTagPathError{}) {
+			backend.DocumentManager.Logger.Warnf("Skipping unknown tag %v of document %v", tagPath, path)
+
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	document, err := backend.documentFromPath(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	oldTagIDs := slices.Clone(document.TagIDs)
+	slices.Sort(oldTagIDs)
+	slices.Sort(tagIDs)
+
+	if slices.Equal(oldTagIDs, tagIDs) {
+		return nil
+	}
+
+	document.TagIDs = tagIDs
+
+	return backend.DocumentManager.Replace(ctx, []*domain.Document{document})
+}
+
+func documentPathFilter(path string) *domain.DocumentFilter {
+	return &domain.DocumentFilter{
+		Path: optional.Make(model.FilterOperation[string]{
+			Operator: model.FilterEqual,
+			Operand:  model.ScalarOperand[string]{Operand: path},
+		}),
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package bntp
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// Cache is a least recently used cache holding at most MaxSize entries, which expire after TTL.
+// A MaxSize or TTL <= 0 disables the respective limit.
+// A nil *Cache is valid and caches nothing.
+type Cache[TKey comparable, TValue any] struct {
+	MaxSize int
+	TTL     time.Duration
+
+	mutex   sync.Mutex
+	entries map[TKey]*list.Element
+	lru     *list.List
+	now     func() time.Time
+}
+
+type cacheEntry[TKey comparable, TValue any] struct {
+	key       TKey
+	value     TValue
+	expiresAt time.Time
+}
+
+func NewCache[TKey comparable, TValue any](maxSize int, ttl time.Duration) *Cache[TKey, TValue] {
+	return &Cache[TKey, TValue]{
+		MaxSize: maxSize,
+		TTL:     ttl,
+		entries: make(map[TKey]*list.Element),
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns the value cached for key and whether it was found.
+func (cache *Cache[TKey, TValue]) Get(key TKey) (value TValue, ok bool) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	element, ok := cache.entries[key]
+	if !ok {
+		return
+	}
+
+	entry := element.Value.(*cacheEntry[TKey, TValue])
+	if cache.TTL > 0 && !cache.now().Before(entry.expiresAt) {
+		cache.remove(element)
+
+		return value, false
+	}
+
+	cache.lru.MoveToFront(element)
+
+	return entry.value, true
+}
+
+// Set caches value for key, evicting the least recently used entry if the cache is full.
+func (cache *Cache[TKey, TValue]) Set(key TKey, value TValue) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	expiresAt := cache.now().Add(cache.TTL)
+
+	if element, ok := cache.entries[key]; ok {
+		entry := element.Value.(*cacheEntry[TKey, TValue])
+		entry.value = value
+		entry.expiresAt = expiresAt
+
+		cache.lru.MoveToFront(element)
+
+		return
+	}
+
+	cache.entries[key] = cache.lru.PushFront(&cacheEntry[TKey, TValue]{key: key, value: value, expiresAt: expiresAt})
+
+	if cache.MaxSize > 0 && cache.lru.Len() > cache.MaxSize {
+		cache.remove(cache.lru.Back())
+	}
+}
+
+func (cache *Cache[TKey, TValue]) Delete(key TKey) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+}
+
+// Clear removes all entries.
+func (cache *Cache[TKey, TValue]) Clear() {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.entries = make(map[TKey]*list.Element)
+	cache.lru.Init()
+}
+
+// Len returns the number of entries, including expired ones which have not been accessed yet.
+func (cache *Cache[TKey, TValue]) Len() int {
+	if cache == nil {
+		return 0
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.lru.Len()
+}
+
+func (cache *Cache[TKey, TValue]) remove(element *list.Element) {
+	delete(cache.entries, element.Value.(*cacheEntry[TKey, TValue]).key)
+	cache.lru.Remove(element)
+}
+
+//******************************************************************//
+//                           ManagerCache                           //
+//******************************************************************//
+
+// Invalidator is implemented by caches which have to be cleared when the data they are based on changes.
+type Invalidator interface {
+	Invalidate()
+}
+
+// ManagerCache caches the entities a manager retrieved from its repository.
+// Entities are stored and returned as copies, which do not share the backing arrays of their slice fields,
+// so callers can modify the returned entities.
+// A nil *ManagerCache is valid and caches nothing.
+type ManagerCache[TEntity any] struct {
+	// Entities holds single entities by their ID.
+	Entities *Cache[int64, TEntity]
+	// All holds the result of the last GetAll call.
+	All *Cache[struct{}, []TEntity]
+
+	getID      func(*TEntity) int64
+	mutex      sync.Mutex
+	dependents []Invalidator
+}
+
+func NewManagerCache[TEntity any](maxSize int, ttl time.Duration, getID func(*TEntity) int64) *ManagerCache[TEntity] {
+	return &ManagerCache[TEntity]{
+		Entities: NewCache[int64, TEntity](maxSize, ttl),
+		All:      NewCache[struct{}, []TEntity](1, ttl),
+		getID:    getID,
+	}
+}
+
+// AddDependent makes dependent be invalidated together with cache.
+// This is needed if the entities cached in dependent embed the ones cached in cache.
+func (cache *ManagerCache[TEntity]) AddDependent(dependent Invalidator) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.dependents = append(cache.dependents, dependent)
+}
+
+// Invalidate clears the cache and its dependents.
+func (cache *ManagerCache[TEntity]) Invalidate() {
+	if cache == nil {
+		return
+	}
+
+	cache.Entities.Clear()
+	cache.All.Clear()
+
+	cache.mutex.Lock()
+	dependents := cache.dependents
+	cache.mutex.Unlock()
+
+	for _, dependent := range dependents {
+		dependent.Invalidate()
+	}
+}
+
+// InvalidateAfterWrite invalidates the cache after a write through ctx.
+// If the write is part of a unit of work, the cache is invalidated again once it completes,
+// entities read in the meantime might not be committed.
+func (cache *ManagerCache[TEntity]) InvalidateAfterWrite(ctx context.Context) {
+	if cache == nil {
+		return
+	}
+
+	cache.Invalidate()
+
+	if unitOfWork, ok := repository.UnitOfWorkFromContext(ctx); ok {
+		unitOfWork.AfterCompletion(cache.Invalidate)
+	}
+}
+
+// GetFromIDs returns the entities with the given ids in the order of ids, only the uncached ones are retrieved through fetch.
+// Like the repositories, it only fails with a helper.IneffectiveOperationError if none of the entities exist.
+func (cache *ManagerCache[TEntity]) GetFromIDs(ids []int64, fetch func(ids []int64) ([]*TEntity, error)) (entities []*TEntity, err error) {
+	if cache == nil {
+		return fetch(ids)
+	}
+
+	found := make(map[int64]*TEntity, len(ids))
+	missingIDs := make([]int64, 0, len(ids))
+
+	for _, id := range ids {
+		if entity, ok := cache.Entities.Get(id); ok {
+			entity = copyEntity(&entity)
+			found[id] = &entity
+		} else {
+			missingIDs = append(missingIDs, id)
+		}
+	}
+
+	if len(missingIDs) > 0 {
+		var fetched []*TEntity
+
+		fetched, err = fetch(missingIDs)
+		if err != nil && (len(found) == 0 || !errors.Is(err, helper.IneffectiveOperationError{})) {
+			return nil, err
+		}
+
+		err = nil
+
+		cache.SetEntities(fetched)
+
+		for _, entity := range fetched {
+			found[cache.getID(entity)] = entity
+		}
+	}
+
+	entities = make([]*TEntity, 0, len(found))
+
+	for _, id := range ids {
+		if entity, ok := found[id]; ok {
+			entities = append(entities, entity)
+		}
+	}
+
+	return
+}
+
+// SetEntities caches entities by their ID.
+func (cache *ManagerCache[TEntity]) SetEntities(entities []*TEntity) {
+	if cache == nil {
+		return
+	}
+
+	for _, entity := range entities {
+		if entity != nil {
+			cache.Entities.Set(cache.getID(entity), copyEntity(entity))
+		}
+	}
+}
+
+// GetAll returns the cached result of GetAll and whether it was found.
+func (cache *ManagerCache[TEntity]) GetAll() (entities []*TEntity, ok bool) {
+	if cache == nil {
+		return
+	}
+
+	cached, ok := cache.All.Get(struct{}{})
+	if !ok {
+		return
+	}
+
+	entities = make([]*TEntity, 0, len(cached))
+
+	for _, entity := range cached {
+		entity := copyEntity(&entity)
+		entities = append(entities, &entity)
+	}
+
+	return
+}
+
+// SetAll caches the result of GetAll and the contained entities.
+func (cache *ManagerCache[TEntity]) SetAll(entities []*TEntity) {
+	if cache == nil {
+		return
+	}
+
+	cached := make([]TEntity, 0, len(entities))
+
+	for _, entity := range entities {
+		if entity != nil {
+			cached = append(cached, copyEntity(entity))
+		}
+	}
+
+	cache.All.Set(struct{}{}, cached)
+	cache.SetEntities(entities)
+}
+
+// copyEntity returns a copy of entity, which does not share the backing arrays of its slice fields with entity.
+func copyEntity[TEntity any](entity *TEntity) TEntity {
+	copied := *entity
+
+	value := reflect.ValueOf(&copied).Elem()
+	if value.Kind() != reflect.Struct {
+		return copied
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		if field.Kind() != reflect.Slice || field.IsNil() || !field.CanSet() {
+			continue
+		}
+
+		clone := reflect.MakeSlice(field.Type(), field.Len(), field.Len())
+		reflect.Copy(clone, field)
+		field.Set(clone)
+	}
+
+	return copied
+}
@@ -0,0 +1,79 @@
+package bntp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheEviction(t *testing.T) {
+	cache := bntp.NewCache[int, string](2, 0)
+
+	cache.Set(1, "foo")
+	cache.Set(2, "bar")
+
+	_, ok := cache.Get(1)
+	assert.True(t, ok)
+
+	cache.Set(3, "baz")
+
+	_, ok = cache.Get(2)
+	assert.False(t, ok, "assert least recently used entry is evicted")
+
+	value, ok := cache.Get(1)
+	assert.True(t, ok)
+	assert.Equal(t, "foo", value)
+
+	assert.Equal(t, 2, cache.Len())
+
+	cache.Clear()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache := bntp.NewCache[int, string](0, 10*time.Millisecond)
+
+	cache.Set(1, "foo")
+
+	_, ok := cache.Get(1)
+	assert.True(t, ok)
+
+	time.Sleep(20 * time.Millisecond)
+
+	_, ok = cache.Get(1)
+	assert.False(t, ok, "assert expired entry is not returned")
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestNilCache(t *testing.T) {
+	var cache *bntp.Cache[int, string]
+
+	cache.Set(1, "foo")
+
+	_, ok := cache.Get(1)
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestManagerCacheCopiesSliceFields(t *testing.T) {
+	cache := bntp.NewManagerCache(0, 0, (*domain.Bookmark).GetID)
+
+	bookmark := &domain.Bookmark{ID: 1, URL: "foo", TagIDs: make([]int64, 2, 3)}
+	cache.SetAll([]*domain.Bookmark{bookmark})
+
+	bookmark.TagIDs[0] = 1
+
+	bookmarks, ok := cache.GetAll()
+	assert.True(t, ok)
+	assert.Equal(t, []int64{0, 0}, bookmarks[0].TagIDs, "assert stored entity does not share slices with the input")
+
+	bookmarks[0].TagIDs[0] = 2
+	_ = append(bookmarks[0].TagIDs[:1], 3)
+
+	bookmarks, err := cache.GetFromIDs([]int64{1}, func(ids []int64) ([]*domain.Bookmark, error) { return nil, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 0}, bookmarks[0].TagIDs, "assert returned entity does not share slices with the cached one")
+}
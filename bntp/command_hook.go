@@ -0,0 +1,119 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package bntp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/marshallers"
+)
+
+// CommandHookPointEnvVar is the environment variable holding the hook point a command hook is executed at.
+const CommandHookPointEnvVar = "BNTP_HOOK_POINT"
+
+type CommandHookError struct {
+	Inner   error
+	Command string
+	Stderr  string
+}
+
+func (err CommandHookError) Error() string {
+	if err.Stderr == "" {
+		return fmt.Sprintf("Command hook %q failed: %v", err.Command, err.Inner)
+	}
+
+	return fmt.Sprintf("Command hook %q failed: %v: %v", err.Command, err.Inner, err.Stderr)
+}
+
+func (err CommandHookError) Unwrap() error {
+	return err.Inner
+}
+
+func (err CommandHookError) Is(other error) bool {
+	switch other.(type) {
+	case CommandHookError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err CommandHookError) As(target any) bool {
+	switch target.(type) {
+	case CommandHookError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// NewCommandHook creates a hook running an external program with the entity marshalled to its stdin.
+// The hook point is passed in CommandHookPointEnvVar, a timeout of 0 means no timeout.
+func NewCommandHook[TEntity any](command []string, timeout time.Duration, marshaller marshallers.Marshaller) func(context.Context, *TEntity) error {
+	return func(ctx context.Context, entity *TEntity) error {
+		commandString := strings.Join(command, " ")
+
+		if len(command) == 0 {
+			return CommandHookError{Command: commandString, Inner: fmt.Errorf("no command given")}
+		}
+
+		if timeout > 0 {
+			var cancel context.CancelFunc
+
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		input, err := marshaller.Marshall(entity)
+		if err != nil {
+			return CommandHookError{Command: commandString, Inner: err}
+		}
+
+		point, _ := HookPointFromContext(ctx)
+
+		stderr := new(bytes.Buffer)
+
+		cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+		cmd.Stdin = strings.NewReader(input)
+		cmd.Stderr = stderr
+		cmd.Env = append(os.Environ(), CommandHookPointEnvVar+"="+point.String())
+
+		err = cmd.Run()
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+
+		if err != nil {
+			return CommandHookError{Command: commandString, Stderr: strings.TrimSpace(stderr.String()), Inner: err}
+		}
+
+		return nil
+	}
+}
@@ -55,6 +55,62 @@ func (err BadHookPointError) As(target any) bool {
 	}
 }
 
+type UnknownHookError struct {
+	Name string
+}
+
+func (err UnknownHookError) Error() string {
+	return fmt.Sprintf("No hook named %q is registered", err.Name)
+}
+
+func (err UnknownHookError) Is(other error) bool {
+	switch other.(type) {
+	case UnknownHookError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnknownHookError) As(target any) bool {
+	switch target.(type) {
+	case UnknownHookError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+type DuplicateHookError struct {
+	Name string
+}
+
+func (err DuplicateHookError) Error() string {
+	return fmt.Sprintf("A hook named %q is already registered", err.Name)
+}
+
+func (err DuplicateHookError) Is(other error) bool {
+	switch other.(type) {
+	case DuplicateHookError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err DuplicateHookError) As(target any) bool {
+	switch target.(type) {
+	case DuplicateHookError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
 type HookExecutionError struct {
 	Inner error
 }
@@ -67,6 +123,26 @@ func (err HookExecutionError) Unwrap() error {
 	return err.Inner
 }
 
+func (err HookExecutionError) Is(other error) bool {
+	switch other.(type) {
+	case HookExecutionError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err HookExecutionError) As(target any) bool {
+	switch target.(type) {
+	case HookExecutionError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
 type HookPoint int
 
 const (
@@ -97,6 +173,59 @@ const (
 	_end
 )
 
+// HookPointNames maps the names used in the configuration to hook points.
+var HookPointNames = map[string]HookPoint{
+	"before_add":     BeforeAddHook,
+	"after_add":      AfterAddHook,
+	"before_select":  BeforeSelectHook,
+	"after_select":   AfterSelectHook,
+	"before_update":  BeforeUpdateHook,
+	"after_update":   AfterUpdateHook,
+	"before_delete":  BeforeDeleteHook,
+	"after_delete":   AfterDeleteHook,
+	"before_upsert":  BeforeUpsertHook,
+	"after_upsert":   AfterUpsertHook,
+	"before_any":     BeforeAnyHook,
+	"after_any":      AfterAnyHook,
+	"after_error":    AfterErrorHook,
+	"after_deadline": AfterDeadlineHook,
+	"after_timeout":  AfterTimeoutHook,
+	"after_cancel":   AfterCancelHook,
+}
+
+func (point HookPoint) String() string {
+	for name, namedPoint := range HookPointNames {
+		if namedPoint == point {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("HookPoint(%d)", int(point))
+}
+
+func HookPointFromString(name string) (HookPoint, error) {
+	point, ok := HookPointNames[name]
+	if !ok {
+		return 0, BadHookPointError{}
+	}
+
+	return point, nil
+}
+
+type hookPointContextKey struct{}
+
+// ContextWithHookPoint makes point available to hooks through HookPointFromContext.
+func ContextWithHookPoint(ctx context.Context, point HookPoint) context.Context {
+	return context.WithValue(ctx, hookPointContextKey{}, point)
+}
+
+// HookPointFromContext returns the hook point a hook is executed at.
+func HookPointFromContext(ctx context.Context) (HookPoint, bool) {
+	point, ok := ctx.Value(hookPointContextKey{}).(HookPoint)
+
+	return point, ok
+}
+
 type Hooks[TEntity any] struct {
 	// Outer dimension is a fixed size array because there is a fixed number of hookpoints
 	hooks map[HookPoint][]func(context.Context, *TEntity) error
@@ -141,7 +270,9 @@ func (hooks *Hooks[TEntity]) ExecuteHooks(ctx context.Context, point HookPoint,
 
 	for hp := HookPoint(1); hp < _end; hp <<= 1 {
 		if hp&point > 0 {
-			err := goaoi.ForeachSlice(hooks.hooks[hp], func(hook func(context.Context, *TEntity) error) error { return hook(ctx, entity) })
+			hookCtx := ContextWithHookPoint(ctx, hp)
+
+			err := goaoi.ForeachSlice(hooks.hooks[hp], func(hook func(context.Context, *TEntity) error) error { return hook(hookCtx, entity) })
 			if err != nil && !errors.As(err, &goaoi.EmptyIterableError{}) {
 				return err
 			}
@@ -159,3 +290,75 @@ func (hooks *Hooks[TEntity]) PartiallySpecializeExecuteHooks(ctx context.Context
 func (hooks *Hooks[TEntity]) PartiallySpecializeExecuteHooksForNoPointer(ctx context.Context, point HookPoint) func(entity TEntity) error {
 	return func(entity TEntity) error { return hooks.ExecuteHooks(ctx, point, &entity) }
 }
+
+// HookRegistry holds named hooks, so they can be referenced in the configuration.
+type HookRegistry[TEntity any] struct {
+	hooks map[string]func(context.Context, *TEntity) error
+}
+
+func NewHookRegistry[TEntity any]() *HookRegistry[TEntity] {
+	return &HookRegistry[TEntity]{hooks: make(map[string]func(context.Context, *TEntity) error)}
+}
+
+func (registry *HookRegistry[TEntity]) Register(name string, hook func(context.Context, *TEntity) error) error {
+	if _, ok := registry.hooks[name]; ok {
+		return DuplicateHookError{Name: name}
+	}
+
+	registry.hooks[name] = hook
+
+	return nil
+}
+
+func (registry *HookRegistry[TEntity]) Get(name string) (func(context.Context, *TEntity) error, error) {
+	hook, ok := registry.hooks[name]
+	if !ok {
+		return nil, UnknownHookError{Name: name}
+	}
+
+	return hook, nil
+}
+
+// Clone copies the registry, so hooks can be registered without affecting the original.
+func (registry *HookRegistry[TEntity]) Clone() *HookRegistry[TEntity] {
+	clone := NewHookRegistry[TEntity]()
+
+	for name, hook := range registry.hooks {
+		clone.hooks[name] = hook
+	}
+
+	return clone
+}
+
+func (registry *HookRegistry[TEntity]) Has(name string) bool {
+	_, ok := registry.hooks[name]
+
+	return ok
+}
+
+// NewHooks attaches the registered hooks to the hook points they are listed under.
+// hookPoints maps hook point names as in HookPointNames to hook names.
+func (registry *HookRegistry[TEntity]) NewHooks(hookPoints map[string][]string) (*Hooks[TEntity], error) {
+	hooks := NewHooks[TEntity]()
+
+	for pointName, hookNames := range hookPoints {
+		point, err := HookPointFromString(pointName)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hookName := range hookNames {
+			hook, err := registry.Get(hookName)
+			if err != nil {
+				return nil, err
+			}
+
+			err = hooks.AddHook(point, hook)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return hooks, nil
+}
@@ -24,6 +24,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	domain "github.com/JonasMuehlmann/bntp.go/model/domain"
 	repository "github.com/JonasMuehlmann/bntp.go/model/repository"
 	"github.com/JonasMuehlmann/goaoi"
@@ -36,13 +38,16 @@ type BookmarkManager struct {
 	Hooks      *bntp.Hooks[domain.Bookmark]
 	Repository repository.BookmarkRepository
 	Logger     *log.Logger
+	// Cache is invalidated by all write operations, it is optional.
+	Cache *bntp.ManagerCache[domain.Bookmark]
 }
 
-func NewBookmarkManager(logger *log.Logger, hooks *bntp.Hooks[domain.Bookmark], repository repository.BookmarkRepository) (BookmarkManager, error) {
+func NewBookmarkManager(logger *log.Logger, hooks *bntp.Hooks[domain.Bookmark], repository repository.BookmarkRepository, cache *bntp.ManagerCache[domain.Bookmark]) (BookmarkManager, error) {
 	m := BookmarkManager{}
 	m.Repository = repository
 	m.Hooks = hooks
 	m.Logger = logger
+	m.Cache = cache
 
 	return m, nil
 }
@@ -53,9 +58,13 @@ func (m *BookmarkManager) Add(ctx context.Context, bookmarks []*domain.Bookmark)
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.Add(ctx, bookmarks)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -75,9 +84,13 @@ func (m *BookmarkManager) Replace(ctx context.Context, bookmarks []*domain.Bookm
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.Replace(ctx, bookmarks)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -97,9 +110,13 @@ func (m *BookmarkManager) Upsert(ctx context.Context, bookmarks []*domain.Bookma
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.Upsert(ctx, bookmarks)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -119,9 +136,13 @@ func (m *BookmarkManager) Update(ctx context.Context, documents []*domain.Bookma
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.Update(ctx, documents, documentUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -143,9 +164,15 @@ func (m *BookmarkManager) UpdateWhere(ctx context.Context, bookmarkFilter *domai
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
 	numAffectedRecords, err = m.Repository.UpdateWhere(ctx, bookmarkFilter, bookmarkUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -160,14 +187,19 @@ func (m *BookmarkManager) UpdateWhere(ctx context.Context, bookmarkFilter *domai
 	return
 }
 
+// Delete moves bookmarks to the trash, from which they can be restored or purged.
 func (m *BookmarkManager) Delete(ctx context.Context, bookmarks []*domain.Bookmark) error {
 	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeDeleteHook))
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
-	err := m.Repository.Delete(ctx, bookmarks)
+	err := m.trash().Trash(ctx, bookmarks)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -182,6 +214,7 @@ func (m *BookmarkManager) Delete(ctx context.Context, bookmarks []*domain.Bookma
 	return err
 }
 
+// DeleteWhere moves the bookmarks matching bookmarkFilter to the trash.
 func (m *BookmarkManager) DeleteWhere(ctx context.Context, bookmarkFilter *domain.BookmarkFilter) (numAffectedRecords int64, err error) {
 	bookmarks := []*domain.Bookmark{}
 
@@ -189,9 +222,15 @@ func (m *BookmarkManager) DeleteWhere(ctx context.Context, bookmarkFilter *domai
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	numAffectedRecords, err = m.Repository.DeleteWhere(ctx, bookmarkFilter)
+	numAffectedRecords, err = m.Repository.UpdateWhere(ctx, ExcludeTrashed(bookmarkFilter), trashFilters.TrashUpdater())
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -213,9 +252,13 @@ func (m *BookmarkManager) CountWhere(ctx context.Context, bookmarkFilter *domain
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	numRecords, err = m.Repository.CountWhere(ctx, bookmarkFilter)
+	numRecords, err = m.Repository.CountWhere(ctx, ExcludeTrashed(bookmarkFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -237,9 +280,69 @@ func (m *BookmarkManager) CountAll(ctx context.Context) (numRecords int64, err e
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	numRecords, err = m.Repository.CountWhere(ctx, notTrashedFilter())
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) CountGroupedWhere(ctx context.Context, bookmarkFilter *domain.BookmarkFilter, bookmarkGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	groups, err = m.Repository.CountGroupedWhere(ctx, ExcludeTrashed(bookmarkFilter), bookmarkGrouper)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) CountGroupedAll(ctx context.Context, bookmarkGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	numRecords, err = m.Repository.CountAll(ctx)
+	groups, err = m.Repository.CountGroupedWhere(ctx, notTrashedFilter(), bookmarkGrouper)
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -261,9 +364,17 @@ func (m *BookmarkManager) DoesExist(ctx context.Context, bookmark *domain.Bookma
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	doesExist, err = m.Repository.DoesExist(ctx, bookmark)
+	if bookmark == nil {
+		err = helper.NilInputError{}
+	} else {
+		doesExist, err = m.Repository.DoesExistWhere(ctx, ExcludeTrashed(trashFilters.IDIn([]int64{bookmark.ID})))
+	}
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -285,9 +396,13 @@ func (m *BookmarkManager) DoesExistWhere(ctx context.Context, bookmarkFilter *do
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	doesExist, err = m.Repository.DoesExistWhere(ctx, bookmarkFilter)
+	doesExist, err = m.Repository.DoesExistWhere(ctx, ExcludeTrashed(bookmarkFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -309,9 +424,13 @@ func (m *BookmarkManager) GetWhere(ctx context.Context, bookmarkFilter *domain.B
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetWhere(ctx, bookmarkFilter)
+	records, err = m.Repository.GetWhere(ctx, ExcludeTrashed(bookmarkFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -333,9 +452,13 @@ func (m *BookmarkManager) GetFirstWhere(ctx context.Context, bookmarkFilter *dom
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	record, err = m.Repository.GetFirstWhere(ctx, bookmarkFilter)
+	record, err = m.Repository.GetFirstWhere(ctx, ExcludeTrashed(bookmarkFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -357,9 +480,157 @@ func (m *BookmarkManager) GetAll(ctx context.Context) (records []*domain.Bookmar
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, ok := m.Cache.GetAll()
+	if !ok {
+		records, err = m.Repository.GetWhere(ctx, notTrashedFilter())
+		if err != nil {
+			m.Logger.Error(err)
+		} else {
+			m.Cache.SetAll(records)
+		}
 	}
 
-	records, err = m.Repository.GetAll(ctx)
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) GetWhereSorted(ctx context.Context, bookmarkFilter *domain.BookmarkFilter, bookmarkSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSorted(ctx, ExcludeTrashed(bookmarkFilter), bookmarkSorter, limiter)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) GetAllSorted(ctx context.Context, bookmarkSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSorted(ctx, notTrashedFilter(), bookmarkSorter, limiter)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) GetWhereSelected(ctx context.Context, bookmarkFilter *domain.BookmarkFilter, bookmarkSorter domain.BookmarkSorter, limiter *model.Limiter, bookmarkSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSelected(ctx, ExcludeTrashed(bookmarkFilter), bookmarkSorter, limiter, bookmarkSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) GetAllSelected(ctx context.Context, bookmarkSorter domain.BookmarkSorter, limiter *model.Limiter, bookmarkSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSelected(ctx, notTrashedFilter(), bookmarkSorter, limiter, bookmarkSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *BookmarkManager) GetFirstWhereSelected(ctx context.Context, bookmarkFilter *domain.BookmarkFilter, bookmarkSelector domain.BookmarkMemberSelector) (record *domain.Bookmark, err error) {
+	bookmarks := []*domain.Bookmark{}
+
+	hookErr := goaoi.ForeachSlice(bookmarks, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	record, err = m.Repository.GetFirstWhereSelected(ctx, ExcludeTrashed(bookmarkFilter), bookmarkSelector)
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -381,6 +652,8 @@ func (m *BookmarkManager) AddType(ctx context.Context, types []string) error {
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.AddType(ctx, types)
@@ -405,9 +678,13 @@ func (m *BookmarkManager) DeleteType(ctx context.Context, types []string) error
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.DeleteType(ctx, types)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -429,9 +706,13 @@ func (m *BookmarkManager) UpdateType(ctx context.Context, oldType string, newTyp
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		return hookErr
 	}
 
 	err := m.Repository.UpdateType(ctx, oldType, newType)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -454,6 +735,7 @@ func (m *BookmarkManager) GetAllTypes(ctx context.Context) ([]string, error) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return nil, hookErr
 	}
 
 	types, err := m.Repository.GetAllTypes(ctx)
@@ -479,9 +761,15 @@ func (m *BookmarkManager) GetFromIDs(ctx context.Context, ids []int64) (records
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetFromIDs(ctx, ids)
+	records, err = m.Cache.GetFromIDs(ids, func(ids []int64) ([]*domain.Bookmark, error) {
+		return m.Repository.GetWhere(ctx, ExcludeTrashed(trashFilters.IDIn(ids)))
+	})
 	if err != nil {
 		m.Logger.Error(err)
 
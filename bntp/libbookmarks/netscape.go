@@ -0,0 +1,541 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libbookmarks
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	domain "github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+	"golang.org/x/exp/slices"
+	htmlParser "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// NetscapeBookmark is a single entry of a Netscape bookmark file as exported by browsers.
+type NetscapeBookmark struct {
+	AddDate      time.Time
+	LastModified time.Time
+	URL          string
+	Title        string
+	// Folders holds the names of the folders the bookmark is nested in, from the outermost to the innermost one.
+	Folders []string
+}
+
+const netscapeBookmarkFileHeader = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<!-- This is an automatically generated file.
+     It will be read and overwritten.
+     DO NOT EDIT! -->
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+`
+
+// ParseNetscapeBookmarks reads all bookmarks from a Netscape bookmark file.
+func ParseNetscapeBookmarks(reader io.Reader) (bookmarks []NetscapeBookmark, err error) {
+	tokenizer := htmlParser.NewTokenizer(reader)
+
+	var folders []string
+	// Every <DL> either opens the content of a folder or is the top level list.
+	var listOpensFolder []bool
+
+	var pendingFolder string
+	var hasPendingFolder bool
+
+	var currentText strings.Builder
+	var currentBookmark *NetscapeBookmark
+	var inFolderName bool
+
+	for {
+		tokenType := tokenizer.Next()
+
+		switch tokenType {
+		case htmlParser.ErrorToken:
+			if tokenizer.Err() == io.EOF {
+				return bookmarks, nil
+			}
+
+			return nil, tokenizer.Err()
+
+		case htmlParser.TextToken:
+			if inFolderName || currentBookmark != nil {
+				currentText.Write(tokenizer.Text())
+			}
+
+		case htmlParser.StartTagToken:
+			token := tokenizer.Token()
+
+			switch token.DataAtom {
+			case atom.H3:
+				inFolderName = true
+				currentText.Reset()
+
+			case atom.Dl:
+				listOpensFolder = append(listOpensFolder, hasPendingFolder)
+				if hasPendingFolder {
+					folders = append(folders, pendingFolder)
+					hasPendingFolder = false
+				}
+
+			case atom.A:
+				currentBookmark, err = newNetscapeBookmarkFromAttributes(token.Attr)
+				if err != nil {
+					return nil, err
+				}
+
+				currentBookmark.Folders = append([]string{}, folders...)
+				currentText.Reset()
+			}
+
+		case htmlParser.EndTagToken:
+			token := tokenizer.Token()
+
+			switch token.DataAtom {
+			case atom.H3:
+				if inFolderName {
+					pendingFolder = strings.TrimSpace(currentText.String())
+					hasPendingFolder = true
+					inFolderName = false
+				}
+
+			case atom.Dl:
+				if len(listOpensFolder) > 0 {
+					if listOpensFolder[len(listOpensFolder)-1] {
+						folders = folders[:len(folders)-1]
+					}
+
+					listOpensFolder = listOpensFolder[:len(listOpensFolder)-1]
+				}
+
+			case atom.A:
+				if currentBookmark != nil {
+					currentBookmark.Title = strings.TrimSpace(currentText.String())
+					bookmarks = append(bookmarks, *currentBookmark)
+					currentBookmark = nil
+				}
+			}
+		}
+	}
+}
+
+func newNetscapeBookmarkFromAttributes(attributes []htmlParser.Attribute) (bookmark *NetscapeBookmark, err error) {
+	bookmark = &NetscapeBookmark{}
+
+	for _, attribute := range attributes {
+		switch attribute.Key {
+		case "href":
+			bookmark.URL = attribute.Val
+		case "add_date":
+			bookmark.AddDate, err = parseNetscapeTimestamp(attribute.Val)
+		case "last_modified":
+			bookmark.LastModified, err = parseNetscapeTimestamp(attribute.Val)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+func parseNetscapeTimestamp(timestamp string) (time.Time, error) {
+	if timestamp == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(seconds, 0).UTC(), nil
+}
+
+type netscapeFolder struct {
+	Name       string
+	Bookmarks  []NetscapeBookmark
+	Subfolders []*netscapeFolder
+}
+
+func (folder *netscapeFolder) getOrAddSubfolder(name string) *netscapeFolder {
+	for _, subfolder := range folder.Subfolders {
+		if subfolder.Name == name {
+			return subfolder
+		}
+	}
+
+	subfolder := &netscapeFolder{Name: name}
+	folder.Subfolders = append(folder.Subfolders, subfolder)
+
+	return subfolder
+}
+
+// WriteNetscapeBookmarks writes bookmarks as a Netscape bookmark file.
+// Folders are written in the order they are first encountered in bookmarks.
+func WriteNetscapeBookmarks(writer io.Writer, bookmarks []NetscapeBookmark) error {
+	root := &netscapeFolder{}
+
+	for _, bookmark := range bookmarks {
+		folder := root
+		for _, folderName := range bookmark.Folders {
+			folder = folder.getOrAddSubfolder(folderName)
+		}
+
+		folder.Bookmarks = append(folder.Bookmarks, bookmark)
+	}
+
+	_, err := io.WriteString(writer, netscapeBookmarkFileHeader)
+	if err != nil {
+		return err
+	}
+
+	return writeNetscapeFolderContent(writer, root, 0)
+}
+
+func writeNetscapeFolderContent(writer io.Writer, folder *netscapeFolder, depth int) (err error) {
+	indentation := strings.Repeat("    ", depth)
+
+	_, err = fmt.Fprintf(writer, "%s<DL><p>\n", indentation)
+	if err != nil {
+		return
+	}
+
+	for _, subfolder := range folder.Subfolders {
+		_, err = fmt.Fprintf(writer, "%s    <DT><H3>%s</H3>\n", indentation, html.EscapeString(subfolder.Name))
+		if err != nil {
+			return
+		}
+
+		err = writeNetscapeFolderContent(writer, subfolder, depth+1)
+		if err != nil {
+			return
+		}
+	}
+
+	for _, bookmark := range folder.Bookmarks {
+		_, err = fmt.Fprintf(writer, "%s    <DT><A HREF=\"%s\"", indentation, html.EscapeString(bookmark.URL))
+		if err != nil {
+			return
+		}
+
+		if !bookmark.AddDate.IsZero() {
+			_, err = fmt.Fprintf(writer, " ADD_DATE=\"%d\"", bookmark.AddDate.Unix())
+			if err != nil {
+				return
+			}
+		}
+
+		if !bookmark.LastModified.IsZero() {
+			_, err = fmt.Fprintf(writer, " LAST_MODIFIED=\"%d\"", bookmark.LastModified.Unix())
+			if err != nil {
+				return
+			}
+		}
+
+		_, err = fmt.Fprintf(writer, ">%s</A>\n", html.EscapeString(bookmark.Title))
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = fmt.Fprintf(writer, "%s</DL><p>\n", indentation)
+
+	return
+}
+
+//******************************************************************//
+//                       BookmarkManager glue                       //
+//******************************************************************//
+
+// ImportNetscapeBookmarks adds bookmarks read from a Netscape bookmark file.
+// Folders are mapped to hierarchical tags, missing tags are created through tagManager.
+// Folder names must not contain libtags.PathSeparator.
+// Bookmarks whose URL is already known keep their data and only receive the tag of the folder they were found in.
+// Trashed bookmarks with an imported URL are restored.
+func (m *BookmarkManager) ImportNetscapeBookmarks(ctx context.Context, tagManager *libtags.TagManager, netscapeBookmarks []NetscapeBookmark) (numAffectedRecords int64, err error) {
+	if len(netscapeBookmarks) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+		m.Logger.Error(err)
+
+		return
+	}
+
+	tagIDsByPath, err := getOrAddTagsFromFolders(ctx, tagManager, netscapeBookmarks)
+	if err != nil {
+		m.Logger.Error(err)
+
+		return
+	}
+
+	var existingBookmarks []*domain.Bookmark
+
+	numExistingBookmarks, err := m.CountAll(ctx)
+	if err != nil {
+		return
+	}
+
+	if numExistingBookmarks > 0 {
+		existingBookmarks, err = m.GetAll(ctx)
+		if err != nil {
+			return
+		}
+	}
+
+	// Trashed bookmarks still occupy their URL and title
+	trashedFilter := TrashedFilter(&domain.BookmarkFilter{})
+
+	hasTrashedBookmarks, err := m.DoesExistWhere(ctx, trashedFilter)
+	if err != nil {
+		return
+	}
+
+	if hasTrashedBookmarks {
+		var trashedBookmarks []*domain.Bookmark
+
+		trashedBookmarks, err = m.GetWhere(ctx, trashedFilter)
+		if err != nil {
+			return
+		}
+
+		existingBookmarks = append(existingBookmarks, trashedBookmarks...)
+	}
+
+	bookmarksByURL := make(map[string]*domain.Bookmark, len(existingBookmarks))
+	usedTitles := make(map[string]bool, len(existingBookmarks))
+
+	for _, bookmark := range existingBookmarks {
+		bookmarksByURL[bookmark.URL] = bookmark
+
+		if bookmark.Title.HasValue {
+			usedTitles[bookmark.Title.Wrappee] = true
+		}
+	}
+
+	var newBookmarks []*domain.Bookmark
+	var changedBookmarks []*domain.Bookmark
+	isNewBookmark := make(map[*domain.Bookmark]bool)
+	isChangedBookmark := make(map[*domain.Bookmark]bool)
+
+	for _, netscapeBookmark := range netscapeBookmarks {
+		tagID, hasTag := tagIDsByPath[strings.Join(netscapeBookmark.Folders, libtags.PathSeparator)]
+
+		if bookmark, ok := bookmarksByURL[netscapeBookmark.URL]; ok {
+			isChanged := false
+
+			// Importing a trashed bookmark again restores it
+			if bookmark.DeletedAt.HasValue {
+				bookmark.DeletedAt = optional.Optional[time.Time]{}
+				isChanged = true
+			}
+
+			if hasTag && !slices.Contains(bookmark.TagIDs, tagID) {
+				bookmark.TagIDs = append(bookmark.TagIDs, tagID)
+				isChanged = true
+			}
+
+			if isChanged && !isNewBookmark[bookmark] && !isChangedBookmark[bookmark] {
+				isChangedBookmark[bookmark] = true
+				changedBookmarks = append(changedBookmarks, bookmark)
+			}
+
+			continue
+		}
+
+		bookmark := &domain.Bookmark{
+			URL:       netscapeBookmark.URL,
+			CreatedAt: netscapeBookmark.AddDate,
+			UpdatedAt: netscapeBookmark.LastModified,
+		}
+
+		if bookmark.CreatedAt.IsZero() {
+			bookmark.CreatedAt = time.Now()
+		}
+
+		if bookmark.UpdatedAt.IsZero() {
+			bookmark.UpdatedAt = bookmark.CreatedAt
+		}
+
+		// NOTE: Titles have to be unique but browsers happily store duplicates, the URL is what identifies a bookmark.
+		if netscapeBookmark.Title != "" {
+			if usedTitles[netscapeBookmark.Title] {
+				m.Logger.Warnf("Dropping duplicate title %q of bookmark %v", netscapeBookmark.Title, netscapeBookmark.URL)
+			} else {
+				bookmark.Title = optional.Make(netscapeBookmark.Title)
+				usedTitles[netscapeBookmark.Title] = true
+			}
+		}
+
+		if hasTag {
+			bookmark.TagIDs = []int64{tagID}
+		}
+
+		bookmarksByURL[bookmark.URL] = bookmark
+		isNewBookmark[bookmark] = true
+		newBookmarks = append(newBookmarks, bookmark)
+	}
+
+	if len(newBookmarks) > 0 {
+		err = m.Add(ctx, newBookmarks)
+		if err != nil {
+			return
+		}
+	}
+
+	if len(changedBookmarks) > 0 {
+		err = m.Replace(ctx, changedBookmarks)
+		if err != nil {
+			return
+		}
+	}
+
+	numAffectedRecords = int64(len(newBookmarks) + len(changedBookmarks))
+
+	return
+}
+
+// ExportNetscapeBookmarks returns all bookmarks in a form suitable for writing a Netscape bookmark file.
+// Tags are mapped to folders, a bookmark with multiple tags is listed in the folder of every tag.
+func (m *BookmarkManager) ExportNetscapeBookmarks(ctx context.Context, tagManager *libtags.TagManager) (netscapeBookmarks []NetscapeBookmark, err error) {
+	bookmarks, err := m.GetAll(ctx)
+	if err != nil {
+		return
+	}
+
+	var tags []*domain.Tag
+
+	numTags, err := tagManager.CountAll(ctx)
+	if err != nil {
+		return
+	}
+
+	if numTags > 0 {
+		tags, err = tagManager.GetAll(ctx)
+		if err != nil {
+			return
+		}
+	}
+
+	tagsByID := make(map[int64]*domain.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByID[tag.ID] = tag
+	}
+
+	for _, bookmark := range bookmarks {
+		netscapeBookmark := NetscapeBookmark{
+			URL:          bookmark.URL,
+			AddDate:      bookmark.CreatedAt,
+			LastModified: bookmark.UpdatedAt,
+		}
+
+		if bookmark.Title.HasValue {
+			netscapeBookmark.Title = bookmark.Title.Wrappee
+		}
+
+		if len(bookmark.TagIDs) == 0 {
+			netscapeBookmarks = append(netscapeBookmarks, netscapeBookmark)
+
+			continue
+		}
+
+		for _, tagID := range bookmark.TagIDs {
+			tag, ok := tagsByID[tagID]
+			if !ok {
+				err = helper.NonExistentDependencyError{Inner: fmt.Errorf("tag with ID %v does not exist", tagID)}
+				m.Logger.Error(err)
+
+				return
+			}
+
+			netscapeBookmark.Folders, err = getTagPathNames(tag, tagsByID)
+			if err != nil {
+				m.Logger.Error(err)
+
+				return
+			}
+
+			netscapeBookmarks = append(netscapeBookmarks, netscapeBookmark)
+		}
+	}
+
+	return
+}
+
+// getOrAddTagsFromFolders makes sure there is a tag for every folder path and returns the leaf tag IDs by their path.
+// Folder names containing the tag path separator are rejected before any tag is added.
+func getOrAddTagsFromFolders(ctx context.Context, tagManager *libtags.TagManager, netscapeBookmarks []NetscapeBookmark) (tagIDsByPath map[string]int64, err error) {
+	for _, netscapeBookmark := range netscapeBookmarks {
+		for _, folder := range netscapeBookmark.Folders {
+			if strings.Contains(folder, libtags.PathSeparator) {
+				return nil, libtags.InvalidTagNameError{Name: folder}
+			}
+		}
+	}
+
+	tagIDsByPath = make(map[string]int64)
+
+	for _, netscapeBookmark := range netscapeBookmarks {
+		if len(netscapeBookmark.Folders) == 0 {
+			continue
+		}
+
+		path := strings.Join(netscapeBookmark.Folders, libtags.PathSeparator)
+		if _, ok := tagIDsByPath[path]; ok {
+			continue
+		}
+
+		var tag *domain.Tag
+
+		tag, err = tagManager.AddPath(ctx, path)
+		if err != nil {
+			return
+		}
+
+		tagIDsByPath[path] = tag.ID
+	}
+
+	return
+}
+
+func getTagPathNames(tag *domain.Tag, tagsByID map[int64]*domain.Tag) (pathNames []string, err error) {
+	for _, parentID := range tag.ParentPathIDs {
+		parent, ok := tagsByID[parentID]
+		if !ok {
+			err = helper.NonExistentDependencyError{Inner: fmt.Errorf("tag with ID %v does not exist", parentID)}
+
+			return
+		}
+
+		pathNames = append(pathNames, parent.Tag)
+	}
+
+	pathNames = append(pathNames, tag.Tag)
+
+	return
+}
@@ -0,0 +1,104 @@
+package libbookmarks_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/internal/config"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLibbookmarksParseNetscapeBookmarks(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		bookmarks []libbookmarks.NetscapeBookmark
+	}{
+		{
+			name:  "empty file",
+			input: "",
+		},
+		{
+			name:  "no folders",
+			input: `<DL><p><DT><A HREF="https://example.com" ADD_DATE="1654500000" LAST_MODIFIED="1654600000">Example</A></DL><p>`,
+			bookmarks: []libbookmarks.NetscapeBookmark{
+				{URL: "https://example.com", Title: "Example", AddDate: time.Unix(1654500000, 0).UTC(), LastModified: time.Unix(1654600000, 0).UTC(), Folders: []string{}},
+			},
+		},
+		{
+			name: "nested folders",
+			input: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+    <DT><H3 ADD_DATE="1654500000">foo</H3>
+    <DL><p>
+        <DT><H3>bar &amp; baz</H3>
+        <DL><p>
+            <DT><A HREF="https://example.com/bar">Bar</A>
+        </DL><p>
+        <DT><A HREF="https://example.com/foo">Foo</A>
+    </DL><p>
+    <DT><A HREF="https://example.com"></A>
+</DL><p>
+`,
+			bookmarks: []libbookmarks.NetscapeBookmark{
+				{URL: "https://example.com/bar", Title: "Bar", Folders: []string{"foo", "bar & baz"}},
+				{URL: "https://example.com/foo", Title: "Foo", Folders: []string{"foo"}},
+				{URL: "https://example.com", Folders: []string{}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			bookmarks, err := libbookmarks.ParseNetscapeBookmarks(strings.NewReader(test.input))
+			assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			assert.Equal(t, test.bookmarks, bookmarks, test.name+", assert parsed bookmarks match")
+
+			var serialized strings.Builder
+
+			err = libbookmarks.WriteNetscapeBookmarks(&serialized, bookmarks)
+			assert.NoError(t, err, test.name+", assert writing bookmarks does not error")
+
+			reparsedBookmarks, err := libbookmarks.ParseNetscapeBookmarks(strings.NewReader(serialized.String()))
+			assert.NoError(t, err, test.name+", assert reparsing bookmarks does not error")
+			assert.Equal(t, test.bookmarks, reparsedBookmarks, test.name+", assert reparsed bookmarks match")
+		})
+	}
+}
+
+func TestLibbookmarksImportNetscapeBookmarksFolderWithPathSeparator(t *testing.T) {
+	db, err := testCommon.GetDB()
+	assert.NoError(t, err, "assert db creation")
+
+	configManager, err := config.NewConfigManager(&testCommon.Buffer{}, db, afero.NewMemMapFs())
+	assert.NoError(t, err, "assert config manager creation")
+
+	bntpBackend, err := configManager.NewBackendFromConfig()
+	assert.NoError(t, err, "assert backend creation")
+
+	netscapeBookmarks := []libbookmarks.NetscapeBookmark{
+		{URL: "https://example.com/foo", Folders: []string{"foo"}},
+		{URL: "https://example.com/bar", Folders: []string{"foo", "bar::baz"}},
+	}
+
+	_, err = bntpBackend.BookmarkManager.ImportNetscapeBookmarks(context.Background(), &bntpBackend.TagManager, netscapeBookmarks)
+	assert.ErrorIs(t, err, libtags.InvalidTagNameError{}, "assert folder name is rejected")
+
+	numTags, err := bntpBackend.TagManager.CountAll(context.Background())
+	assert.NoError(t, err, "assert counting tags")
+	assert.Zero(t, numTags, "assert no tags were added")
+
+	numBookmarks, err := bntpBackend.BookmarkManager.CountAll(context.Background())
+	assert.NoError(t, err, "assert counting bookmarks")
+	assert.Zero(t, numBookmarks, "assert no bookmarks were added")
+}
@@ -0,0 +1,117 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libbookmarks
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+)
+
+var trashFilters = bntp.TrashFilters[domain.Bookmark, domain.BookmarkFilter, domain.BookmarkUpdater]{
+	Trashed: func() *domain.BookmarkFilter {
+		filter := *domain.PredefinedBookmarkFilters[domain.BookmarkFilterDeleted]
+
+		return &filter
+	},
+	NotTrashed: notTrashedFilter,
+	And: func(filters ...*domain.BookmarkFilter) *domain.BookmarkFilter {
+		return &domain.BookmarkFilter{And: filters}
+	},
+	Or: func(filters ...*domain.BookmarkFilter) *domain.BookmarkFilter {
+		return &domain.BookmarkFilter{Or: filters}
+	},
+	FiltersByDeletedAt: func(filter *domain.BookmarkFilter) bool {
+		return filter.DeletedAt.HasValue
+	},
+	Nested: func(filter *domain.BookmarkFilter) []*domain.BookmarkFilter {
+		return append(append([]*domain.BookmarkFilter{filter.Not}, filter.And...), filter.Or...)
+	},
+	ID: (*domain.Bookmark).GetID,
+	IDIn: func(ids []int64) *domain.BookmarkFilter {
+		return &domain.BookmarkFilter{ID: optional.Make(model.FilterOperation[int64]{
+			Operator: model.FilterIn,
+			Operand:  model.ListOperand[int64]{Operands: ids},
+		})}
+	},
+	SetDeletedAt: func(deletedAt optional.Optional[time.Time]) *domain.BookmarkUpdater {
+		return &domain.BookmarkUpdater{DeletedAt: optional.Make(model.UpdateOperation[optional.Optional[time.Time]]{
+			Operator: model.UpdateSet,
+			Operand:  deletedAt,
+		})}
+	},
+}
+
+// ExcludeTrashed restricts filter to bookmarks, which are not trashed, unless it filters by DeletedAt itself.
+// All queries of the BookmarkManager apply it, so trashed bookmarks can only be retrieved by filtering for them.
+func ExcludeTrashed(filter *domain.BookmarkFilter) *domain.BookmarkFilter {
+	return trashFilters.ExcludeTrashed(filter)
+}
+
+// IncludeTrashed makes filter match bookmarks whether they are trashed or not.
+func IncludeTrashed(filter *domain.BookmarkFilter) *domain.BookmarkFilter {
+	return trashFilters.IncludeTrashed(filter)
+}
+
+// TrashedFilter restricts filter to trashed bookmarks.
+func TrashedFilter(filter *domain.BookmarkFilter) *domain.BookmarkFilter {
+	return trashFilters.TrashedFilter(filter)
+}
+
+func notTrashedFilter() *domain.BookmarkFilter {
+	filter := *domain.PredefinedBookmarkFilters[domain.BookmarkFilterNotDeleted]
+
+	return &filter
+}
+
+func (m *BookmarkManager) trash() bntp.Trash[domain.Bookmark, domain.BookmarkFilter, domain.BookmarkUpdater] {
+	return bntp.Trash[domain.Bookmark, domain.BookmarkFilter, domain.BookmarkUpdater]{
+		Filters:    trashFilters,
+		Hooks:      m.Hooks,
+		Repository: m.Repository,
+		Logger:     m.Logger,
+		Cache:      m.Cache,
+	}
+}
+
+// Restore moves trashed bookmarks out of the trash.
+func (m *BookmarkManager) Restore(ctx context.Context, bookmarks []*domain.Bookmark) error {
+	return m.trash().Restore(ctx, bookmarks)
+}
+
+// RestoreWhere moves the trashed bookmarks matching bookmarkFilter out of the trash.
+func (m *BookmarkManager) RestoreWhere(ctx context.Context, bookmarkFilter *domain.BookmarkFilter) (numAffectedRecords int64, err error) {
+	return m.trash().RestoreWhere(ctx, bookmarkFilter)
+}
+
+// Purge deletes bookmarks permanently, whether they are trashed or not.
+func (m *BookmarkManager) Purge(ctx context.Context, bookmarks []*domain.Bookmark) error {
+	return m.trash().Purge(ctx, bookmarks)
+}
+
+// PurgeWhere permanently deletes the trashed bookmarks matching bookmarkFilter.
+func (m *BookmarkManager) PurgeWhere(ctx context.Context, bookmarkFilter *domain.BookmarkFilter) (numAffectedRecords int64, err error) {
+	return m.trash().PurgeWhere(ctx, bookmarkFilter)
+}
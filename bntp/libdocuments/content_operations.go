@@ -30,6 +30,7 @@ import (
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/goaoi"
 	"github.com/JonasMuehlmann/goaoi/functional"
+	"golang.org/x/exp/slices"
 )
 
 type DocumentContentEntity string
@@ -248,6 +249,9 @@ func AddTags(ctx context.Context, content string, tags []string) (newContent str
 	// No tag line exists, and line is end of file
 	if iTagLine == len(lines) {
 		lines = append(lines, strings.Join(tags, ","))
+	} else if strings.HasPrefix(lines[iTagLine], "#") {
+		// No tag line exists, and line is the next heading
+		lines = append(lines[:iTagLine:iTagLine], append([]string{strings.Join(tags, ",")}, lines[iTagLine:]...)...)
 	} else {
 		// Tag line has some tags, make sure not to break enumeration syntax
 		if lines[iTagLine] != "" {
@@ -286,7 +290,7 @@ func RemoveTags(ctx context.Context, content string, tags []string) (newContent
 		return
 	}
 
-	if iTagLine == len(lines) {
+	if iTagLine == len(lines) || strings.HasPrefix(lines[iTagLine], "#") {
 		return "", helper.IneffectiveOperationError{Inner: helper.NonExistentDependencyError{Inner: EmptyEntitiesListError{Entity: DocumentContentEntityTag}}}
 
 	}
@@ -304,7 +308,89 @@ func RemoveTags(ctx context.Context, content string, tags []string) (newContent
 		return
 	}
 
-	lines[iTagLine] = strings.Join(lineTags, "\n")
+	lines[iTagLine] = strings.Join(lineTags, ",")
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// ParseTags returns the tags listed on the line after the "# Tags" heading of content.
+func ParseTags(content string) (tags []string, err error) {
+	lines := strings.Split(content, "\n")
+
+	iTagLine, err := findTagsLine(lines)
+	if err != nil {
+		if errors.Is(err, goaoi.ElementNotFoundError{}) {
+			err = DocumentSyntaxError{Inner: TagsHeaderNotFoundError{Inner: err}}
+		}
+
+		return
+	}
+
+	tags = []string{}
+
+	if iTagLine == len(lines) || strings.HasPrefix(lines[iTagLine], "#") {
+		return
+	}
+
+	for _, tag := range strings.Split(lines[iTagLine], ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return
+}
+
+// RenameTags replaces the tags listed on the line after the "# Tags" heading of content according to tagChanges,
+// which maps old tag paths to new ones. Tags renamed to a tag already listed are removed.
+func RenameTags(content string, tagChanges map[string]string) (newContent string, err error) {
+	lines := strings.Split(content, "\n")
+
+	iTagLine, err := findTagsLine(lines)
+	if err != nil {
+		if errors.Is(err, goaoi.ElementNotFoundError{}) {
+			err = DocumentSyntaxError{Inner: TagsHeaderNotFoundError{Inner: err}}
+		}
+
+		return
+	}
+
+	if iTagLine == len(lines) || strings.HasPrefix(lines[iTagLine], "#") {
+		return content, nil
+	}
+
+	lineTags := strings.Split(lines[iTagLine], ",")
+	newTags := make([]string, 0, len(lineTags))
+
+	for i, lineTag := range lineTags {
+		tag := strings.TrimSpace(lineTag)
+
+		if newTag, ok := tagChanges[tag]; ok {
+			lineTags[i] = strings.Replace(lineTag, tag, newTag, 1)
+			tag = newTag
+		}
+
+		newTags = append(newTags, tag)
+	}
+
+	isRenamedTag := make(map[string]bool, len(tagChanges))
+	for _, newTag := range tagChanges {
+		isRenamedTag[newTag] = true
+	}
+
+	newLineTags := make([]string, 0, len(lineTags))
+
+	for i, lineTag := range lineTags {
+		// Only the first of the tags listed multiple times because of renaming is kept
+		if isRenamedTag[newTags[i]] && slices.Index(newTags, newTags[i]) != i {
+			continue
+		}
+
+		newLineTags = append(newLineTags, lineTag)
+	}
+
+	lines[iTagLine] = strings.Join(newLineTags, ",")
 
 	return strings.Join(lines, "\n"), nil
 }
@@ -340,26 +426,12 @@ func AddLinks(ctx context.Context, content string, links []string) (newContent s
 	}
 
 	iLinksLinesEnd, err := goaoi.FindIfSlice(lines[iLinksLinesStart:], unary_predicate)
-	if errors.Is(err, goaoi.EmptyIterableError{}) {
-		// No links exist yet, make space for new ones
-		linesNew := make([]string, 0, len(lines)+len(links))
-		linesNew = append(linesNew, lines...)
-		lines = linesNew
-
-		err = nil
-	}
-	if err != nil && !errors.Is(err, goaoi.ElementNotFoundError{}) {
+	if errors.Is(err, goaoi.ElementNotFoundError{}) || errors.Is(err, goaoi.EmptyIterableError{}) {
+		iLinksLinesEnd = len(lines)
+	} else if err != nil {
 		return
-	}
-
-	var linesAfterLinkLine []string
-	var linesBeforeLinksLineEnd []string
-
-	if !errors.Is(err, goaoi.ElementNotFoundError{}) {
-		linesAfterLinkLine = lines[iLinksLinesEnd+1:]
-		linesBeforeLinksLineEnd = lines[:iLinksLinesEnd+1]
 	} else {
-		linesBeforeLinksLineEnd = lines
+		iLinksLinesEnd += iLinksLinesStart
 	}
 
 	transformer := func(link string) string {
@@ -371,7 +443,8 @@ func AddLinks(ctx context.Context, content string, links []string) (newContent s
 		return
 	}
 
-	lines = append(linesBeforeLinksLineEnd, append(newLinksLines, linesAfterLinkLine...)...)
+	// Limit the capacity so that appending does not overwrite the following lines
+	lines = append(lines[:iLinksLinesEnd:iLinksLinesEnd], append(newLinksLines, lines[iLinksLinesEnd:]...)...)
 
 	return strings.Join(lines, "\n"), nil
 }
@@ -438,7 +511,8 @@ func RemoveLinks(ctx context.Context, content string, links []string) (newConten
 	if err != nil {
 		return
 	}
-	if len(newLinksLines) == 0 {
+	// Keep the line break after the heading if the section ends the document
+	if len(newLinksLines) == 0 && iLinksLinesEnd == len(lines) {
 		lines = append(lines, "")
 	}
 
@@ -478,26 +552,12 @@ func AddBacklinks(ctx context.Context, content string, links []string) (newConte
 	}
 
 	iBacklinksLinesEnd, err := goaoi.FindIfSlice(lines[iBacklinksLinesStart:], unary_predicate)
-	if errors.Is(err, goaoi.EmptyIterableError{}) {
-		// No links exist yet, make space for new ones
-		linesNew := make([]string, 0, len(lines)+len(links))
-		linesNew = append(linesNew, lines...)
-		lines = linesNew
-
-		err = nil
-	}
-	if err != nil && !errors.Is(err, goaoi.ElementNotFoundError{}) {
+	if errors.Is(err, goaoi.ElementNotFoundError{}) || errors.Is(err, goaoi.EmptyIterableError{}) {
+		iBacklinksLinesEnd = len(lines)
+	} else if err != nil {
 		return
-	}
-
-	var linesAfterBacklinkLine []string
-	var linesBeforeBacklinksLineEnd []string
-
-	if !errors.Is(err, goaoi.ElementNotFoundError{}) {
-		linesAfterBacklinkLine = lines[iBacklinksLinesEnd+1:]
-		linesBeforeBacklinksLineEnd = lines[:iBacklinksLinesEnd+1]
 	} else {
-		linesBeforeBacklinksLineEnd = lines
+		iBacklinksLinesEnd += iBacklinksLinesStart
 	}
 
 	transformer := func(link string) string {
@@ -509,7 +569,8 @@ func AddBacklinks(ctx context.Context, content string, links []string) (newConte
 		return
 	}
 
-	lines = append(linesBeforeBacklinksLineEnd, append(newBacklinksLines, linesAfterBacklinkLine...)...)
+	// Limit the capacity so that appending does not overwrite the following lines
+	lines = append(lines[:iBacklinksLinesEnd:iBacklinksLinesEnd], append(newBacklinksLines, lines[iBacklinksLinesEnd:]...)...)
 
 	return strings.Join(lines, "\n"), nil
 }
@@ -576,7 +637,8 @@ func RemoveBacklinks(ctx context.Context, content string, links []string) (newCo
 	if err != nil {
 		return
 	}
-	if len(newBacklinksLines) == 0 {
+	// Keep the line break after the heading if the section ends the document
+	if len(newBacklinksLines) == 0 && iBacklinksLinesEnd == len(lines) {
 		lines = append(lines, "")
 	}
 
@@ -61,6 +61,12 @@ func TestAddTags(t *testing.T) {
 			content:            "# Tags\nfoo,bar",
 			expectedNewContent: "# Tags\nfoo,bar,baz",
 		},
+		{
+			name:               "add before next section",
+			tagsToAdd:          []string{"foo"},
+			content:            "# Tags\n# Links",
+			expectedNewContent: "# Tags\nfoo\n# Links",
+		},
 	}
 
 	for _, test := range tests {
@@ -128,6 +134,12 @@ func TestRemoveTags(t *testing.T) {
 			content:            "# Tags\nfoo,bar",
 			expectedNewContent: "# Tags\nfoo",
 		},
+		{
+			name:               "remove one of three",
+			tagsToRemove:       []string{"bar"},
+			content:            "# Tags\nfoo,bar,baz\n# Links",
+			expectedNewContent: "# Tags\nfoo,baz\n# Links",
+		},
 		{
 			name:               "remove all",
 			tagsToRemove:       []string{"foo", "bar"},
@@ -162,6 +174,111 @@ func TestRemoveTags(t *testing.T) {
 	}
 }
 
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		err          error
+		name         string
+		content      string
+		expectedTags []string
+	}{
+		{
+			name:    "no tag line",
+			content: "# Links\n# Backlinks\n",
+			err:     libdocuments.DocumentSyntaxError{},
+		},
+		{
+			name:         "no tags",
+			content:      "# Tags",
+			expectedTags: []string{},
+		},
+		{
+			name:         "no tags before next section",
+			content:      "# Tags\n# Links",
+			expectedTags: []string{},
+		},
+		{
+			name:         "tags",
+			content:      "# Tags\nfoo, bar::baz,\n# Links",
+			expectedTags: []string{"foo", "bar::baz"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			tags, err := libdocuments.ParseTags(test.content)
+			assert.Equal(t, test.expectedTags, tags, test.name+", assert tags match expected")
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
+
+func TestRenameTags(t *testing.T) {
+	tests := []struct {
+		err                error
+		name               string
+		content            string
+		tagChanges         map[string]string
+		expectedNewContent string
+	}{
+		{
+			name:       "no tag line",
+			content:    "# Links\n# Backlinks\n",
+			tagChanges: map[string]string{"foo": "bar"},
+			err:        libdocuments.DocumentSyntaxError{},
+		},
+		{
+			name:               "no tags",
+			content:            "# Tags\n# Links",
+			tagChanges:         map[string]string{"foo": "bar"},
+			expectedNewContent: "# Tags\n# Links",
+		},
+		{
+			name:               "rename tags",
+			content:            "# Tags\nfoo, foo::bar,baz\n# Links",
+			tagChanges:         map[string]string{"foo": "qux", "foo::bar": "qux::bar"},
+			expectedNewContent: "# Tags\nqux, qux::bar,baz\n# Links",
+		},
+		{
+			name:               "rename to listed tag",
+			content:            "# Tags\nfoo,bar,baz,bar\n# Links",
+			tagChanges:         map[string]string{"baz": "foo"},
+			expectedNewContent: "# Tags\nfoo,bar,bar\n# Links",
+		},
+		{
+			name:               "rename to later listed tag",
+			content:            "# Tags\nbaz,bar,foo\n# Links",
+			tagChanges:         map[string]string{"baz": "foo"},
+			expectedNewContent: "# Tags\nfoo,bar\n# Links",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			newContent, err := libdocuments.RenameTags(test.content, test.tagChanges)
+			assert.Equal(t, test.expectedNewContent, newContent, test.name+", assert new content matches expected")
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
+
 func TestAddLinks(t *testing.T) {
 	tests := []struct {
 		err                error
@@ -213,6 +330,12 @@ func TestAddLinks(t *testing.T) {
 			content:            "# Links\n- (foo)[foo]\n- (bar)[bar]\n- (baz)[bar]",
 			expectedNewContent: "# Links\n- (foo)[foo]\n- (bar)[bar]\n- (baz)[bar]\n- (baz)[baz]",
 		},
+		{
+			name:               "add before next section",
+			linksToAdd:         []string{"bar"},
+			content:            "# Tags\n# Links\n- (foo)[foo]\n# Backlinks\n- (baz)[baz]",
+			expectedNewContent: "# Tags\n# Links\n- (foo)[foo]\n- (bar)[bar]\n# Backlinks\n- (baz)[baz]",
+		},
 	}
 
 	for _, test := range tests {
@@ -365,6 +488,12 @@ func TestAddBacklinks(t *testing.T) {
 			content:            "# Backlinks\n- (foo)[foo]\n- (bar)[bar]\n- (baz)[bar]",
 			expectedNewContent: "# Backlinks\n- (foo)[foo]\n- (bar)[bar]\n- (baz)[bar]\n- (baz)[baz]",
 		},
+		{
+			name:               "add before body",
+			linksToAdd:         []string{"bar"},
+			content:            "# Links\n- (baz)[baz]\n# Backlinks\n- (foo)[foo]\n\nbody",
+			expectedNewContent: "# Links\n- (baz)[baz]\n# Backlinks\n- (foo)[foo]\n- (bar)[bar]\n\nbody",
+		},
 	}
 
 	for _, test := range tests {
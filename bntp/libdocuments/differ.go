@@ -28,70 +28,43 @@ import (
 
 // TODO: Add error logging
 
-func GetAddedLinks(old *domain.Document, new *domain.Document) (addedLinkIDs []int64, err error) {
-	predicate := func(oldLink int64) bool {
-		_, err := goaoi.FindIfSlice(new.LinkedDocumentIDs, functional.AreEqualPartial(oldLink))
+// difference returns the elements of a, which are not in b.
+// If there are no such elements, goaoi.EmptyIterableError is returned.
+func difference(a []int64, b []int64) ([]int64, error) {
+	predicate := func(x int64) bool {
+		_, err := goaoi.FindIfSlice(b, functional.AreEqualPartial(x))
 
-		return err == nil
+		return err != nil
 	}
 
-	addedLinkIDs, err = goaoi.TakeIfSlice(old.LinkedDocumentIDs, predicate)
+	diff, err := goaoi.TakeIfSlice(a, predicate)
+	if err == nil && len(diff) == 0 {
+		err = goaoi.EmptyIterableError{}
+	}
 
-	return
+	return diff, err
 }
 
-func GetRemovedLinks(old *domain.Document, new *domain.Document) (removedLinkIDs []int64, err error) {
-	predicate := func(oldLink int64) bool {
-		_, err := goaoi.FindIfSlice(new.LinkedDocumentIDs, functional.AreEqualPartial(oldLink))
-
-		return err == nil
-	}
-
-	removedLinkIDs, err = goaoi.TakeIfSlice(old.LinkedDocumentIDs, predicate)
+func GetAddedLinks(old *domain.Document, new *domain.Document) (addedLinkIDs []int64, err error) {
+	return difference(new.LinkedDocumentIDs, old.LinkedDocumentIDs)
+}
 
-	return
+func GetRemovedLinks(old *domain.Document, new *domain.Document) (removedLinkIDs []int64, err error) {
+	return difference(old.LinkedDocumentIDs, new.LinkedDocumentIDs)
 }
 
 func GetAddedBacklinks(old *domain.Document, new *domain.Document) (addedBacklinkIDs []int64, err error) {
-	predicate := func(oldBacklink int64) bool {
-		_, err := goaoi.FindIfSlice(new.BacklinkedDocumentsIDs, functional.AreEqualPartial(oldBacklink))
-
-		return err == nil
-	}
-
-	addedBacklinkIDs, err = goaoi.TakeIfSlice(old.BacklinkedDocumentsIDs, predicate)
-	return
+	return difference(new.BacklinkedDocumentsIDs, old.BacklinkedDocumentsIDs)
 }
 
 func GetRemovedBacklinks(old *domain.Document, new *domain.Document) (removedBacklinkIDs []int64, err error) {
-	predicate := func(oldBacklink int64) bool {
-		_, err := goaoi.FindIfSlice(new.BacklinkedDocumentsIDs, functional.AreEqualPartial(oldBacklink))
-
-		return err == nil
-	}
-
-	removedBacklinkIDs, err = goaoi.TakeIfSlice(old.BacklinkedDocumentsIDs, predicate)
-	return
+	return difference(old.BacklinkedDocumentsIDs, new.BacklinkedDocumentsIDs)
 }
 
 func GetAddedTags(old *domain.Document, new *domain.Document) (addedTagIDs []int64, err error) {
-	predicate := func(oldTag int64) bool {
-		_, err := goaoi.FindIfSlice(new.TagIDs, functional.AreEqualPartial(oldTag))
-
-		return err == nil
-	}
-
-	addedTagIDs, err = goaoi.TakeIfSlice(old.TagIDs, predicate)
-	return
+	return difference(new.TagIDs, old.TagIDs)
 }
 
 func GetRemovedTags(old *domain.Document, new *domain.Document) (removedTagIDs []int64, err error) {
-	predicate := func(oldTag int64) bool {
-		_, err := goaoi.FindIfSlice(new.TagIDs, functional.AreEqualPartial(oldTag))
-
-		return err == nil
-	}
-
-	removedTagIDs, err = goaoi.TakeIfSlice(old.TagIDs, predicate)
-	return
+	return difference(old.TagIDs, new.TagIDs)
 }
@@ -39,13 +39,16 @@ import (
 
 type DocumentContentManager struct {
 	Repository repository.DocumentContentRepository
-	Hooks      *bntp.Hooks[string]
-	Logger     *log.Logger
+	// SearchRepository is kept in sync with all content changes, it is optional.
+	SearchRepository repository.DocumentContentSearchRepository
+	Hooks            *bntp.Hooks[string]
+	Logger           *log.Logger
 }
 
-func NewDocumentContentManager(logger *log.Logger, hooks *bntp.Hooks[string], repository repository.DocumentContentRepository) (DocumentContentManager, error) {
+func NewDocumentContentManager(logger *log.Logger, hooks *bntp.Hooks[string], repository repository.DocumentContentRepository, searchRepository repository.DocumentContentSearchRepository) (DocumentContentManager, error) {
 	m := DocumentContentManager{}
 	m.Repository = repository
+	m.SearchRepository = searchRepository
 	m.Hooks = hooks
 	m.Logger = logger
 
@@ -62,12 +65,14 @@ func (m *DocumentContentManager) Add(ctx context.Context, pathContents []tuple.T
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Add(ctx, pathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, pathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterAddHook))
@@ -88,12 +93,14 @@ func (m *DocumentContentManager) Update(ctx context.Context, pathContents []tupl
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Update(ctx, pathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, pathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterUpdateHook))
@@ -114,12 +121,14 @@ func (m *DocumentContentManager) Move(ctx context.Context, pathChanges []tuple.T
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Move(ctx, pathChanges)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.moveIndexedContents(ctx, pathChanges)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterUpdateHook))
@@ -138,12 +147,93 @@ func (m *DocumentContentManager) Delete(ctx context.Context, paths []string) err
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Delete(ctx, paths)
 	if err != nil {
 		m.Logger.Error(err)
+	} else {
+		m.deleteIndexedContents(ctx, paths)
+	}
+
+	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return err
+}
+
+// Trash moves the contents at paths into the trash area of the repository, paths without content are skipped.
+func (m *DocumentContentManager) Trash(ctx context.Context, paths []string) error {
+	hookErr := goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.BeforeAnyHook|bntp.BeforeDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		return hookErr
+	}
 
+	err := m.Repository.Trash(ctx, paths)
+	if err != nil {
+		m.Logger.Error(err)
+	} else {
+		m.deleteIndexedContents(ctx, paths)
+	}
+
+	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return err
+}
+
+// Restore moves trashed contents back to their paths, paths without trashed content are skipped.
+func (m *DocumentContentManager) Restore(ctx context.Context, paths []string) error {
+	hookErr := goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.BeforeAnyHook|bntp.BeforeUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		return hookErr
+	}
+
+	err := m.Repository.Restore(ctx, paths)
+	if err != nil {
+		m.Logger.Error(err)
+	} else {
+		m.reindexContents(ctx, paths)
+	}
+
+	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return err
+}
+
+// Purge deletes trashed contents, paths without trashed content are skipped.
+func (m *DocumentContentManager) Purge(ctx context.Context, paths []string) error {
+	hookErr := goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.BeforeAnyHook|bntp.BeforeDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		return hookErr
+	}
+
+	err := m.Repository.Purge(ctx, paths)
+	if err != nil {
+		m.Logger.Error(err)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
@@ -161,6 +251,10 @@ func (m *DocumentContentManager) Get(ctx context.Context, paths []string) (conte
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
 	contents, err = m.Repository.Get(ctx, paths)
@@ -186,6 +280,7 @@ func (m *DocumentContentManager) AddTags(ctx context.Context, pathTags []tuple.T
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -195,7 +290,7 @@ func (m *DocumentContentManager) AddTags(ctx context.Context, pathTags []tuple.T
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -214,7 +309,8 @@ func (m *DocumentContentManager) AddTags(ctx context.Context, pathTags []tuple.T
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterAddHook))
@@ -236,6 +332,7 @@ func (m *DocumentContentManager) RemoveTags(ctx context.Context, pathTags []tupl
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -245,7 +342,7 @@ func (m *DocumentContentManager) RemoveTags(ctx context.Context, pathTags []tupl
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -264,7 +361,8 @@ func (m *DocumentContentManager) RemoveTags(ctx context.Context, pathTags []tupl
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
@@ -286,6 +384,7 @@ func (m *DocumentContentManager) AddLinks(ctx context.Context, pathLinks []tuple
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -295,7 +394,7 @@ func (m *DocumentContentManager) AddLinks(ctx context.Context, pathLinks []tuple
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -314,7 +413,8 @@ func (m *DocumentContentManager) AddLinks(ctx context.Context, pathLinks []tuple
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterAddHook))
@@ -336,6 +436,7 @@ func (m *DocumentContentManager) RemoveLinks(ctx context.Context, pathLinks []tu
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -345,7 +446,7 @@ func (m *DocumentContentManager) RemoveLinks(ctx context.Context, pathLinks []tu
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -364,7 +465,8 @@ func (m *DocumentContentManager) RemoveLinks(ctx context.Context, pathLinks []tu
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
@@ -386,6 +488,7 @@ func (m *DocumentContentManager) AddBackLinks(ctx context.Context, pathBacklinks
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -395,7 +498,7 @@ func (m *DocumentContentManager) AddBackLinks(ctx context.Context, pathBacklinks
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -414,7 +517,8 @@ func (m *DocumentContentManager) AddBackLinks(ctx context.Context, pathBacklinks
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterAddHook))
@@ -436,6 +540,7 @@ func (m *DocumentContentManager) RemoveBackLinks(ctx context.Context, pathBackli
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	contents, err := m.Repository.Get(ctx, paths)
@@ -445,7 +550,7 @@ func (m *DocumentContentManager) RemoveBackLinks(ctx context.Context, pathBackli
 		return err
 	}
 
-	newContents := make([]string, len(contents))
+	newContents := make([]string, 0, len(contents))
 
 	contentTags := tuple.T2[[]string, [][]string]{V1: contents, V2: soa.V2}
 	for i := range contentTags.V1 {
@@ -464,7 +569,8 @@ func (m *DocumentContentManager) RemoveBackLinks(ctx context.Context, pathBackli
 	err = m.Repository.Update(ctx, newPathContents)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		m.indexContents(ctx, newPathContents)
 	}
 
 	hookErr = goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterDeleteHook))
@@ -521,7 +627,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromNewModels(ctx context
 
 		if !errors.Is(err, goaoi.EmptyIterableError{}) {
 			addedLinkDocuments, err := documentManager.GetWhere(ctx, &domain.DocumentFilter{
-				LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				ID: optional.Make(model.FilterOperation[int64]{
 					Operator: model.FilterIn,
 					Operand:  model.ListOperand[int64]{addedLinkIDs}}),
 			})
@@ -550,7 +656,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromNewModels(ctx context
 
 		if !errors.Is(err, goaoi.EmptyIterableError{}) {
 			removedLinkDocuments, err := documentManager.GetWhere(ctx, &domain.DocumentFilter{
-				LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				ID: optional.Make(model.FilterOperation[int64]{
 					Operator: model.FilterIn,
 					Operand:  model.ListOperand[int64]{removedLinkIDs}}),
 			})
@@ -579,7 +685,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromNewModels(ctx context
 
 		if !errors.Is(err, goaoi.EmptyIterableError{}) {
 			addedBacklinkDocuments, err := documentManager.GetWhere(ctx, &domain.DocumentFilter{
-				LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				ID: optional.Make(model.FilterOperation[int64]{
 					Operator: model.FilterIn,
 					Operand:  model.ListOperand[int64]{addedBacklinkIDs}}),
 			})
@@ -608,7 +714,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromNewModels(ctx context
 
 		if !errors.Is(err, goaoi.EmptyIterableError{}) {
 			removedBacklinkDocuments, err := documentManager.GetWhere(ctx, &domain.DocumentFilter{
-				LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				ID: optional.Make(model.FilterOperation[int64]{
 					Operator: model.FilterIn,
 					Operand:  model.ListOperand[int64]{removedBacklinkIDs}}),
 			})
@@ -687,41 +793,41 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromNewModels(ctx context
 		}
 	}
 
-	err = m.AddLinks(context.Background(), addedPathLinks)
+	err = m.AddLinks(ctx, addedPathLinks)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) {
 		m.Logger.Error(err)
 
 		return err
 	}
-	err = m.RemoveLinks(context.Background(), removedPathLinks)
+	err = m.RemoveLinks(ctx, removedPathLinks)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) && !errors.Is(err, EmptyEntitiesListError{}) {
 		m.Logger.Error(err)
 
 		return err
 	}
 
-	err = m.AddBackLinks(context.Background(), addedPathBacklinks)
+	err = m.AddBackLinks(ctx, addedPathBacklinks)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) {
 		m.Logger.Error(err)
 
 		return err
 	}
 
-	err = m.RemoveBackLinks(context.Background(), removedPathBacklinks)
+	err = m.RemoveBackLinks(ctx, removedPathBacklinks)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) && !errors.Is(err, EmptyEntitiesListError{}) {
 		m.Logger.Error(err)
 
 		return err
 	}
 
-	err = m.AddTags(context.Background(), addedPathTags)
+	err = m.AddTags(ctx, addedPathTags)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) {
 		m.Logger.Error(err)
 
 		return err
 	}
 
-	err = m.RemoveTags(context.Background(), removedPathTags)
+	err = m.RemoveTags(ctx, removedPathTags)
 	if err != nil && !errors.Is(err, helper.EmptyInputError{}) && !errors.Is(err, EmptyEntitiesListError{}) {
 		m.Logger.Error(err)
 
@@ -772,7 +878,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromFilterAndUpdater(ctx
 				addedPathLinks = append(addedPathLinks, tuple.T2[string, []string]{oldDocument.Path, addedLinks})
 			}
 
-			err = m.AddLinks(context.Background(), addedPathLinks)
+			err = m.AddLinks(ctx, addedPathLinks)
 			if err != nil {
 				m.Logger.Error(err)
 
@@ -817,7 +923,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromFilterAndUpdater(ctx
 				addedPathBacklinks = append(addedPathBacklinks, tuple.T2[string, []string]{oldDocument.Path, addedBacklinks})
 			}
 
-			err = m.AddBackLinks(context.Background(), addedPathBacklinks)
+			err = m.AddBackLinks(ctx, addedPathBacklinks)
 			if err != nil {
 				m.Logger.Error(err)
 
@@ -862,7 +968,7 @@ func (m *DocumentContentManager) UpdateDocumentContentsFromFilterAndUpdater(ctx
 				addedPathTags = append(addedPathTags, tuple.T2[string, []string]{oldDocument.Path, addedTags})
 			}
 
-			err = m.AddTags(context.Background(), addedPathTags)
+			err = m.AddTags(ctx, addedPathTags)
 			if err != nil {
 				m.Logger.Error(err)
 
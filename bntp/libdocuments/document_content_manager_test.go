@@ -28,6 +28,7 @@ import (
 	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
 	fsRepo "github.com/JonasMuehlmann/bntp.go/model/repository/fs"
 	sqlite3Repo "github.com/JonasMuehlmann/bntp.go/model/repository/sqlite3"
 	testCommon "github.com/JonasMuehlmann/bntp.go/test"
@@ -81,7 +82,7 @@ func TestDocumentContentManagerAdd(t *testing.T) {
 
 			repoConcrete = repoAbstract.(*fsRepo.FSDocumentContentRepository)
 
-			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete)
+			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete, nil)
 			assert.NoError(t, err, test.name+", assert manager creation")
 
 			err = manager.Add(context.Background(), test.pathContents)
@@ -222,7 +223,7 @@ func TestDocumentContentManagerUpdateDocumentContentsFromNewModels(t *testing.T)
 
 			repoConcrete = repoAbstract.(*fsRepo.FSDocumentContentRepository)
 
-			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete)
+			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete, nil)
 			assert.NoError(t, err, test.name+", assert document content manager creation")
 
 			//*******************    Setup tag repository    *******************//
@@ -238,7 +239,7 @@ func TestDocumentContentManagerUpdateDocumentContentsFromNewModels(t *testing.T)
 			assert.NoError(t, err, test.name+", assert document repository creation")
 			documentRepoConcrete = documentRepoAbstract.(*sqlite3Repo.Sqlite3DocumentRepository)
 
-			documentManager, err := libdocuments.NewDocumentManager(repoConcrete.Logger, &bntp.Hooks[domain.Document]{}, documentRepoConcrete)
+			documentManager, err := libdocuments.NewDocumentManager(repoConcrete.Logger, &bntp.Hooks[domain.Document]{}, documentRepoConcrete, nil)
 			assert.NoError(t, err, test.name+", assert document manager creation")
 
 			//******************    Add tags and documents    ******************//
@@ -273,3 +274,183 @@ func TestDocumentContentManagerUpdateDocumentContentsFromNewModels(t *testing.T)
 		})
 	}
 }
+
+func TestDocumentContentManagerUnitOfWork(t *testing.T) {
+	tests := []struct {
+		name            string
+		document        *domain.Document
+		pathContents    []tuple.T2[string, string]
+		existingFiles   []tuple.T2[string, string]
+		commit          bool
+		expectCommitted bool
+	}{
+		{
+			name:            "commit",
+			document:        &domain.Document{ID: 1, Path: "Foo"},
+			pathContents:    []tuple.T2[string, string]{{V1: "Foo", V2: "# Tags\n# Links\n# Backlinks"}},
+			commit:          true,
+			expectCommitted: true,
+		},
+		{
+			name:         "rollback",
+			document:     &domain.Document{ID: 1, Path: "Foo"},
+			pathContents: []tuple.T2[string, string]{{V1: "Foo", V2: "# Tags\n# Links\n# Backlinks"}},
+		},
+		{
+			name:          "rollback after failed file write",
+			document:      &domain.Document{ID: 1, Path: "Foo"},
+			pathContents:  []tuple.T2[string, string]{{V1: "Foo", V2: "# Tags\n# Links\n# Backlinks"}},
+			existingFiles: []tuple.T2[string, string]{{V1: "Foo", V2: "old"}},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			fs := afero.NewMemMapFs()
+
+			for _, pathContent := range test.existingFiles {
+				err = afero.WriteFile(fs, pathContent.V1, []byte(pathContent.V2), 0o644)
+				assert.NoError(t, err, test.name+", assert file creation")
+			}
+
+			repoConcrete := &fsRepo.FSDocumentContentRepository{}
+			repoAbstract, err := repoConcrete.New(fsRepo.FSDocumentContentRepositoryConstructorArgs{Fs: fs, Logger: logrus.StandardLogger()})
+			assert.NoError(t, err, test.name+", assert document content repository creation")
+
+			repoConcrete = repoAbstract.(*fsRepo.FSDocumentContentRepository)
+
+			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete, nil)
+			assert.NoError(t, err, test.name+", assert document content manager creation")
+
+			tagRepoConcrete := &sqlite3Repo.Sqlite3TagRepository{}
+			tagRepoAbstract, err := tagRepoConcrete.New(sqlite3Repo.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: logrus.StandardLogger()})
+			assert.NoError(t, err, test.name+", assert tag repository creation")
+
+			documentRepoConcrete := &sqlite3Repo.Sqlite3DocumentRepository{}
+			documentRepoAbstract, err := documentRepoConcrete.New(sqlite3Repo.Sqlite3DocumentRepositoryConstructorArgs{DB: db, TagRepository: tagRepoAbstract.(*sqlite3Repo.Sqlite3TagRepository), Logger: repoConcrete.Logger})
+			assert.NoError(t, err, test.name+", assert document repository creation")
+
+			documentManager, err := libdocuments.NewDocumentManager(repoConcrete.Logger, &bntp.Hooks[domain.Document]{}, documentRepoAbstract.(*sqlite3Repo.Sqlite3DocumentRepository), nil)
+			assert.NoError(t, err, test.name+", assert document manager creation")
+
+			unitOfWork := repository.NewUnitOfWork()
+			ctx := repository.ContextWithUnitOfWork(context.Background(), unitOfWork)
+
+			err = documentManager.Add(ctx, []*domain.Document{test.document})
+			assert.NoError(t, err, test.name+", assert adding document")
+
+			err = manager.Add(ctx, test.pathContents)
+			if test.existingFiles != nil {
+				assert.ErrorIs(t, err, helper.DuplicateInsertionError{}, test.name+", assert adding existing file fails")
+			} else {
+				assert.NoError(t, err, test.name+", assert adding document contents")
+
+				contents, err := manager.Get(ctx, []string{test.pathContents[0].V1})
+				assert.NoError(t, err, test.name+", assert getting staged document contents")
+				assert.Equal(t, []string{test.pathContents[0].V2}, contents, test.name+", assert staged document contents match")
+			}
+
+			isFileWritten, err := afero.Exists(fs, test.pathContents[0].V1)
+			assert.NoError(t, err, test.name+", assert checking file existence")
+			assert.Equal(t, test.existingFiles != nil, isFileWritten, test.name+", assert file is not written before completion")
+
+			if test.commit {
+				err = unitOfWork.Commit()
+				assert.NoError(t, err, test.name+", assert commit")
+			} else {
+				err = unitOfWork.Rollback()
+				assert.NoError(t, err, test.name+", assert rollback")
+			}
+
+			doesExist, err := documentManager.DoesExist(context.Background(), test.document)
+			assert.NoError(t, err, test.name+", assert checking document existence")
+			assert.Equal(t, test.expectCommitted, doesExist, test.name+", assert document existence")
+
+			contents, err := afero.ReadFile(fs, test.pathContents[0].V1)
+			if test.expectCommitted {
+				assert.NoError(t, err, test.name+", assert reading committed file")
+				assert.Equal(t, test.pathContents[0].V2, string(contents), test.name+", assert committed file contents match")
+			} else if test.existingFiles != nil {
+				assert.NoError(t, err, test.name+", assert reading existing file")
+				assert.Equal(t, test.existingFiles[0].V2, string(contents), test.name+", assert existing file is unchanged")
+			} else {
+				assert.Error(t, err, test.name+", assert file does not exist after rollback")
+			}
+		})
+	}
+}
+
+func TestDocumentContentManagerTrash(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		content       string
+		restore       bool
+		expectTrashed bool
+	}{
+		{
+			name:          "trash",
+			path:          "Foo",
+			content:       "# Tags\n# Links\n# Backlinks",
+			expectTrashed: true,
+		},
+		{
+			name:    "restore",
+			path:    "Foo",
+			content: "# Tags\n# Links\n# Backlinks",
+			restore: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			fs := afero.NewMemMapFs()
+
+			err := afero.WriteFile(fs, test.path, []byte(test.content), 0o644)
+			assert.NoError(t, err, test.name+", assert file creation")
+
+			repoConcrete := &fsRepo.FSDocumentContentRepository{}
+			repoAbstract, err := repoConcrete.New(fsRepo.FSDocumentContentRepositoryConstructorArgs{Fs: fs, Logger: logrus.StandardLogger(), TrashDir: "trash"})
+			assert.NoError(t, err, test.name+", assert document content repository creation")
+
+			repoConcrete = repoAbstract.(*fsRepo.FSDocumentContentRepository)
+
+			manager, err := libdocuments.NewDocumentContentManager(repoConcrete.Logger, &bntp.Hooks[string]{}, repoConcrete, nil)
+			assert.NoError(t, err, test.name+", assert document content manager creation")
+
+			err = manager.Trash(context.Background(), []string{test.path})
+			assert.NoError(t, err, test.name+", assert trashing document contents")
+
+			if test.restore {
+				err = manager.Restore(context.Background(), []string{test.path})
+				assert.NoError(t, err, test.name+", assert restoring document contents")
+			}
+
+			doesExist, err := afero.Exists(fs, test.path)
+			assert.NoError(t, err, test.name+", assert checking file existence")
+			assert.Equal(t, !test.expectTrashed, doesExist, test.name+", assert file existence")
+
+			isTrashed, err := afero.Exists(fs, "trash/"+test.path)
+			assert.NoError(t, err, test.name+", assert checking trashed file existence")
+			assert.Equal(t, test.expectTrashed, isTrashed, test.name+", assert trashed file existence")
+
+			err = manager.Purge(context.Background(), []string{test.path})
+			assert.NoError(t, err, test.name+", assert purging document contents")
+
+			isTrashed, err = afero.Exists(fs, "trash/"+test.path)
+			assert.NoError(t, err, test.name+", assert checking purged file existence")
+			assert.False(t, isTrashed, test.name+", assert purged file does not exist")
+		})
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	domain "github.com/JonasMuehlmann/bntp.go/model/domain"
 	repository "github.com/JonasMuehlmann/bntp.go/model/repository"
 	"github.com/JonasMuehlmann/goaoi"
@@ -36,13 +38,16 @@ type DocumentManager struct {
 	Repository repository.DocumentRepository
 	Hooks      *bntp.Hooks[domain.Document]
 	Logger     *log.Logger
+	// Cache is invalidated by all write operations, it is optional.
+	Cache *bntp.ManagerCache[domain.Document]
 }
 
-func NewDocumentManager(logger *log.Logger, hooks *bntp.Hooks[domain.Document], repository repository.DocumentRepository) (DocumentManager, error) {
+func NewDocumentManager(logger *log.Logger, hooks *bntp.Hooks[domain.Document], repository repository.DocumentRepository, cache *bntp.ManagerCache[domain.Document]) (DocumentManager, error) {
 	m := DocumentManager{}
 	m.Repository = repository
 	m.Hooks = hooks
 	m.Logger = logger
+	m.Cache = cache
 
 	return m, nil
 }
@@ -54,9 +59,12 @@ func (m *DocumentManager) Add(ctx context.Context, documents []*domain.Document)
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Add(ctx, documents)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -78,9 +86,12 @@ func (m *DocumentManager) Replace(ctx context.Context, documents []*domain.Docum
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Replace(ctx, documents)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -102,9 +113,12 @@ func (m *DocumentManager) Upsert(ctx context.Context, documents []*domain.Docume
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Upsert(ctx, documents)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -126,9 +140,12 @@ func (m *DocumentManager) Update(ctx context.Context, documents []*domain.Docume
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Update(ctx, documents, documentUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -152,9 +169,14 @@ func (m *DocumentManager) UpdateWhere(ctx context.Context, documentFilter *domai
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	numAffectedRecords, err = m.Repository.UpdateWhere(ctx, documentFilter, documentUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -170,15 +192,19 @@ func (m *DocumentManager) UpdateWhere(ctx context.Context, documentFilter *domai
 	return
 }
 
+// Delete moves documents to the trash, from which they can be restored or purged.
 func (m *DocumentManager) Delete(ctx context.Context, documents []*domain.Document) error {
 	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeDeleteHook))
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
-	err := m.Repository.Delete(ctx, documents)
+	err := m.trash().Trash(ctx, documents)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 	}
@@ -193,6 +219,7 @@ func (m *DocumentManager) Delete(ctx context.Context, documents []*domain.Docume
 	return err
 }
 
+// DeleteWhere moves the documents matching documentFilter to the trash.
 func (m *DocumentManager) DeleteWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
 	documents := []*domain.Document{}
 
@@ -201,9 +228,14 @@ func (m *DocumentManager) DeleteWhere(ctx context.Context, documentFilter *domai
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	numAffectedRecords, err = m.Repository.DeleteWhere(ctx, documentFilter)
+	numAffectedRecords, err = m.Repository.UpdateWhere(ctx, ExcludeTrashed(documentFilter), trashFilters.TrashUpdater())
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -227,9 +259,12 @@ func (m *DocumentManager) CountWhere(ctx context.Context, documentFilter *domain
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	numRecords, err = m.Repository.CountWhere(ctx, documentFilter)
+	numRecords, err = m.Repository.CountWhere(ctx, ExcludeTrashed(documentFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -253,9 +288,70 @@ func (m *DocumentManager) CountAll(ctx context.Context) (numRecords int64, err e
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	numRecords, err = m.Repository.CountAll(ctx)
+	numRecords, err = m.Repository.CountWhere(ctx, notTrashedFilter())
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) CountGroupedWhere(ctx context.Context, documentFilter *domain.DocumentFilter, documentGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	groups, err = m.Repository.CountGroupedWhere(ctx, ExcludeTrashed(documentFilter), documentGrouper)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) CountGroupedAll(ctx context.Context, documentGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	groups, err = m.Repository.CountGroupedWhere(ctx, notTrashedFilter(), documentGrouper)
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -279,9 +375,16 @@ func (m *DocumentManager) DoesExist(ctx context.Context, document *domain.Docume
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	doesExist, err = m.Repository.DoesExist(ctx, document)
+	if document == nil {
+		err = helper.NilInputError{}
+	} else {
+		doesExist, err = m.Repository.DoesExistWhere(ctx, ExcludeTrashed(trashFilters.IDIn([]int64{document.ID})))
+	}
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -305,9 +408,12 @@ func (m *DocumentManager) DoesExistWhere(ctx context.Context, documentFilter *do
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	doesExist, err = m.Repository.DoesExistWhere(ctx, documentFilter)
+	doesExist, err = m.Repository.DoesExistWhere(ctx, ExcludeTrashed(documentFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -331,9 +437,12 @@ func (m *DocumentManager) GetWhere(ctx context.Context, documentFilter *domain.D
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetWhere(ctx, documentFilter)
+	records, err = m.Repository.GetWhere(ctx, ExcludeTrashed(documentFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -356,9 +465,12 @@ func (m *DocumentManager) GetFirstWhere(ctx context.Context, documentFilter *dom
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
-	record, err = m.Repository.GetFirstWhere(ctx, documentFilter)
+	record, err = m.Repository.GetFirstWhere(ctx, ExcludeTrashed(documentFilter))
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -382,9 +494,161 @@ func (m *DocumentManager) GetAll(ctx context.Context) (records []*domain.Documen
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
+	}
+
+	records, ok := m.Cache.GetAll()
+	if !ok {
+		records, err = m.Repository.GetWhere(ctx, notTrashedFilter())
+		if err != nil {
+			m.Logger.Error(err)
+		} else {
+			m.Cache.SetAll(records)
+		}
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) GetWhereSorted(ctx context.Context, documentFilter *domain.DocumentFilter, documentSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetAll(ctx)
+	records, err = m.Repository.GetWhereSorted(ctx, ExcludeTrashed(documentFilter), documentSorter, limiter)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) GetAllSorted(ctx context.Context, documentSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSorted(ctx, notTrashedFilter(), documentSorter, limiter)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) GetWhereSelected(ctx context.Context, documentFilter *domain.DocumentFilter, documentSorter domain.DocumentSorter, limiter *model.Limiter, documentSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSelected(ctx, ExcludeTrashed(documentFilter), documentSorter, limiter, documentSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) GetAllSelected(ctx context.Context, documentSorter domain.DocumentSorter, limiter *model.Limiter, documentSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSelected(ctx, notTrashedFilter(), documentSorter, limiter, documentSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *DocumentManager) GetFirstWhereSelected(ctx context.Context, documentFilter *domain.DocumentFilter, documentSelector domain.DocumentMemberSelector) (record *domain.Document, err error) {
+	documents := []*domain.Document{}
+
+	hookErr := goaoi.ForeachSlice(documents, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	record, err = m.Repository.GetFirstWhereSelected(ctx, ExcludeTrashed(documentFilter), documentSelector)
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -408,6 +672,7 @@ func (m *DocumentManager) AddType(ctx context.Context, types []string) error {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.AddType(ctx, types)
@@ -434,9 +699,12 @@ func (m *DocumentManager) DeleteType(ctx context.Context, types []string) error
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.DeleteType(ctx, types)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -460,9 +728,12 @@ func (m *DocumentManager) UpdateType(ctx context.Context, oldType string, newTyp
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.UpdateType(ctx, oldType, newType)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -486,6 +757,7 @@ func (m *DocumentManager) GetAllTypes(ctx context.Context) ([]string, error) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return nil, hookErr
 	}
 
 	types, err := m.Repository.GetAllTypes(ctx)
@@ -511,9 +783,15 @@ func (m *DocumentManager) GetFromIDs(ctx context.Context, ids []int64) (records
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetFromIDs(ctx, ids)
+	records, err = m.Cache.GetFromIDs(ids, func(ids []int64) ([]*domain.Document, error) {
+		return m.Repository.GetWhere(ctx, ExcludeTrashed(trashFilters.IDIn(ids)))
+	})
 	if err != nil {
 		m.Logger.Error(err)
 
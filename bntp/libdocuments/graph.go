@@ -0,0 +1,252 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+)
+
+// GraphColors are assigned to the tags nodes are colored by in order, repeating if there are more tags.
+var GraphColors = []string{"#8dd3c7", "#ffffb3", "#bebada", "#fb8072", "#80b1d3", "#fdb462", "#b3de69", "#fccde5", "#d9d9d9", "#bc80bd"}
+
+type DocumentGraphNode struct {
+	Path         string   `json:"path"`
+	DocumentType string   `json:"documentType,omitempty"`
+	Color        string   `json:"color,omitempty"`
+	Tags         []string `json:"tags"`
+	ID           int64    `json:"id"`
+}
+
+type DocumentGraphEdge struct {
+	Source int64 `json:"source"`
+	Target int64 `json:"target"`
+}
+
+// DocumentGraph is the directed graph of links between documents.
+type DocumentGraph struct {
+	Nodes []DocumentGraphNode `json:"nodes"`
+	Edges []DocumentGraphEdge `json:"edges"`
+}
+
+// NewDocumentGraph creates the graph of documents and the links between them, links to other documents are left out.
+// The tags of the nodes are looked up in tagPaths by their IDs.
+func NewDocumentGraph(documents []*domain.Document, tagPaths map[int64]string) *DocumentGraph {
+	graph := &DocumentGraph{
+		Nodes: make([]DocumentGraphNode, 0, len(documents)),
+		Edges: []DocumentGraphEdge{},
+	}
+
+	isNode := make(map[int64]bool, len(documents))
+	for _, document := range documents {
+		isNode[document.ID] = true
+	}
+
+	for _, document := range documents {
+		node := DocumentGraphNode{
+			ID:           document.ID,
+			Path:         document.Path,
+			DocumentType: document.DocumentType.Wrappee,
+			Tags:         make([]string, 0, len(document.TagIDs)),
+		}
+
+		for _, tagID := range document.TagIDs {
+			if tagPath, ok := tagPaths[tagID]; ok {
+				node.Tags = append(node.Tags, tagPath)
+			}
+		}
+
+		sort.Strings(node.Tags)
+
+		graph.Nodes = append(graph.Nodes, node)
+
+		for _, linkedID := range document.LinkedDocumentIDs {
+			if isNode[linkedID] {
+				graph.Edges = append(graph.Edges, DocumentGraphEdge{Source: document.ID, Target: linkedID})
+			}
+		}
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool { return graph.Nodes[i].ID < graph.Nodes[j].ID })
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+
+		return graph.Edges[i].Target < graph.Edges[j].Target
+	})
+
+	return graph
+}
+
+// ColorByTags colors each node by the first of tagPaths it or one of its tags' ancestors is tagged with.
+func (graph *DocumentGraph) ColorByTags(tagPaths []string) {
+	for i := range graph.Nodes {
+		graph.Nodes[i].Color = ""
+
+	tagPathsLoop:
+		for j, tagPath := range tagPaths {
+			for _, nodeTag := range graph.Nodes[i].Tags {
+				if nodeTag == tagPath || strings.HasPrefix(nodeTag, tagPath+libtags.PathSeparator) {
+					graph.Nodes[i].Color = GraphColors[j%len(GraphColors)]
+
+					break tagPathsLoop
+				}
+			}
+		}
+	}
+}
+
+// MarshalDOT encodes graph in the DOT language of Graphviz.
+func (graph *DocumentGraph) MarshalDOT() string {
+	var builder strings.Builder
+
+	builder.WriteString("digraph documents {\n")
+	builder.WriteString("\tnode [shape=box];\n")
+
+	for _, node := range graph.Nodes {
+		label := node.Path
+		if node.DocumentType != "" {
+			label += "\ntype: " + node.DocumentType
+		}
+		if len(node.Tags) > 0 {
+			label += "\ntags: " + strings.Join(node.Tags, ", ")
+		}
+
+		fmt.Fprintf(&builder, "\t%d [label=%s", node.ID, quoteDOT(label))
+
+		if node.Color != "" {
+			fmt.Fprintf(&builder, ", style=filled, fillcolor=%s", quoteDOT(node.Color))
+		}
+
+		builder.WriteString("];\n")
+	}
+
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&builder, "\t%d -> %d;\n", edge.Source, edge.Target)
+	}
+
+	builder.WriteString("}")
+
+	return builder.String()
+}
+
+// quoteDOT quotes s as a DOT string, turning newlines into centered line breaks.
+func quoteDOT(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+
+	return `"` + s + `"`
+}
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	ID     string `xml:"id,attr"`
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// MarshalGraphML encodes graph as GraphML, the label of nodes is their path and tags are joined by commas.
+func (graph *DocumentGraph) MarshalGraphML() (string, error) {
+	document := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "label", For: "node", AttrName: "label", AttrType: "string"},
+			{ID: "documentType", For: "node", AttrName: "documentType", AttrType: "string"},
+			{ID: "tags", For: "node", AttrName: "tags", AttrType: "string"},
+			{ID: "color", For: "node", AttrName: "color", AttrType: "string"},
+		},
+		Graph: graphMLGraph{
+			ID:          "documents",
+			EdgeDefault: "directed",
+			Nodes:       make([]graphMLNode, 0, len(graph.Nodes)),
+			Edges:       make([]graphMLEdge, 0, len(graph.Edges)),
+		},
+	}
+
+	for _, node := range graph.Nodes {
+		data := []graphMLData{{Key: "label", Value: node.Path}}
+
+		if node.DocumentType != "" {
+			data = append(data, graphMLData{Key: "documentType", Value: node.DocumentType})
+		}
+		if len(node.Tags) > 0 {
+			data = append(data, graphMLData{Key: "tags", Value: strings.Join(node.Tags, ",")})
+		}
+		if node.Color != "" {
+			data = append(data, graphMLData{Key: "color", Value: node.Color})
+		}
+
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{ID: strconv.FormatInt(node.ID, 10), Data: data})
+	}
+
+	for i, edge := range graph.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			ID:     "e" + strconv.Itoa(i),
+			Source: strconv.FormatInt(edge.Source, 10),
+			Target: strconv.FormatInt(edge.Target, 10),
+		})
+	}
+
+	output, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(output), nil
+}
@@ -0,0 +1,346 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type GraphDirection string
+
+const (
+	// GraphDirectionOutgoing follows links from documents to the documents they link to.
+	GraphDirectionOutgoing GraphDirection = "out"
+	// GraphDirectionIncoming follows links from documents to the documents linking to them.
+	GraphDirectionIncoming GraphDirection = "in"
+	// GraphDirectionBoth follows links regardless of their direction.
+	GraphDirectionBoth GraphDirection = "both"
+)
+
+// DocumentGraphNeighbour is a node reachable from another one in Distance hops.
+type DocumentGraphNeighbour struct {
+	DocumentGraphNode
+	Distance int `json:"distance"`
+}
+
+// DocumentGraphHub is a node with the number of its links and backlinks.
+type DocumentGraphHub struct {
+	DocumentGraphNode
+	NumLinks     int `json:"numLinks"`
+	NumBacklinks int `json:"numBacklinks"`
+}
+
+//******************************************************************//
+//                       UnknownGraphNodeError                      //
+//******************************************************************//
+
+type UnknownGraphNodeError struct {
+	Path string
+}
+
+func (err UnknownGraphNodeError) Error() string {
+	return fmt.Sprintf("No document with path %q in the graph", err.Path)
+}
+
+func (err UnknownGraphNodeError) Is(other error) bool {
+	switch other.(type) {
+	case UnknownGraphNodeError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnknownGraphNodeError) As(target any) bool {
+	switch target.(type) {
+	case UnknownGraphNodeError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                    InvalidGraphDirectionError                    //
+//******************************************************************//
+
+type InvalidGraphDirectionError struct {
+	Direction GraphDirection
+}
+
+func (err InvalidGraphDirectionError) Error() string {
+	return fmt.Sprintf("Invalid graph direction %q, expected one of %v, %v or %v", err.Direction, GraphDirectionOutgoing, GraphDirectionIncoming, GraphDirectionBoth)
+}
+
+func (err InvalidGraphDirectionError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidGraphDirectionError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidGraphDirectionError) As(target any) bool {
+	switch target.(type) {
+	case InvalidGraphDirectionError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// Node returns the node of the document at path.
+func (graph *DocumentGraph) Node(path string) (DocumentGraphNode, error) {
+	for _, node := range graph.Nodes {
+		if node.Path == path {
+			return node, nil
+		}
+	}
+
+	return DocumentGraphNode{}, UnknownGraphNodeError{Path: path}
+}
+
+// Neighbourhood returns the nodes reachable from the node at path in at most hops hops following links in direction,
+// ordered by their distance. All reachable nodes are returned if hops is 0.
+func (graph *DocumentGraph) Neighbourhood(path string, hops int, direction GraphDirection) ([]DocumentGraphNeighbour, error) {
+	start, err := graph.Node(path)
+	if err != nil {
+		return nil, err
+	}
+
+	adjacency, err := graph.adjacency(direction)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := graph.nodesByID()
+	distances := map[int64]int{start.ID: 0}
+	neighbours := []DocumentGraphNeighbour{}
+
+	for frontier := []int64{start.ID}; len(frontier) > 0; {
+		id := frontier[0]
+		frontier = frontier[1:]
+
+		if hops > 0 && distances[id] >= hops {
+			continue
+		}
+
+		for _, neighbourID := range adjacency[id] {
+			if _, ok := distances[neighbourID]; ok {
+				continue
+			}
+
+			distances[neighbourID] = distances[id] + 1
+			frontier = append(frontier, neighbourID)
+			neighbours = append(neighbours, DocumentGraphNeighbour{DocumentGraphNode: nodes[neighbourID], Distance: distances[neighbourID]})
+		}
+	}
+
+	return neighbours, nil
+}
+
+// ShortestPath returns the nodes on a shortest path from the node at sourcePath to the one at destinationPath
+// following links in direction, including both ends. The path is empty if the destination is unreachable.
+func (graph *DocumentGraph) ShortestPath(sourcePath string, destinationPath string, direction GraphDirection) ([]DocumentGraphNode, error) {
+	source, err := graph.Node(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := graph.Node(destinationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	adjacency, err := graph.adjacency(direction)
+	if err != nil {
+		return nil, err
+	}
+
+	predecessors := map[int64]int64{source.ID: source.ID}
+
+	for frontier := []int64{source.ID}; len(frontier) > 0 && destination.ID != source.ID; {
+		id := frontier[0]
+		frontier = frontier[1:]
+
+		for _, neighbourID := range adjacency[id] {
+			if _, ok := predecessors[neighbourID]; ok {
+				continue
+			}
+
+			predecessors[neighbourID] = id
+			frontier = append(frontier, neighbourID)
+		}
+
+		if _, ok := predecessors[destination.ID]; ok {
+			break
+		}
+	}
+
+	if _, ok := predecessors[destination.ID]; !ok {
+		return []DocumentGraphNode{}, nil
+	}
+
+	nodes := graph.nodesByID()
+	path := []DocumentGraphNode{nodes[destination.ID]}
+
+	for id := destination.ID; id != source.ID; {
+		id = predecessors[id]
+		path = append([]DocumentGraphNode{nodes[id]}, path...)
+	}
+
+	return path, nil
+}
+
+// Orphans returns the nodes without links and backlinks.
+func (graph *DocumentGraph) Orphans() []DocumentGraphNode {
+	return graph.nodesWhere(func(hub DocumentGraphHub) bool { return hub.NumLinks == 0 && hub.NumBacklinks == 0 })
+}
+
+// DeadEnds returns the nodes which are linked to, but do not link to other nodes themselves.
+func (graph *DocumentGraph) DeadEnds() []DocumentGraphNode {
+	return graph.nodesWhere(func(hub DocumentGraphHub) bool { return hub.NumLinks == 0 && hub.NumBacklinks > 0 })
+}
+
+// Hubs returns the limit nodes with the most backlinks, all nodes if limit is 0.
+func (graph *DocumentGraph) Hubs(limit int) []DocumentGraphHub {
+	hubs := graph.hubs()
+
+	sort.SliceStable(hubs, func(i, j int) bool { return hubs[i].NumBacklinks > hubs[j].NumBacklinks })
+
+	if limit > 0 && limit < len(hubs) {
+		hubs = hubs[:limit]
+	}
+
+	return hubs
+}
+
+// Components returns the sets of nodes connected by links regardless of their direction, largest first.
+func (graph *DocumentGraph) Components() [][]DocumentGraphNode {
+	// The direction is always valid
+	adjacency, _ := graph.adjacency(GraphDirectionBoth)
+
+	isVisited := make(map[int64]bool, len(graph.Nodes))
+	nodes := graph.nodesByID()
+	components := [][]DocumentGraphNode{}
+
+	for _, node := range graph.Nodes {
+		if isVisited[node.ID] {
+			continue
+		}
+
+		component := []DocumentGraphNode{}
+		isVisited[node.ID] = true
+
+		for frontier := []int64{node.ID}; len(frontier) > 0; {
+			id := frontier[0]
+			frontier = frontier[1:]
+
+			component = append(component, nodes[id])
+
+			for _, neighbourID := range adjacency[id] {
+				if !isVisited[neighbourID] {
+					isVisited[neighbourID] = true
+					frontier = append(frontier, neighbourID)
+				}
+			}
+		}
+
+		sort.Slice(component, func(i, j int) bool { return component[i].ID < component[j].ID })
+		components = append(components, component)
+	}
+
+	sort.SliceStable(components, func(i, j int) bool { return len(components[i]) > len(components[j]) })
+
+	return components
+}
+
+// adjacency returns the IDs of the nodes adjacent to each node in direction, ordered by ID.
+func (graph *DocumentGraph) adjacency(direction GraphDirection) (map[int64][]int64, error) {
+	if direction != GraphDirectionOutgoing && direction != GraphDirectionIncoming && direction != GraphDirectionBoth {
+		return nil, InvalidGraphDirectionError{Direction: direction}
+	}
+
+	adjacency := make(map[int64][]int64, len(graph.Nodes))
+
+	for _, edge := range graph.Edges {
+		if direction != GraphDirectionIncoming {
+			adjacency[edge.Source] = append(adjacency[edge.Source], edge.Target)
+		}
+		if direction != GraphDirectionOutgoing {
+			adjacency[edge.Target] = append(adjacency[edge.Target], edge.Source)
+		}
+	}
+
+	for id := range adjacency {
+		sort.Slice(adjacency[id], func(i, j int) bool { return adjacency[id][i] < adjacency[id][j] })
+	}
+
+	return adjacency, nil
+}
+
+func (graph *DocumentGraph) nodesByID() map[int64]DocumentGraphNode {
+	nodes := make(map[int64]DocumentGraphNode, len(graph.Nodes))
+
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+
+	return nodes
+}
+
+// hubs returns the nodes with the numbers of their links and backlinks in the order of the nodes.
+func (graph *DocumentGraph) hubs() []DocumentGraphHub {
+	numLinks := make(map[int64]int, len(graph.Nodes))
+	numBacklinks := make(map[int64]int, len(graph.Nodes))
+
+	for _, edge := range graph.Edges {
+		numLinks[edge.Source]++
+		numBacklinks[edge.Target]++
+	}
+
+	hubs := make([]DocumentGraphHub, 0, len(graph.Nodes))
+
+	for _, node := range graph.Nodes {
+		hubs = append(hubs, DocumentGraphHub{DocumentGraphNode: node, NumLinks: numLinks[node.ID], NumBacklinks: numBacklinks[node.ID]})
+	}
+
+	return hubs
+}
+
+func (graph *DocumentGraph) nodesWhere(predicate func(hub DocumentGraphHub) bool) []DocumentGraphNode {
+	nodes := []DocumentGraphNode{}
+
+	for _, hub := range graph.hubs() {
+		if predicate(hub) {
+			nodes = append(nodes, hub.DocumentGraphNode)
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,199 @@
+package libdocuments_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// newQueryTestGraph returns the graph 1 -> 2 -> 3 -> 1, 2 -> 4 <- 5, 6 and 7 -> 8.
+func newQueryTestGraph() *libdocuments.DocumentGraph {
+	links := map[int64][]int64{1: {2}, 2: {3, 4}, 3: {1}, 5: {4}, 7: {8}}
+	documents := []*domain.Document{}
+
+	for id := int64(1); id <= 8; id++ {
+		documents = append(documents, &domain.Document{ID: id, Path: queryTestPath(id), LinkedDocumentIDs: links[id]})
+	}
+
+	return libdocuments.NewDocumentGraph(documents, nil)
+}
+
+func queryTestPath(id int64) string {
+	return strconv.FormatInt(id, 10) + ".md"
+}
+
+func queryTestNodes(ids ...int64) []libdocuments.DocumentGraphNode {
+	nodes := []libdocuments.DocumentGraphNode{}
+
+	for _, id := range ids {
+		nodes = append(nodes, libdocuments.DocumentGraphNode{ID: id, Path: queryTestPath(id), Tags: []string{}})
+	}
+
+	return nodes
+}
+
+func TestDocumentGraphNeighbourhood(t *testing.T) {
+	neighbour := func(id int64, distance int) libdocuments.DocumentGraphNeighbour {
+		return libdocuments.DocumentGraphNeighbour{DocumentGraphNode: queryTestNodes(id)[0], Distance: distance}
+	}
+
+	tests := []struct {
+		err                error
+		name               string
+		path               string
+		hops               int
+		direction          libdocuments.GraphDirection
+		expectedNeighbours []libdocuments.DocumentGraphNeighbour
+	}{
+		{
+			name:      "unknown document",
+			path:      "9.md",
+			direction: libdocuments.GraphDirectionOutgoing,
+			err:       libdocuments.UnknownGraphNodeError{},
+		},
+		{
+			name:      "invalid direction",
+			path:      "1.md",
+			direction: "up",
+			err:       libdocuments.InvalidGraphDirectionError{},
+		},
+		{
+			name:               "one hop",
+			path:               "1.md",
+			hops:               1,
+			direction:          libdocuments.GraphDirectionOutgoing,
+			expectedNeighbours: []libdocuments.DocumentGraphNeighbour{neighbour(2, 1)},
+		},
+		{
+			name:               "two hops",
+			path:               "1.md",
+			hops:               2,
+			direction:          libdocuments.GraphDirectionOutgoing,
+			expectedNeighbours: []libdocuments.DocumentGraphNeighbour{neighbour(2, 1), neighbour(3, 2), neighbour(4, 2)},
+		},
+		{
+			name:               "incoming",
+			path:               "4.md",
+			hops:               1,
+			direction:          libdocuments.GraphDirectionIncoming,
+			expectedNeighbours: []libdocuments.DocumentGraphNeighbour{neighbour(2, 1), neighbour(5, 1)},
+		},
+		{
+			name:               "both directions unlimited",
+			path:               "4.md",
+			direction:          libdocuments.GraphDirectionBoth,
+			expectedNeighbours: []libdocuments.DocumentGraphNeighbour{neighbour(2, 1), neighbour(5, 1), neighbour(1, 2), neighbour(3, 2)},
+		},
+		{
+			name:               "no neighbours",
+			path:               "6.md",
+			direction:          libdocuments.GraphDirectionBoth,
+			expectedNeighbours: []libdocuments.DocumentGraphNeighbour{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			neighbours, err := newQueryTestGraph().Neighbourhood(test.path, test.hops, test.direction)
+			assert.Equal(t, test.expectedNeighbours, neighbours, test.name+", assert neighbours match expected")
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
+
+func TestDocumentGraphShortestPath(t *testing.T) {
+	tests := []struct {
+		err             error
+		name            string
+		sourcePath      string
+		destinationPath string
+		direction       libdocuments.GraphDirection
+		expectedPath    []libdocuments.DocumentGraphNode
+	}{
+		{
+			name:            "unknown destination",
+			sourcePath:      "1.md",
+			destinationPath: "9.md",
+			direction:       libdocuments.GraphDirectionOutgoing,
+			err:             libdocuments.UnknownGraphNodeError{},
+		},
+		{
+			name:            "same document",
+			sourcePath:      "1.md",
+			destinationPath: "1.md",
+			direction:       libdocuments.GraphDirectionOutgoing,
+			expectedPath:    queryTestNodes(1),
+		},
+		{
+			name:            "outgoing",
+			sourcePath:      "1.md",
+			destinationPath: "4.md",
+			direction:       libdocuments.GraphDirectionOutgoing,
+			expectedPath:    queryTestNodes(1, 2, 4),
+		},
+		{
+			name:            "unreachable",
+			sourcePath:      "4.md",
+			destinationPath: "1.md",
+			direction:       libdocuments.GraphDirectionOutgoing,
+			expectedPath:    queryTestNodes(),
+		},
+		{
+			name:            "incoming",
+			sourcePath:      "4.md",
+			destinationPath: "3.md",
+			direction:       libdocuments.GraphDirectionIncoming,
+			expectedPath:    queryTestNodes(4, 2, 1, 3),
+		},
+		{
+			name:            "both directions",
+			sourcePath:      "5.md",
+			destinationPath: "3.md",
+			direction:       libdocuments.GraphDirectionBoth,
+			expectedPath:    queryTestNodes(5, 4, 2, 3),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			path, err := newQueryTestGraph().ShortestPath(test.sourcePath, test.destinationPath, test.direction)
+			assert.Equal(t, test.expectedPath, path, test.name+", assert path matches expected")
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
+
+func TestDocumentGraphStructure(t *testing.T) {
+	graph := newQueryTestGraph()
+
+	assert.Equal(t, queryTestNodes(6), graph.Orphans(), "assert orphans match expected")
+	assert.Equal(t, queryTestNodes(4, 8), graph.DeadEnds(), "assert dead ends match expected")
+	assert.Equal(t, []libdocuments.DocumentGraphHub{
+		{DocumentGraphNode: queryTestNodes(4)[0], NumLinks: 0, NumBacklinks: 2},
+		{DocumentGraphNode: queryTestNodes(1)[0], NumLinks: 1, NumBacklinks: 1},
+	}, graph.Hubs(2), "assert hubs match expected")
+	assert.Len(t, graph.Hubs(0), 8, "assert all hubs are returned without limit")
+	assert.Equal(t, [][]libdocuments.DocumentGraphNode{queryTestNodes(1, 2, 3, 4, 5), queryTestNodes(7, 8), queryTestNodes(6)}, graph.Components(), "assert components match expected")
+}
@@ -0,0 +1,97 @@
+package libdocuments_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentGraph(t *testing.T) {
+	documents := []*domain.Document{
+		{ID: 2, Path: "notes/bar.md", TagIDs: []int64{2, 1}, LinkedDocumentIDs: []int64{1}},
+		{ID: 1, Path: "notes/\"foo\".md", DocumentType: optional.Make("note"), LinkedDocumentIDs: []int64{2, 3}},
+	}
+	tagPaths := map[int64]string{1: "lang", 2: "lang::go"}
+
+	tests := []struct {
+		name            string
+		colorTagPaths   []string
+		expectedDOT     string
+		expectedGraphML string
+	}{
+		{
+			name: "uncolored",
+			expectedDOT: `digraph documents {
+	node [shape=box];
+	1 [label="notes/\"foo\".md\ntype: note"];
+	2 [label="notes/bar.md\ntags: lang, lang::go"];
+	1 -> 2;
+	2 -> 1;
+}`,
+			expectedGraphML: `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="label" for="node" attr.name="label" attr.type="string"></key>
+  <key id="documentType" for="node" attr.name="documentType" attr.type="string"></key>
+  <key id="tags" for="node" attr.name="tags" attr.type="string"></key>
+  <key id="color" for="node" attr.name="color" attr.type="string"></key>
+  <graph id="documents" edgedefault="directed">
+    <node id="1">
+      <data key="label">notes/&#34;foo&#34;.md</data>
+      <data key="documentType">note</data>
+    </node>
+    <node id="2">
+      <data key="label">notes/bar.md</data>
+      <data key="tags">lang,lang::go</data>
+    </node>
+    <edge id="e0" source="1" target="2"></edge>
+    <edge id="e1" source="2" target="1"></edge>
+  </graph>
+</graphml>`,
+		},
+		{
+			name:          "colored by ancestor",
+			colorTagPaths: []string{"lang"},
+			expectedDOT: `digraph documents {
+	node [shape=box];
+	1 [label="notes/\"foo\".md\ntype: note"];
+	2 [label="notes/bar.md\ntags: lang, lang::go", style=filled, fillcolor="#8dd3c7"];
+	1 -> 2;
+	2 -> 1;
+}`,
+		},
+		{
+			name:          "colored by first matching tag",
+			colorTagPaths: []string{"lang::rust", "lang::go"},
+			expectedDOT: `digraph documents {
+	node [shape=box];
+	1 [label="notes/\"foo\".md\ntype: note"];
+	2 [label="notes/bar.md\ntags: lang, lang::go", style=filled, fillcolor="#ffffb3"];
+	1 -> 2;
+	2 -> 1;
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			graph := libdocuments.NewDocumentGraph(documents, tagPaths)
+			graph.ColorByTags(test.colorTagPaths)
+
+			assert.Equal(t, test.expectedDOT, graph.MarshalDOT(), test.name+", assert DOT matches expected")
+
+			if test.expectedGraphML != "" {
+				graphML, err := graph.MarshalGraphML()
+				assert.NoError(t, err, test.name+", assert marshalling GraphML")
+				assert.Equal(t, test.expectedGraphML, graphML, test.name+", assert GraphML matches expected")
+			}
+		})
+	}
+}
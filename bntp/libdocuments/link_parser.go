@@ -0,0 +1,182 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+type LinkKind string
+
+const (
+	// LinkKindInline is a link like [text](path).
+	LinkKindInline LinkKind = "inline"
+	// LinkKindReference is a link definition like [label]: path.
+	LinkKindReference LinkKind = "reference"
+	// LinkKindWiki is a link like [[path]], [[path|text]] or [[path#heading]].
+	LinkKindWiki LinkKind = "wiki"
+	// LinkKindListed is a link in the "# Links" section as written by AddLinks.
+	LinkKindListed LinkKind = "listed"
+)
+
+// ParsedLink is an outgoing link found in a document's content.
+type ParsedLink struct {
+	Target string
+	Kind   LinkKind
+}
+
+var (
+	fenceRegex         = regexp.MustCompile("^\\s{0,3}(```|~~~)")
+	codeSpanRegex      = regexp.MustCompile("`[^`]*`")
+	inlineLinkRegex    = regexp.MustCompile(`(!?)\[[^\]]*\]\(\s*(?:<([^>]+)>|([^)\s]+))(?:\s+"[^"]*")?\s*\)`)
+	referenceLinkRegex = regexp.MustCompile(`^\s{0,3}\[[^\]]+\]:\s*(?:<([^>]+)>|(\S+))`)
+	wikilinkRegex      = regexp.MustCompile(`\[\[([^\]|#]+)(?:#[^\]|]*)?(?:\|[^\]]*)?\]\]`)
+	listedLinkRegex    = regexp.MustCompile(`^- \(([^)]+)\)\[`)
+	schemeRegex        = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+)
+
+// ParseLinks extracts the outgoing links to local files from content.
+// Images, external URLs, links in code and the "# Backlinks" section are skipped.
+func ParseLinks(content string) []ParsedLink {
+	links := []ParsedLink{}
+
+	isInFence := false
+	isInLinks := false
+	isInBacklinks := false
+
+	for _, line := range strings.Split(content, "\n") {
+		if fenceRegex.MatchString(line) {
+			isInFence = !isInFence
+
+			continue
+		}
+		if isInFence {
+			continue
+		}
+
+		// The link sections end at the first line which is not a list item
+		if !strings.HasPrefix(line, "- ") {
+			isInLinks = line == "# Links"
+			isInBacklinks = line == "# Backlinks"
+
+			if isInLinks || isInBacklinks {
+				continue
+			}
+		}
+
+		if isInBacklinks {
+			continue
+		}
+
+		if isInLinks {
+			if match := listedLinkRegex.FindStringSubmatch(line); match != nil {
+				links = append(links, ParsedLink{Target: match[1], Kind: LinkKindListed})
+			}
+
+			continue
+		}
+
+		line = codeSpanRegex.ReplaceAllString(line, "")
+
+		if match := referenceLinkRegex.FindStringSubmatch(line); match != nil {
+			// Destinations in angle brackets may contain spaces
+			if target, ok := localLinkTarget(match[1] + match[2]); ok {
+				links = append(links, ParsedLink{Target: target, Kind: LinkKindReference})
+			}
+
+			continue
+		}
+
+		for _, match := range inlineLinkRegex.FindAllStringSubmatch(line, -1) {
+			// Images are embedded, not linked
+			if match[1] == "!" {
+				continue
+			}
+
+			if target, ok := localLinkTarget(match[2] + match[3]); ok {
+				links = append(links, ParsedLink{Target: target, Kind: LinkKindInline})
+			}
+		}
+
+		for _, match := range wikilinkRegex.FindAllStringSubmatch(line, -1) {
+			target := strings.TrimSpace(match[1])
+			if target != "" {
+				links = append(links, ParsedLink{Target: target, Kind: LinkKindWiki})
+			}
+		}
+	}
+
+	return links
+}
+
+// ResolveLinks turns the targets of links into paths of documents, relative to the document at documentPath.
+// Targets of wikilinks without an extension are assumed to be Markdown files.
+// The returned paths are unique.
+func ResolveLinks(documentPath string, links []ParsedLink) []string {
+	paths := make([]string, 0, len(links))
+	seen := make(map[string]bool, len(links))
+
+	for _, link := range links {
+		path := link.Target
+
+		// Listed links are written with the paths of the linked documents
+		if link.Kind != LinkKindListed {
+			if link.Kind == LinkKindWiki && filepath.Ext(path) == "" {
+				path += ".md"
+			}
+
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(documentPath), path)
+			}
+		}
+
+		path = filepath.Clean(path)
+
+		if !seen[path] {
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// localLinkTarget returns the path a link target points to, if it is a local file.
+func localLinkTarget(target string) (string, bool) {
+	if strings.HasPrefix(target, "#") || schemeRegex.MatchString(target) {
+		return "", false
+	}
+
+	// Fragments and queries address parts of the file
+	if i := strings.IndexAny(target, "#?"); i != -1 {
+		target = target[:i]
+	}
+
+	unescapedTarget, err := url.PathUnescape(target)
+	if err == nil {
+		target = unescapedTarget
+	}
+
+	return target, target != ""
+}
@@ -0,0 +1,121 @@
+package libdocuments_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedLinks []libdocuments.ParsedLink
+	}{
+		{
+			name:          "empty document",
+			content:       "",
+			expectedLinks: []libdocuments.ParsedLink{},
+		},
+		{
+			name:          "inline links",
+			content:       "See [foo](foo.md) and [bar](../bar.md#heading \"Bar\")",
+			expectedLinks: []libdocuments.ParsedLink{{Target: "foo.md", Kind: libdocuments.LinkKindInline}, {Target: "../bar.md", Kind: libdocuments.LinkKindInline}},
+		},
+		{
+			name:          "escaped inline link",
+			content:       "See [foo](<foo bar.md>) and [baz](baz%20qux.md)",
+			expectedLinks: []libdocuments.ParsedLink{{Target: "foo bar.md", Kind: libdocuments.LinkKindInline}, {Target: "baz qux.md", Kind: libdocuments.LinkKindInline}},
+		},
+		{
+			name:          "reference link",
+			content:       "See [foo][1]\n\n[1]: foo.md \"Foo\"",
+			expectedLinks: []libdocuments.ParsedLink{{Target: "foo.md", Kind: libdocuments.LinkKindReference}},
+		},
+		{
+			name:          "wikilinks",
+			content:       "See [[foo]], [[bar|Bar]] and [[baz.md#heading]]",
+			expectedLinks: []libdocuments.ParsedLink{{Target: "foo", Kind: libdocuments.LinkKindWiki}, {Target: "bar", Kind: libdocuments.LinkKindWiki}, {Target: "baz.md", Kind: libdocuments.LinkKindWiki}},
+		},
+		{
+			name:          "listed links",
+			content:       "# Tags\n# Links\n- (foo.md)[foo.md]\n# Backlinks\n- (bar.md)[bar.md]\n\n[baz](baz.md)",
+			expectedLinks: []libdocuments.ParsedLink{{Target: "foo.md", Kind: libdocuments.LinkKindListed}, {Target: "baz.md", Kind: libdocuments.LinkKindInline}},
+		},
+		{
+			name:          "skipped links",
+			content:       "![image](foo.png) [web](https://example.com) [mail](mailto:foo@example.com) [heading](#heading) `[code](foo.md)`\n```\n[code](bar.md)\n```",
+			expectedLinks: []libdocuments.ParsedLink{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			links := libdocuments.ParseLinks(test.content)
+			assert.Equal(t, test.expectedLinks, links, test.name+", assert links match expected")
+		})
+	}
+}
+
+func TestResolveLinks(t *testing.T) {
+	tests := []struct {
+		name          string
+		documentPath  string
+		links         []libdocuments.ParsedLink
+		expectedPaths []string
+	}{
+		{
+			name:          "no links",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{},
+			expectedPaths: []string{},
+		},
+		{
+			name:          "relative links",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{{Target: "bar.md", Kind: libdocuments.LinkKindInline}, {Target: "../baz.md", Kind: libdocuments.LinkKindReference}},
+			expectedPaths: []string{"notes/bar.md", "baz.md"},
+		},
+		{
+			name:          "absolute link",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{{Target: "/home/bar.md", Kind: libdocuments.LinkKindInline}},
+			expectedPaths: []string{"/home/bar.md"},
+		},
+		{
+			name:          "wikilinks",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{{Target: "bar", Kind: libdocuments.LinkKindWiki}, {Target: "baz.txt", Kind: libdocuments.LinkKindWiki}},
+			expectedPaths: []string{"notes/bar.md", "notes/baz.txt"},
+		},
+		{
+			name:          "listed links",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{{Target: "other/bar.md", Kind: libdocuments.LinkKindListed}},
+			expectedPaths: []string{"other/bar.md"},
+		},
+		{
+			name:          "duplicate links",
+			documentPath:  "notes/foo.md",
+			links:         []libdocuments.ParsedLink{{Target: "bar", Kind: libdocuments.LinkKindWiki}, {Target: "bar.md", Kind: libdocuments.LinkKindInline}, {Target: "notes/bar.md", Kind: libdocuments.LinkKindListed}},
+			expectedPaths: []string{"notes/bar.md"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			paths := libdocuments.ResolveLinks(test.documentPath, test.links)
+			assert.Equal(t, test.expectedPaths, paths, test.name+", assert paths match expected")
+		})
+	}
+}
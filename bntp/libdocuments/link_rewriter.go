@@ -0,0 +1,221 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var listedLinkLineRegex = regexp.MustCompile(`^- \(([^)]+)\)\[([^\]]+)\]$`)
+
+// RewriteLinks rewrites the links in content, the document at oldDocumentPath, for the document being moved to newDocumentPath
+// and the documents at the keys of pathChanges being moved to their values.
+// Relative links keep pointing to their files, listed links and backlinks are written with the new paths.
+// Links in code and external URLs are left alone.
+func RewriteLinks(content string, oldDocumentPath string, newDocumentPath string, pathChanges map[string]string) string {
+	rewriter := linkRewriter{
+		oldDir:      filepath.Dir(oldDocumentPath),
+		newDir:      filepath.Dir(newDocumentPath),
+		pathChanges: pathChanges,
+	}
+
+	lines := strings.Split(content, "\n")
+
+	isInFence := false
+	isInLinkSection := false
+
+	for i, line := range lines {
+		if fenceRegex.MatchString(line) {
+			isInFence = !isInFence
+
+			continue
+		}
+		if isInFence {
+			continue
+		}
+
+		// The link sections end at the first line which is not a list item
+		if !strings.HasPrefix(line, "- ") {
+			isInLinkSection = line == "# Links" || line == "# Backlinks"
+
+			if isInLinkSection {
+				continue
+			}
+		}
+
+		if isInLinkSection {
+			if match := listedLinkLineRegex.FindStringSubmatch(line); match != nil {
+				if newPath, ok := pathChanges[filepath.Clean(match[1])]; ok {
+					lines[i] = "- (" + newPath + ")[" + newPath + "]"
+				}
+			}
+
+			continue
+		}
+
+		lines[i] = rewriter.rewriteLine(line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+type linkRewriter struct {
+	oldDir      string
+	newDir      string
+	pathChanges map[string]string
+}
+
+// rewriteLine rewrites the destinations of the reference, inline and wiki links in line.
+func (rewriter linkRewriter) rewriteLine(line string) string {
+	codeSpans := codeSpanRegex.FindAllStringIndex(line, -1)
+
+	isInCodeSpan := func(i int) bool {
+		for _, codeSpan := range codeSpans {
+			if i >= codeSpan[0] && i < codeSpan[1] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// Replacements are collected as [start, end) ranges of the destinations and applied from the back,
+	// so earlier ranges stay valid.
+	type replacement struct {
+		start  int
+		end    int
+		target string
+	}
+
+	replacements := []replacement{}
+
+	collect := func(matches [][]int, groups []int, isWiki bool) {
+		for _, match := range matches {
+			if isInCodeSpan(match[0]) {
+				continue
+			}
+
+			for _, group := range groups {
+				start, end := match[2*group], match[2*group+1]
+				if start == -1 {
+					continue
+				}
+
+				if target, ok := rewriter.rewriteTarget(line[start:end], isWiki); ok {
+					replacements = append(replacements, replacement{start: start, end: end, target: target})
+				}
+			}
+		}
+	}
+
+	if match := referenceLinkRegex.FindStringSubmatchIndex(line); match != nil {
+		collect([][]int{match}, []int{1, 2}, false)
+	} else {
+		collect(inlineLinkRegex.FindAllStringSubmatchIndex(line, -1), []int{2, 3}, false)
+		collect(wikilinkRegex.FindAllStringSubmatchIndex(line, -1), []int{1}, true)
+	}
+
+	sort.Slice(replacements, func(i, j int) bool { return replacements[i].start < replacements[j].start })
+
+	for i := len(replacements) - 1; i >= 0; i-- {
+		line = line[:replacements[i].start] + replacements[i].target + line[replacements[i].end:]
+	}
+
+	return line
+}
+
+// rewriteTarget returns the new destination of a link to rawTarget and whether it changed.
+// Fragments, queries and the escaping of the destination are kept.
+func (rewriter linkRewriter) rewriteTarget(rawTarget string, isWiki bool) (string, bool) {
+	if strings.HasPrefix(rawTarget, "#") || schemeRegex.MatchString(rawTarget) {
+		return "", false
+	}
+
+	rawPath, suffix := rawTarget, ""
+	if i := strings.IndexAny(rawTarget, "#?"); i != -1 {
+		rawPath, suffix = rawTarget[:i], rawTarget[i:]
+	}
+
+	path := strings.TrimSpace(rawPath)
+
+	isEscaped := false
+	if unescapedPath, err := url.PathUnescape(path); err == nil {
+		isEscaped = unescapedPath != path
+		path = unescapedPath
+	}
+
+	if path == "" {
+		return "", false
+	}
+
+	hasExtension := filepath.Ext(path) != ""
+
+	resolvedPath := path
+	if isWiki && !hasExtension {
+		resolvedPath += ".md"
+	}
+
+	if !filepath.IsAbs(resolvedPath) {
+		resolvedPath = filepath.Join(rewriter.oldDir, resolvedPath)
+	}
+
+	resolvedPath = filepath.Clean(resolvedPath)
+
+	newResolvedPath, isMoved := rewriter.pathChanges[resolvedPath]
+	if !isMoved {
+		// Absolute links and links of documents staying in their directory keep working
+		if filepath.IsAbs(path) || rewriter.oldDir == rewriter.newDir {
+			return "", false
+		}
+
+		newResolvedPath = resolvedPath
+	}
+
+	newPath := newResolvedPath
+	if !filepath.IsAbs(path) {
+		var err error
+
+		newPath, err = filepath.Rel(rewriter.newDir, newResolvedPath)
+		if err != nil {
+			return "", false
+		}
+	}
+
+	newPath = filepath.ToSlash(newPath)
+
+	if isWiki && !hasExtension {
+		newPath = strings.TrimSuffix(newPath, ".md")
+	}
+
+	if isEscaped {
+		newPath = (&url.URL{Path: newPath}).EscapedPath()
+	}
+
+	if newPath == rawPath {
+		return "", false
+	}
+
+	return newPath + suffix, true
+}
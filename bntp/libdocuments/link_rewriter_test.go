@@ -0,0 +1,80 @@
+package libdocuments_test
+
+import (
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRewriteLinks(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		oldDocumentPath string
+		newDocumentPath string
+		pathChanges     map[string]string
+		expectedContent string
+	}{
+		{
+			name:            "no links",
+			content:         "# Tags\n# Links\n# Backlinks\n\nfoo",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "notes/foo.md",
+			pathChanges:     map[string]string{"notes/bar.md": "archive/bar.md"},
+			expectedContent: "# Tags\n# Links\n# Backlinks\n\nfoo",
+		},
+		{
+			name:            "listed links and backlinks",
+			content:         "# Tags\n# Links\n- (notes/bar.md)[notes/bar.md]\n- (notes/baz.md)[notes/baz.md]\n# Backlinks\n- (notes/bar.md)[notes/bar.md]",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "notes/foo.md",
+			pathChanges:     map[string]string{"notes/bar.md": "archive/bar.md"},
+			expectedContent: "# Tags\n# Links\n- (archive/bar.md)[archive/bar.md]\n- (notes/baz.md)[notes/baz.md]\n# Backlinks\n- (archive/bar.md)[archive/bar.md]",
+		},
+		{
+			name:            "inline, reference and wiki links",
+			content:         "See [bar](bar.md#heading \"Bar\"), [[bar]], [baz](baz.md) and [web](https://example.com/bar.md)\n\n[1]: ./bar.md",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "notes/foo.md",
+			pathChanges:     map[string]string{"notes/bar.md": "archive/bar.md"},
+			expectedContent: "See [bar](../archive/bar.md#heading \"Bar\"), [[../archive/bar]], [baz](baz.md) and [web](https://example.com/bar.md)\n\n[1]: ../archive/bar.md",
+		},
+		{
+			name:            "escaped links",
+			content:         "See [bar](<bar.md>) and [bar](bar.md?raw), [qux](qux%20quux.md)",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "notes/foo.md",
+			pathChanges:     map[string]string{"notes/bar.md": "notes/sub/bar.md", "notes/qux quux.md": "notes/sub/qux quux.md"},
+			expectedContent: "See [bar](<sub/bar.md>) and [bar](sub/bar.md?raw), [qux](sub/qux%20quux.md)",
+		},
+		{
+			name:            "links in code",
+			content:         "`[bar](bar.md)` [bar](bar.md)\n```\n[bar](bar.md)\n```",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "notes/foo.md",
+			pathChanges:     map[string]string{"notes/bar.md": "archive/bar.md"},
+			expectedContent: "`[bar](bar.md)` [bar](../archive/bar.md)\n```\n[bar](bar.md)\n```",
+		},
+		{
+			name:            "moved document",
+			content:         "# Tags\n# Links\n- (notes/bar.md)[notes/bar.md]\n# Backlinks\n\nSee [bar](bar.md), [self](#heading), ![image](img/foo.png) and [abs](/tmp/foo.md)",
+			oldDocumentPath: "notes/foo.md",
+			newDocumentPath: "archive/old/foo.md",
+			pathChanges:     map[string]string{"notes/foo.md": "archive/old/foo.md"},
+			expectedContent: "# Tags\n# Links\n- (notes/bar.md)[notes/bar.md]\n# Backlinks\n\nSee [bar](../../notes/bar.md), [self](#heading), ![image](../../notes/img/foo.png) and [abs](/tmp/foo.md)",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			content := libdocuments.RewriteLinks(test.content, test.oldDocumentPath, test.newDocumentPath, test.pathChanges)
+			assert.Equal(t, test.expectedContent, content, test.name+", assert content matches expected")
+		})
+	}
+}
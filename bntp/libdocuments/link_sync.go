@@ -0,0 +1,133 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"context"
+	"errors"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	"golang.org/x/exp/slices"
+)
+
+// SyncLinks replaces the links of the documents at paths with the links parsed from their contents.
+// All documents known to documentManager are synced if no paths are given, skipping those whose contents can not be read.
+// Links to paths without a document are ignored.
+func (m *DocumentContentManager) SyncLinks(ctx context.Context, paths []string, documentManager *DocumentManager) (numAffectedRecords int64, err error) {
+	skipUnreadable := len(paths) == 0
+
+	if len(paths) == 0 {
+		var documents []*domain.Document
+
+		documents, err = documentManager.GetAll(ctx)
+		if err != nil {
+			return
+		}
+
+		paths, err = goaoi.TransformCopySliceUnsafe(documents, (*domain.Document).GetPath)
+		if err != nil {
+			return
+		}
+	}
+
+	for _, path := range paths {
+		var contents []string
+
+		contents, err = m.Get(ctx, []string{path})
+		if err != nil && skipUnreadable {
+			m.Logger.Warnf("Skipping document %v while syncing links: %v", path, err)
+
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		var isChanged bool
+
+		isChanged, err = m.syncDocumentLinks(ctx, path, contents[0], documentManager)
+		if err != nil {
+			m.Logger.Error(err)
+
+			return
+		}
+
+		if isChanged {
+			numAffectedRecords++
+		}
+	}
+
+	return
+}
+
+// syncDocumentLinks replaces the links of the document at path with the ones parsed from content
+// and reports whether they changed.
+func (m *DocumentContentManager) syncDocumentLinks(ctx context.Context, path string, content string, documentManager *DocumentManager) (bool, error) {
+	// The document is retrieved right before replacing it,
+	// because replacing a document also replaces its backlinks, which change while syncing other documents.
+	document, err := documentManager.GetFirstWhere(ctx, &domain.DocumentFilter{
+		Path: optional.Make(model.FilterOperation[string]{
+			Operator: model.FilterEqual,
+			Operand:  model.ScalarOperand[string]{Operand: path},
+		}),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	linkedIDs := []int64{}
+
+	linkedPaths := ResolveLinks(path, ParseLinks(content))
+	if len(linkedPaths) > 0 {
+		linkedDocuments, err := documentManager.GetWhere(ctx, &domain.DocumentFilter{
+			Path: optional.Make(model.FilterOperation[string]{
+				Operator: model.FilterIn,
+				Operand:  model.ListOperand[string]{Operands: linkedPaths},
+			}),
+		})
+		if err != nil && !errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+			return false, err
+		}
+
+		for _, linkedDocument := range linkedDocuments {
+			// Links to headings of the same document are not links between documents
+			if linkedDocument.ID != document.ID {
+				linkedIDs = append(linkedIDs, linkedDocument.ID)
+			}
+		}
+	}
+
+	oldLinkedIDs := slices.Clone(document.LinkedDocumentIDs)
+	slices.Sort(oldLinkedIDs)
+	slices.Sort(linkedIDs)
+
+	if slices.Equal(oldLinkedIDs, linkedIDs) {
+		return false, nil
+	}
+
+	document.LinkedDocumentIDs = linkedIDs
+
+	return true, documentManager.Replace(ctx, []*domain.Document{document})
+}
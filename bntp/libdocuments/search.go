@@ -0,0 +1,229 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"context"
+	"errors"
+	"reflect"
+
+	bntp "github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	repository "github.com/JonasMuehlmann/bntp.go/model/repository"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/barweiss/go-tuple"
+)
+
+//******************************************************************//
+//                    MissingSearchRepositoryError                  //
+//******************************************************************//
+
+type MissingSearchRepositoryError struct{}
+
+func (err MissingSearchRepositoryError) Error() string {
+	return "The document content manager has no search repository"
+}
+
+func (err MissingSearchRepositoryError) Is(other error) bool {
+	switch other.(type) {
+	case MissingSearchRepositoryError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err MissingSearchRepositoryError) As(target any) bool {
+	switch target.(type) {
+	case MissingSearchRepositoryError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// Search returns the documents whose contents match query, best matches first.
+// If filter is not nil, only documents matching it are searched.
+func (m *DocumentContentManager) Search(ctx context.Context, query string, filter *domain.DocumentFilter, documentManager *DocumentManager) (results []repository.DocumentContentSearchResult, err error) {
+	if m.SearchRepository == nil {
+		err = MissingSearchRepositoryError{}
+		m.Logger.Error(err)
+
+		return
+	}
+
+	var paths []string
+
+	if filter != nil {
+		var documents []*domain.Document
+
+		documents, err = documentManager.GetWhere(ctx, filter)
+		if err != nil {
+			return
+		}
+
+		paths = make([]string, 0, len(documents))
+		for _, document := range documents {
+			paths = append(paths, document.Path)
+		}
+	}
+
+	hookErr := goaoi.ForeachSlice(paths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	results, err = m.SearchRepository.Search(ctx, query, paths)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+
+	resultPaths := make([]string, 0, len(results))
+	for _, result := range results {
+		resultPaths = append(resultPaths, result.Path)
+	}
+
+	hookErr = goaoi.ForeachSlice(resultPaths, m.Hooks.PartiallySpecializeExecuteHooksForNoPointer(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+// RebuildSearchIndex replaces the search index with the contents of all documents known to documentManager.
+// Documents whose contents can not be read are skipped.
+func (m *DocumentContentManager) RebuildSearchIndex(ctx context.Context, documentManager *DocumentManager) (numIndexedDocuments int64, err error) {
+	if m.SearchRepository == nil {
+		err = MissingSearchRepositoryError{}
+		m.Logger.Error(err)
+
+		return
+	}
+
+	documents, err := documentManager.GetAll(ctx)
+	if err != nil {
+		return
+	}
+
+	pathContents := make([]tuple.T2[string, string], 0, len(documents))
+
+	for _, document := range documents {
+		contents, err := m.Repository.Get(ctx, []string{document.Path})
+		if err != nil {
+			m.Logger.Warnf("Skipping document %v while rebuilding search index: %v", document.Path, err)
+
+			continue
+		}
+
+		pathContents = append(pathContents, tuple.New2(document.Path, contents[0]))
+	}
+
+	err = m.SearchRepository.DeleteAll(ctx)
+	if err != nil {
+		m.Logger.Error(err)
+
+		return
+	}
+
+	if len(pathContents) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+		m.Logger.Error(err)
+
+		return
+	}
+
+	err = m.SearchRepository.Index(ctx, pathContents)
+	if err != nil {
+		m.Logger.Error(err)
+
+		return
+	}
+
+	numIndexedDocuments = int64(len(pathContents))
+
+	return
+}
+
+// NOTE: The contents are already persisted when the index is updated,
+// so a failing index update is only logged instead of failing the whole operation.
+
+func (m *DocumentContentManager) indexContents(ctx context.Context, pathContents []tuple.T2[string, string]) {
+	if m.SearchRepository == nil {
+		return
+	}
+
+	err := m.SearchRepository.Index(ctx, pathContents)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+}
+
+// reindexContents indexes the contents at paths again, paths without content are skipped.
+func (m *DocumentContentManager) reindexContents(ctx context.Context, paths []string) {
+	if m.SearchRepository == nil {
+		return
+	}
+
+	pathContents := make([]tuple.T2[string, string], 0, len(paths))
+
+	for _, path := range paths {
+		contents, err := m.Repository.Get(ctx, []string{path})
+		if err == nil {
+			pathContents = append(pathContents, tuple.T2[string, string]{V1: path, V2: contents[0]})
+		}
+	}
+
+	if len(pathContents) > 0 {
+		m.indexContents(ctx, pathContents)
+	}
+}
+
+func (m *DocumentContentManager) moveIndexedContents(ctx context.Context, pathChanges []tuple.T2[string, string]) {
+	if m.SearchRepository == nil {
+		return
+	}
+
+	err := m.SearchRepository.Move(ctx, pathChanges)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+}
+
+func (m *DocumentContentManager) deleteIndexedContents(ctx context.Context, paths []string) {
+	if m.SearchRepository == nil {
+		return
+	}
+
+	err := m.SearchRepository.Delete(ctx, paths)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package libdocuments
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+)
+
+var trashFilters = bntp.TrashFilters[domain.Document, domain.DocumentFilter, domain.DocumentUpdater]{
+	Trashed: func() *domain.DocumentFilter {
+		filter := *domain.PredefinedDocumentFilters[domain.DocumentFilterDeleted]
+
+		return &filter
+	},
+	NotTrashed: notTrashedFilter,
+	And: func(filters ...*domain.DocumentFilter) *domain.DocumentFilter {
+		return &domain.DocumentFilter{And: filters}
+	},
+	Or: func(filters ...*domain.DocumentFilter) *domain.DocumentFilter {
+		return &domain.DocumentFilter{Or: filters}
+	},
+	FiltersByDeletedAt: func(filter *domain.DocumentFilter) bool {
+		return filter.DeletedAt.HasValue
+	},
+	Nested: func(filter *domain.DocumentFilter) []*domain.DocumentFilter {
+		return append(append([]*domain.DocumentFilter{filter.Not}, filter.And...), filter.Or...)
+	},
+	ID: (*domain.Document).GetID,
+	IDIn: func(ids []int64) *domain.DocumentFilter {
+		return &domain.DocumentFilter{ID: optional.Make(model.FilterOperation[int64]{
+			Operator: model.FilterIn,
+			Operand:  model.ListOperand[int64]{Operands: ids},
+		})}
+	},
+	SetDeletedAt: func(deletedAt optional.Optional[time.Time]) *domain.DocumentUpdater {
+		return &domain.DocumentUpdater{DeletedAt: optional.Make(model.UpdateOperation[optional.Optional[time.Time]]{
+			Operator: model.UpdateSet,
+			Operand:  deletedAt,
+		})}
+	},
+}
+
+// ExcludeTrashed restricts filter to documents, which are not trashed, unless it filters by DeletedAt itself.
+// All queries of the DocumentManager apply it, so trashed documents can only be retrieved by filtering for them.
+func ExcludeTrashed(filter *domain.DocumentFilter) *domain.DocumentFilter {
+	return trashFilters.ExcludeTrashed(filter)
+}
+
+// IncludeTrashed makes filter match documents whether they are trashed or not.
+func IncludeTrashed(filter *domain.DocumentFilter) *domain.DocumentFilter {
+	return trashFilters.IncludeTrashed(filter)
+}
+
+// TrashedFilter restricts filter to trashed documents.
+func TrashedFilter(filter *domain.DocumentFilter) *domain.DocumentFilter {
+	return trashFilters.TrashedFilter(filter)
+}
+
+func notTrashedFilter() *domain.DocumentFilter {
+	filter := *domain.PredefinedDocumentFilters[domain.DocumentFilterNotDeleted]
+
+	return &filter
+}
+
+func (m *DocumentManager) trash() bntp.Trash[domain.Document, domain.DocumentFilter, domain.DocumentUpdater] {
+	return bntp.Trash[domain.Document, domain.DocumentFilter, domain.DocumentUpdater]{
+		Filters:    trashFilters,
+		Hooks:      m.Hooks,
+		Repository: m.Repository,
+		Logger:     m.Logger,
+		Cache:      m.Cache,
+	}
+}
+
+// Restore moves trashed documents out of the trash.
+func (m *DocumentManager) Restore(ctx context.Context, documents []*domain.Document) error {
+	return m.trash().Restore(ctx, documents)
+}
+
+// RestoreWhere moves the trashed documents matching documentFilter out of the trash.
+func (m *DocumentManager) RestoreWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	return m.trash().RestoreWhere(ctx, documentFilter)
+}
+
+// Purge deletes documents permanently, whether they are trashed or not.
+func (m *DocumentManager) Purge(ctx context.Context, documents []*domain.Document) error {
+	return m.trash().Purge(ctx, documents)
+}
+
+// PurgeWhere permanently deletes the trashed documents matching documentFilter.
+func (m *DocumentManager) PurgeWhere(ctx context.Context, documentFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	return m.trash().PurgeWhere(ctx, documentFilter)
+}
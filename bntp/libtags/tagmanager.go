@@ -23,28 +23,39 @@ package libtags
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	domain "github.com/JonasMuehlmann/bntp.go/model/domain"
 	repository "github.com/JonasMuehlmann/bntp.go/model/repository"
 	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
 
 	bntp "github.com/JonasMuehlmann/bntp.go/bntp"
 )
 
+// PathSeparator separates the tags of a tag path, e.g. "foo::bar::baz".
+const PathSeparator = "::"
+
 type TagManager struct {
 	Repository repository.TagRepository
 	Hooks      *bntp.Hooks[domain.Tag]
 	Logger     *log.Logger
+	// Cache is invalidated by all write operations, it is optional.
+	Cache *bntp.ManagerCache[domain.Tag]
 }
 
-func NewTagmanager(logger *log.Logger, hooks *bntp.Hooks[domain.Tag], repository repository.TagRepository) (TagManager, error) {
+func NewTagmanager(logger *log.Logger, hooks *bntp.Hooks[domain.Tag], repository repository.TagRepository, cache *bntp.ManagerCache[domain.Tag]) (TagManager, error) {
 	m := TagManager{}
 	m.Repository = repository
 	m.Hooks = hooks
 	m.Logger = logger
+	m.Cache = cache
 
 	return m, nil
 }
@@ -56,9 +67,12 @@ func (m *TagManager) Add(ctx context.Context, tags []*domain.Tag) error {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Add(ctx, tags)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 	}
@@ -80,9 +94,12 @@ func (m *TagManager) Replace(ctx context.Context, tags []*domain.Tag) error {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Replace(ctx, tags)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -104,9 +121,12 @@ func (m *TagManager) Upsert(ctx context.Context, tags []*domain.Tag) error {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Upsert(ctx, tags)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -128,9 +148,12 @@ func (m *TagManager) Update(ctx context.Context, documents []*domain.Tag, docume
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Update(ctx, documents, documentUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -154,9 +177,14 @@ func (m *TagManager) UpdateWhere(ctx context.Context, tagFilter *domain.TagFilte
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	numAffectedRecords, err = m.Repository.UpdateWhere(ctx, tagFilter, tagUpdater)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -178,9 +206,12 @@ func (m *TagManager) Delete(ctx context.Context, tags []*domain.Tag) error {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		return hookErr
 	}
 
 	err := m.Repository.Delete(ctx, tags)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -204,9 +235,14 @@ func (m *TagManager) DeleteWhere(ctx context.Context, tagFilter *domain.TagFilte
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	numAffectedRecords, err = m.Repository.DeleteWhere(ctx, tagFilter)
+	m.Cache.InvalidateAfterWrite(ctx)
+
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -230,6 +266,9 @@ func (m *TagManager) CountWhere(ctx context.Context, tagFilter *domain.TagFilter
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	numRecords, err = m.Repository.CountWhere(ctx, tagFilter)
@@ -256,6 +295,9 @@ func (m *TagManager) CountAll(ctx context.Context) (numRecords int64, err error)
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	numRecords, err = m.Repository.CountAll(ctx)
@@ -274,6 +316,64 @@ func (m *TagManager) CountAll(ctx context.Context) (numRecords int64, err error)
 	return
 }
 
+func (m *TagManager) CountGroupedWhere(ctx context.Context, tagFilter *domain.TagFilter, tagGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	groups, err = m.Repository.CountGroupedWhere(ctx, tagFilter, tagGrouper)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
+func (m *TagManager) CountGroupedAll(ctx context.Context, tagGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	groups, err = m.Repository.CountGroupedAll(ctx, tagGrouper)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+	}
+
+	return
+}
+
 func (m *TagManager) DoesExist(ctx context.Context, tag *domain.Tag) (doesExist bool, err error) {
 	tags := []*domain.Tag{tag}
 
@@ -282,6 +382,9 @@ func (m *TagManager) DoesExist(ctx context.Context, tag *domain.Tag) (doesExist
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	doesExist, err = m.Repository.DoesExist(ctx, tag)
@@ -308,6 +411,9 @@ func (m *TagManager) DoesExistWhere(ctx context.Context, tagFilter *domain.TagFi
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	doesExist, err = m.Repository.DoesExistWhere(ctx, tagFilter)
@@ -334,12 +440,17 @@ func (m *TagManager) GetWhere(ctx context.Context, tagFilter *domain.TagFilter)
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
 
+		err = hookErr
+
+		return
 	}
 
 	records, err = m.Repository.GetWhere(ctx, tagFilter)
 	if err != nil {
 		m.Logger.Error(err)
-
+	} else {
+		// Listed tags are often each other's parents, which MarshalPath can then take from the cache
+		m.Cache.SetEntities(records)
 	}
 
 	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
@@ -359,6 +470,10 @@ func (m *TagManager) GetFirstWhere(ctx context.Context, tagFilter *domain.TagFil
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
 	record, err = m.Repository.GetFirstWhere(ctx, tagFilter)
@@ -382,9 +497,73 @@ func (m *TagManager) GetAll(ctx context.Context) (records []*domain.Tag, err err
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetAll(ctx)
+	records, ok := m.Cache.GetAll()
+	if !ok {
+		records, err = m.Repository.GetAll(ctx)
+		if err != nil {
+			m.Logger.Error(err)
+		} else {
+			m.Cache.SetAll(records)
+		}
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *TagManager) GetWhereSorted(ctx context.Context, tagFilter *domain.TagFilter, tagSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSorted(ctx, tagFilter, tagSorter, limiter)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *TagManager) GetAllSorted(ctx context.Context, tagSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetAllSorted(ctx, tagSorter, limiter)
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -399,6 +578,89 @@ func (m *TagManager) GetAll(ctx context.Context) (records []*domain.Tag, err err
 	return
 }
 
+func (m *TagManager) GetWhereSelected(ctx context.Context, tagFilter *domain.TagFilter, tagSorter domain.TagSorter, limiter *model.Limiter, tagSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetWhereSelected(ctx, tagFilter, tagSorter, limiter, tagSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *TagManager) GetAllSelected(ctx context.Context, tagSorter domain.TagSorter, limiter *model.Limiter, tagSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	records, err = m.Repository.GetAllSelected(ctx, tagSorter, limiter, tagSelector)
+	if err != nil {
+		m.Logger.Error(err)
+
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+func (m *TagManager) GetFirstWhereSelected(ctx context.Context, tagFilter *domain.TagFilter, tagSelector domain.TagMemberSelector) (record *domain.Tag, err error) {
+	tags := []*domain.Tag{}
+
+	hookErr := goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.BeforeAnyHook|bntp.BeforeSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	record, err = m.Repository.GetFirstWhereSelected(ctx, tagFilter, tagSelector)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+
+	hookErr = goaoi.ForeachSlice(tags, m.Hooks.PartiallySpecializeExecuteHooks(ctx, bntp.AfterAnyHook|bntp.AfterSelectHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = bntp.HookExecutionError{Inner: hookErr}
+		m.Logger.Error(hookErr)
+	}
+
+	return
+}
+
 // FIX: hooks are processing the wrong values
 
 func (m *TagManager) GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Tag, err error) {
@@ -408,9 +670,13 @@ func (m *TagManager) GetFromIDs(ctx context.Context, ids []int64) (records []*do
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
-	records, err = m.Repository.GetFromIDs(ctx, ids)
+	records, err = m.Cache.GetFromIDs(ids, func(ids []int64) ([]*domain.Tag, error) { return m.Repository.GetFromIDs(ctx, ids) })
 	if err != nil {
 		m.Logger.Error(err)
 
@@ -425,8 +691,8 @@ func (m *TagManager) GetFromIDs(ctx context.Context, ids []int64) (records []*do
 	return
 }
 
-// TODO: Handle shortening path
-
+// MarshalPath returns the path of tag, e.g. "lang::go::generics".
+// If shorten is set, the shortest end of the path which UnmarshalPath resolves to tag is returned, e.g. "generics".
 func (m *TagManager) MarshalPath(ctx context.Context, tag *domain.Tag, shorten bool) (path string, err error) {
 	if tag == nil {
 		err = helper.IneffectiveOperationError{helper.NilInputError{}}
@@ -440,6 +706,10 @@ func (m *TagManager) MarshalPath(ctx context.Context, tag *domain.Tag, shorten b
 	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
 		hookErr = bntp.HookExecutionError{Inner: hookErr}
 		m.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
 	}
 
 	if len(tag.ParentPathIDs) == 0 {
@@ -447,19 +717,49 @@ func (m *TagManager) MarshalPath(ctx context.Context, tag *domain.Tag, shorten b
 
 	}
 
-	parentPathTags, err = m.Repository.GetFromIDs(ctx, tag.ParentPathIDs)
+	parentPathTags, err = m.Cache.GetFromIDs(tag.ParentPathIDs, func(ids []int64) ([]*domain.Tag, error) { return m.Repository.GetFromIDs(ctx, ids) })
 	if err != nil {
 		m.Logger.Error(err)
 
 	} else {
-		pathTags := append(parentPathTags, tag)
+		// The repository returns the tags ordered by their IDs instead of their position in the path
+		parentPathTagsByID := make(map[int64]*domain.Tag, len(parentPathTags))
+		for _, parentPathTag := range parentPathTags {
+			parentPathTagsByID[parentPathTag.ID] = parentPathTag
+		}
+
+		pathTags := make([]*domain.Tag, 0, len(tag.ParentPathIDs)+1)
+		for _, parentPathID := range tag.ParentPathIDs {
+			parentPathTag, ok := parentPathTagsByID[parentPathID]
+			if !ok {
+				err = helper.NonExistentDependencyError{Inner: fmt.Errorf("parent tag %v of tag %v does not exist", parentPathID, tag.ID)}
+				m.Logger.Error(err)
+
+				break
+			}
+
+			pathTags = append(pathTags, parentPathTag)
+		}
+
+		if err == nil {
+			pathTags = append(pathTags, tag)
+
+			var tags []string
 
-		tags, err := goaoi.TransformCopySliceUnsafe(pathTags, (*domain.Tag).GetTag)
+			tags, err = goaoi.TransformCopySliceUnsafe(pathTags, (*domain.Tag).GetTag)
+			if err != nil {
+				m.Logger.Error(err)
+			} else {
+				// Forming a tag path should be defined in a function
+				path = strings.Join(tags, PathSeparator)
+			}
+		}
+	}
+
+	if err == nil && shorten && path != "" {
+		path, err = m.shortenPath(ctx, tag, path)
 		if err != nil {
 			m.Logger.Error(err)
-		} else {
-			// Forming a tag path should be defined in a function
-			path = strings.Join(tags, "::")
 		}
 	}
 
@@ -471,3 +771,311 @@ func (m *TagManager) MarshalPath(ctx context.Context, tag *domain.Tag, shorten b
 
 	return
 }
+
+//******************************************************************//
+//                        UnknownTagPathError                       //
+//******************************************************************//
+
+type UnknownTagPathError struct {
+	Path string
+}
+
+func (err UnknownTagPathError) Error() string {
+	return fmt.Sprintf("No tag with path %q", err.Path)
+}
+
+func (err UnknownTagPathError) Is(other error) bool {
+	switch other.(type) {
+	case UnknownTagPathError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnknownTagPathError) As(target any) bool {
+	switch target.(type) {
+	case UnknownTagPathError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                        InvalidTagNameError                       //
+//******************************************************************//
+
+type InvalidTagNameError struct {
+	Name string
+}
+
+func (err InvalidTagNameError) Error() string {
+	return fmt.Sprintf("Invalid tag name %q, tag names must not contain %q", err.Name, PathSeparator)
+}
+
+func (err InvalidTagNameError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidTagNameError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidTagNameError) As(target any) bool {
+	switch target.(type) {
+	case InvalidTagNameError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                       InvalidTagParentError                      //
+//******************************************************************//
+
+type InvalidTagParentError struct {
+	Path       string
+	ParentPath string
+}
+
+func (err InvalidTagParentError) Error() string {
+	return fmt.Sprintf("Tag %q cannot be placed below itself or its descendant %q", err.Path, err.ParentPath)
+}
+
+func (err InvalidTagParentError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidTagParentError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidTagParentError) As(target any) bool {
+	switch target.(type) {
+	case InvalidTagParentError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                       AmbiguousTagPathError                      //
+//******************************************************************//
+
+type AmbiguousTagPathError struct {
+	Path       string
+	Candidates []string
+}
+
+func (err AmbiguousTagPathError) Error() string {
+	return fmt.Sprintf("Tag path %q is ambiguous, it could be any of %v", err.Path, err.Candidates)
+}
+
+func (err AmbiguousTagPathError) Is(other error) bool {
+	switch other.(type) {
+	case AmbiguousTagPathError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err AmbiguousTagPathError) As(target any) bool {
+	switch target.(type) {
+	case AmbiguousTagPathError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// UnmarshalPath returns the tag at path or, if no tag has this path, the only tag whose path ends with it,
+// e.g. "go::generics" or "generics" for "lang::go::generics". It is the reverse of MarshalPath.
+func (m *TagManager) UnmarshalPath(ctx context.Context, path string) (tag *domain.Tag, err error) {
+	tag, err = m.resolvePath(ctx, path)
+	if err != nil {
+		m.Logger.Error(err)
+	}
+
+	return
+}
+
+// AddPath adds the tags of path which do not exist yet and returns the tag at its end, e.g. "generics" for "lang::go::generics".
+// Existing tags are reused, so the existing tag is returned if path exists already.
+func (m *TagManager) AddPath(ctx context.Context, path string) (tag *domain.Tag, err error) {
+	pathTags := strings.Split(path, PathSeparator)
+
+	err = goaoi.AnyOfSlice(pathTags, func(pathTag string) bool { return pathTag == "" })
+	if err == nil {
+		err = helper.NilInputError{}
+		m.Logger.Error(err)
+
+		return
+	}
+
+	var parent *domain.Tag
+
+	// Once a tag is missing, its descendants are missing as well
+	isMissing := false
+
+	for _, pathTag := range pathTags {
+		if !isMissing {
+			tag, err = m.child(ctx, parent, pathTag)
+			if err != nil {
+				return
+			}
+
+			if tag != nil {
+				parent = tag
+
+				continue
+			}
+
+			isMissing = true
+		}
+
+		tag = &domain.Tag{Tag: pathTag}
+		if parent != nil {
+			tag.ParentPathIDs = append(slices.Clone(parent.ParentPathIDs), parent.ID)
+		}
+
+		err = m.Add(ctx, []*domain.Tag{tag})
+		if err != nil {
+			return
+		}
+
+		if parent != nil {
+			parent.SubtagIDs = append(slices.Clone(parent.SubtagIDs), tag.ID)
+
+			err = m.Replace(ctx, []*domain.Tag{parent})
+			if err != nil {
+				return
+			}
+		}
+
+		parent = tag
+	}
+
+	return
+}
+
+// resolvePath is UnmarshalPath without logging errors.
+func (m *TagManager) resolvePath(ctx context.Context, path string) (tag *domain.Tag, err error) {
+	pathTags := strings.Split(path, PathSeparator)
+
+	candidates, err := m.GetWhere(ctx, &domain.TagFilter{Tag: optional.Make(model.FilterOperation[string]{
+		Operator: model.FilterEqual,
+		Operand:  model.ScalarOperand[string]{Operand: pathTags[len(pathTags)-1]},
+	})})
+	if err != nil && !errors.Is(err, helper.IneffectiveOperationError{}) {
+		return
+	}
+
+	err = nil
+
+	// Tag names are not unique, only their paths are
+	var suffixCandidates []*domain.Tag
+	var suffixCandidatePaths []string
+
+	for _, candidate := range candidates {
+		var candidatePath string
+
+		candidatePath, err = m.MarshalPath(ctx, candidate, false)
+		if err != nil {
+			return
+		}
+
+		if candidatePath == path {
+			return candidate, nil
+		}
+
+		if strings.HasSuffix(candidatePath, PathSeparator+path) {
+			suffixCandidates = append(suffixCandidates, candidate)
+			suffixCandidatePaths = append(suffixCandidatePaths, candidatePath)
+		}
+	}
+
+	switch len(suffixCandidates) {
+	case 0:
+		err = UnknownTagPathError{Path: path}
+	case 1:
+		tag = suffixCandidates[0]
+	default:
+		err = AmbiguousTagPathError{Path: path, Candidates: suffixCandidatePaths}
+	}
+
+	return
+}
+
+// shortenPath returns the shortest end of path, the path of tag, which resolves to tag.
+func (m *TagManager) shortenPath(ctx context.Context, tag *domain.Tag, path string) (string, error) {
+	pathTags := strings.Split(path, PathSeparator)
+
+	for i := len(pathTags) - 1; i > 0; i-- {
+		shortenedPath := strings.Join(pathTags[i:], PathSeparator)
+
+		candidate, err := m.resolvePath(ctx, shortenedPath)
+		if err != nil && !errors.Is(err, AmbiguousTagPathError{}) {
+			return "", err
+		}
+
+		if err == nil && candidate.ID == tag.ID {
+			return shortenedPath, nil
+		}
+	}
+
+	return path, nil
+}
+
+// child returns the child of parent called name, the root tag called name if parent is nil, or nil if there is none.
+func (m *TagManager) child(ctx context.Context, parent *domain.Tag, name string) (*domain.Tag, error) {
+	var candidates []*domain.Tag
+	var err error
+
+	if parent != nil {
+		if len(parent.SubtagIDs) == 0 {
+			return nil, nil
+		}
+
+		candidates, err = m.GetFromIDs(ctx, parent.SubtagIDs)
+	} else {
+		filter := &domain.TagFilter{Tag: optional.Make(model.FilterOperation[string]{
+			Operator: model.FilterEqual,
+			Operand:  model.ScalarOperand[string]{Operand: name},
+		})}
+
+		var doesExist bool
+
+		doesExist, err = m.DoesExistWhere(ctx, filter)
+		if err != nil || !doesExist {
+			return nil, err
+		}
+
+		candidates, err = m.GetWhere(ctx, filter)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		if candidate.Tag == name && (parent != nil || len(candidate.ParentPathIDs) == 0) {
+			return candidate, nil
+		}
+	}
+
+	return nil, nil
+}
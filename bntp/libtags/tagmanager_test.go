@@ -7,9 +7,11 @@ import (
 	bntp "github.com/JonasMuehlmann/bntp.go/bntp"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 	sqlite3Repo "github.com/JonasMuehlmann/bntp.go/model/repository/sqlite3"
 	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/optional.go"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -22,6 +24,7 @@ func TestLibtagsMarshalPath(t *testing.T) {
 		name         string
 		path         string
 		tags         []*domain.Tag
+		shorten      bool
 	}{
 		{
 			name: "no args",
@@ -39,6 +42,25 @@ func TestLibtagsMarshalPath(t *testing.T) {
 			tag:  &domain.Tag{ID: 1, Tag: "foo", ParentPathIDs: []int64{2, 3}},
 			path: "bar::baz::foo",
 		},
+		{
+			name:    "shortened",
+			tags:    []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}},
+			tag:     &domain.Tag{ID: 2, Tag: "bar", ParentPathIDs: []int64{1}},
+			shorten: true,
+			path:    "bar",
+		},
+		{
+			name: "shortened with ambiguous name",
+			tags: []*domain.Tag{
+				{ID: 1, Tag: "foo", SubtagIDs: []int64{2}},
+				{ID: 2, Tag: "bar", ParentPathIDs: []int64{1}, SubtagIDs: []int64{3}},
+				{ID: 3, Tag: "baz", ParentPathIDs: []int64{1, 2}},
+				{ID: 4, Tag: "baz"},
+			},
+			tag:     &domain.Tag{ID: 3, Tag: "baz", ParentPathIDs: []int64{1, 2}},
+			shorten: true,
+			path:    "bar::baz",
+		},
 	}
 
 	for _, test := range tests {
@@ -54,7 +76,7 @@ func TestLibtagsMarshalPath(t *testing.T) {
 			tagRepoConcrete = tagRepoAbstract.(*sqlite3Repo.Sqlite3TagRepository)
 			assert.NoError(t, err, test.name+", assert tag repository creation")
 
-			tagManager, err := libtags.NewTagmanager(tagRepoConcrete.Logger, &bntp.Hooks[domain.Tag]{}, tagRepoConcrete)
+			tagManager, err := libtags.NewTagmanager(tagRepoConcrete.Logger, &bntp.Hooks[domain.Tag]{}, tagRepoConcrete, nil)
 			assert.NoError(t, err, test.name+", assert tag manager creation")
 
 			if test.tags != nil {
@@ -62,7 +84,7 @@ func TestLibtagsMarshalPath(t *testing.T) {
 				assert.NoError(t, err, test.name+", assert tag creation")
 			}
 
-			path, err := tagManager.MarshalPath(context.Background(), test.tag, false)
+			path, err := tagManager.MarshalPath(context.Background(), test.tag, test.shorten)
 
 			if test.err != nil {
 				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
@@ -76,3 +98,239 @@ func TestLibtagsMarshalPath(t *testing.T) {
 		})
 	}
 }
+
+func TestLibtagsUnmarshalPath(t *testing.T) {
+	tags := []*domain.Tag{
+		{ID: 1, Tag: "foo", SubtagIDs: []int64{2, 5}},
+		{ID: 2, Tag: "bar", ParentPathIDs: []int64{1}, SubtagIDs: []int64{4}},
+		{ID: 3, Tag: "bar", SubtagIDs: []int64{6}},
+		{ID: 4, Tag: "baz", ParentPathIDs: []int64{1, 2}},
+		{ID: 5, Tag: "qux", ParentPathIDs: []int64{1}},
+		{ID: 6, Tag: "qux", ParentPathIDs: []int64{3}},
+	}
+
+	tests := []struct {
+		err   error
+		name  string
+		path  string
+		tagID int64
+	}{
+		{
+			name:  "root",
+			path:  "foo",
+			tagID: 1,
+		},
+		{
+			name:  "child with ambiguous name",
+			path:  "foo::bar",
+			tagID: 2,
+		},
+		{
+			name:  "root with ambiguous name",
+			path:  "bar",
+			tagID: 3,
+		},
+		{
+			name: "unknown parent",
+			path: "baz::bar",
+			err:  libtags.UnknownTagPathError{},
+		},
+		{
+			name: "unknown tag",
+			path: "quux",
+			err:  libtags.UnknownTagPathError{},
+		},
+		{
+			name:  "shortened",
+			path:  "baz",
+			tagID: 4,
+		},
+		{
+			name:  "shortened with parent",
+			path:  "bar::baz",
+			tagID: 4,
+		},
+		{
+			name: "ambiguous shortened",
+			path: "qux",
+			err:  libtags.AmbiguousTagPathError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			tagRepoConcrete := &sqlite3Repo.Sqlite3TagRepository{}
+			tagRepoAbstract, err := tagRepoConcrete.New(sqlite3Repo.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: logrus.StandardLogger()})
+			tagRepoConcrete = tagRepoAbstract.(*sqlite3Repo.Sqlite3TagRepository)
+			assert.NoError(t, err, test.name+", assert tag repository creation")
+
+			tagManager, err := libtags.NewTagmanager(tagRepoConcrete.Logger, &bntp.Hooks[domain.Tag]{}, tagRepoConcrete, nil)
+			assert.NoError(t, err, test.name+", assert tag manager creation")
+
+			err = tagManager.Add(context.Background(), tags)
+			assert.NoError(t, err, test.name+", assert tag creation")
+
+			tag, err := tagManager.UnmarshalPath(context.Background(), test.path)
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+				assert.Equal(t, test.tagID, tag.ID, test.name+", assert returned tag matches")
+			}
+		})
+	}
+}
+
+func TestLibtagsAddPath(t *testing.T) {
+	tests := []struct {
+		err              error
+		name             string
+		path             string
+		tagID            int64
+		expectedNumAdded int64
+	}{
+		{
+			name: "empty tag",
+			path: "foo::::bar",
+			err:  helper.NilInputError{},
+		},
+		{
+			name:  "existing tag",
+			path:  "foo::bar",
+			tagID: 2,
+		},
+		{
+			name:             "root",
+			path:             "baz",
+			expectedNumAdded: 1,
+		},
+		{
+			name:             "existing parents",
+			path:             "foo::bar::baz",
+			expectedNumAdded: 1,
+		},
+		{
+			name:             "missing parents",
+			path:             "baz::qux::quux",
+			expectedNumAdded: 3,
+		},
+		{
+			name:             "partially missing parents",
+			path:             "foo::baz::qux",
+			expectedNumAdded: 2,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			tagRepoConcrete := &sqlite3Repo.Sqlite3TagRepository{}
+			tagRepoAbstract, err := tagRepoConcrete.New(sqlite3Repo.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: logrus.StandardLogger()})
+			tagRepoConcrete = tagRepoAbstract.(*sqlite3Repo.Sqlite3TagRepository)
+			assert.NoError(t, err, test.name+", assert tag repository creation")
+
+			tagManager, err := libtags.NewTagmanager(tagRepoConcrete.Logger, &bntp.Hooks[domain.Tag]{}, tagRepoConcrete, nil)
+			assert.NoError(t, err, test.name+", assert tag manager creation")
+
+			err = tagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+			assert.NoError(t, err, test.name+", assert tag creation")
+
+			tag, err := tagManager.AddPath(context.Background(), test.path)
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+
+				return
+			}
+
+			assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+
+			path, err := tagManager.MarshalPath(context.Background(), tag, false)
+			assert.NoError(t, err, test.name+", assert marshalling path")
+			assert.Equal(t, test.path, path, test.name+", assert returned tag matches")
+
+			if test.tagID != 0 {
+				assert.Equal(t, test.tagID, tag.ID, test.name+", assert existing tag is returned")
+			}
+
+			numTags, err := tagManager.CountAll(context.Background())
+			assert.NoError(t, err, test.name+", assert counting tags")
+			assert.Equal(t, 2+test.expectedNumAdded, numTags, test.name+", assert number of added tags matches")
+
+			if len(tag.ParentPathIDs) > 0 {
+				parents, err := tagManager.GetFromIDs(context.Background(), tag.ParentPathIDs[len(tag.ParentPathIDs)-1:])
+				assert.NoError(t, err, test.name+", assert getting parent")
+				assert.Contains(t, parents[0].SubtagIDs, tag.ID, test.name+", assert tag is subtag of parent")
+			}
+		})
+	}
+}
+
+func TestLibtagsCache(t *testing.T) {
+	db, err := testCommon.GetDB()
+	assert.NoError(t, err, "assert db creation")
+
+	tagRepoConcrete := &sqlite3Repo.Sqlite3TagRepository{}
+	tagRepoAbstract, err := tagRepoConcrete.New(sqlite3Repo.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: logrus.StandardLogger()})
+	tagRepoConcrete = tagRepoAbstract.(*sqlite3Repo.Sqlite3TagRepository)
+	assert.NoError(t, err, "assert tag repository creation")
+
+	tagManager, err := libtags.NewTagmanager(tagRepoConcrete.Logger, &bntp.Hooks[domain.Tag]{}, tagRepoConcrete, bntp.NewManagerCache(0, 0, (*domain.Tag).GetID))
+	assert.NoError(t, err, "assert tag manager creation")
+
+	err = tagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", ParentPathIDs: []int64{2, 3}}, {ID: 2, Tag: "bar"}, {ID: 3, Tag: "baz"}})
+	assert.NoError(t, err, "assert tag creation")
+
+	tags, err := tagManager.GetFromIDs(context.Background(), []int64{3, 1})
+	assert.NoError(t, err, "assert getting tags")
+	assert.Equal(t, []string{"baz", "foo"}, []string{tags[0].Tag, tags[1].Tag}, "assert tags are returned in order of IDs")
+
+	tags[0].Tag = "modified"
+
+	// Changes made bypassing the manager are not visible until the cache is invalidated
+	err = tagRepoConcrete.Update(context.Background(), []*domain.Tag{{ID: 2}, {ID: 3}}, &domain.TagUpdater{Tag: optional.Make(model.UpdateOperation[string]{Operator: model.UpdateSet, Operand: "changed"})})
+	assert.NoError(t, err, "assert updating tags through repository")
+
+	tags, err = tagManager.GetFromIDs(context.Background(), []int64{3})
+	assert.NoError(t, err, "assert getting cached tag")
+	assert.Equal(t, "baz", tags[0].Tag, "assert cached tag is returned unmodified")
+
+	path, err := tagManager.MarshalPath(context.Background(), tags[0], false)
+	assert.NoError(t, err, "assert marshalling path")
+	assert.Equal(t, "baz", path)
+
+	allTags, err := tagManager.GetAll(context.Background())
+	assert.NoError(t, err, "assert getting all tags")
+	assert.Len(t, allTags, 3)
+
+	err = tagRepoConcrete.Add(context.Background(), []*domain.Tag{{ID: 4, Tag: "qux"}})
+	assert.NoError(t, err, "assert adding tag through repository")
+
+	allTags, err = tagManager.GetAll(context.Background())
+	assert.NoError(t, err, "assert getting cached tags")
+	assert.Len(t, allTags, 3)
+
+	// Writes through the manager invalidate the cache
+	err = tagManager.Add(context.Background(), []*domain.Tag{{ID: 5, Tag: "quux"}})
+	assert.NoError(t, err, "assert adding tag")
+
+	allTags, err = tagManager.GetAll(context.Background())
+	assert.NoError(t, err, "assert getting all tags")
+	assert.Len(t, allTags, 5)
+
+	path, err = tagManager.MarshalPath(context.Background(), &domain.Tag{ID: 1, Tag: "foo", ParentPathIDs: []int64{2, 3}}, false)
+	assert.NoError(t, err, "assert marshalling path")
+	assert.Equal(t, "changed::changed::foo", path)
+}
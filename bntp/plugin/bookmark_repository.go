@@ -0,0 +1,251 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// THIS CODE IS GENERATED BY GO GENERATE, IT'S TEMPLATE IS /templates/plugin_repository.go.tpl
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// PluginBookmarkRepository is a BookmarkRepository provided by a plugin.
+type PluginBookmarkRepository struct {
+	client        *RPCClient
+	tagRepository repository.TagRepository
+}
+
+type PluginBookmarkRepositoryConstructorArgs struct {
+	Client        *RPCClient
+	TagRepository repository.TagRepository
+}
+
+func (repo *PluginBookmarkRepository) New(args any) (newRepo repository.BookmarkRepository, err error) {
+	constructorArgs, ok := args.(PluginBookmarkRepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", PluginBookmarkRepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.client = constructorArgs.Client
+	repo.tagRepository = constructorArgs.TagRepository
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) Add(ctx context.Context, domainModels []*domain.Bookmark) error {
+	return repo.client.Call(ctx, "Add", nil, domainModels)
+}
+
+func (repo *PluginBookmarkRepository) Replace(ctx context.Context, domainModels []*domain.Bookmark) error {
+	return repo.client.Call(ctx, "Replace", nil, domainModels)
+}
+
+func (repo *PluginBookmarkRepository) Upsert(ctx context.Context, domainModels []*domain.Bookmark) error {
+	return repo.client.Call(ctx, "Upsert", nil, domainModels)
+}
+
+func (repo *PluginBookmarkRepository) Update(ctx context.Context, domainModels []*domain.Bookmark, domainUpdaters *domain.BookmarkUpdater) error {
+	return repo.client.Call(ctx, "Update", nil, domainModels, domainUpdaters)
+}
+
+func (repo *PluginBookmarkRepository) UpdateWhere(ctx context.Context, domainFilter *domain.BookmarkFilter, domainUpdaters *domain.BookmarkUpdater) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "UpdateWhere", []any{&numAffectedRecords}, domainFilter, domainUpdaters)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) Delete(ctx context.Context, domainModels []*domain.Bookmark) error {
+	return repo.client.Call(ctx, "Delete", nil, domainModels)
+}
+
+func (repo *PluginBookmarkRepository) DeleteWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "DeleteWhere", []any{&numAffectedRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) CountWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountWhere", []any{&numRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountAll", []any{&numRecords})
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) CountGroupedWhere(ctx context.Context, domainFilter *domain.BookmarkFilter, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedWhere", []any{&groups}, domainFilter, domainGrouper)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedAll", []any{&groups}, domainGrouper)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) DoesExist(ctx context.Context, domainModel *domain.Bookmark) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExist", []any{&doesExist}, domainModel)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) DoesExistWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExistWhere", []any{&doesExist}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetWhere", []any{&records}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetFirstWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (record *domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhere", []any{&record}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetAll(ctx context.Context) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetAll", []any{&records})
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetWhereSorted(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetWhereSorted", []any{&records}, domainFilter, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetAllSorted(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetAllSorted", []any{&records}, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetWhereSelected(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetWhereSelected", []any{&records}, domainFilter, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetAllSelected(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetAllSelected", []any{&records}, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetFirstWhereSelected(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSelector domain.BookmarkMemberSelector) (record *domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhereSelected", []any{&record}, domainFilter, domainSelector)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "GetFromIDs", []any{&records}, ids)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) AddType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "AddType", nil, types)
+}
+
+func (repo *PluginBookmarkRepository) DeleteType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "DeleteType", nil, types)
+}
+
+func (repo *PluginBookmarkRepository) UpdateType(ctx context.Context, oldType string, newType string) error {
+	return repo.client.Call(ctx, "UpdateType", nil, oldType, newType)
+}
+
+func (repo *PluginBookmarkRepository) GetAllTypes(ctx context.Context) (types []string, err error) {
+	err = repo.client.Call(ctx, "GetAllTypes", []any{&types})
+
+	return
+}
+
+// GetTagRepository returns the tag repository of bntp.go, not the one used by the plugin.
+func (repo *PluginBookmarkRepository) GetTagRepository() repository.TagRepository {
+	return repo.tagRepository
+}
+
+//******************************************************************//
+//                            Converters                            //
+//******************************************************************//
+// The repository specific values are received as decoded JSON.
+
+func (repo *PluginBookmarkRepository) BookmarkRepositoryToDomainModel(ctx context.Context, repositoryModel any) (domainModel *domain.Bookmark, err error) {
+	err = repo.client.Call(ctx, "BookmarkRepositoryToDomainModel", []any{&domainModel}, repositoryModel)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositoryModel(ctx context.Context, domainModel *domain.Bookmark) (repositoryModel any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositoryModel", []any{&repositoryModel}, domainModel)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.BookmarkFilter) (repositoryFilter any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositoryFilter", []any{&repositoryFilter}, domainFilter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.BookmarkUpdater) (repositoryUpdater any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositoryUpdater", []any{&repositoryUpdater}, domainUpdater)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositorySorter(ctx context.Context, domainSorter domain.BookmarkSorter) (repositorySorter any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositorySorter", []any{&repositorySorter}, domainSorter)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.BookmarkMemberSelector) (repositorySelector any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositoryMemberSelector", []any{&repositorySelector}, domainSelector)
+
+	return
+}
+
+func (repo *PluginBookmarkRepository) BookmarkDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (repositoryGrouper any, err error) {
+	err = repo.client.Call(ctx, "BookmarkDomainToRepositoryGrouper", []any{&repositoryGrouper}, domainGrouper)
+
+	return
+}
@@ -0,0 +1,87 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+	"github.com/barweiss/go-tuple"
+)
+
+// PluginDocumentContentRepository is a DocumentContentRepository provided by a plugin.
+type PluginDocumentContentRepository struct {
+	client *RPCClient
+}
+
+type PluginDocumentContentRepositoryConstructorArgs struct {
+	Client *RPCClient
+}
+
+func (repo *PluginDocumentContentRepository) New(args any) (newRepo repository.DocumentContentRepository, err error) {
+	constructorArgs, ok := args.(PluginDocumentContentRepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", PluginDocumentContentRepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.client = constructorArgs.Client
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *PluginDocumentContentRepository) Add(ctx context.Context, pathContents []tuple.T2[string, string]) error {
+	return repo.client.Call(ctx, "Add", nil, pathContents)
+}
+
+func (repo *PluginDocumentContentRepository) Update(ctx context.Context, pathContents []tuple.T2[string, string]) error {
+	return repo.client.Call(ctx, "Update", nil, pathContents)
+}
+
+func (repo *PluginDocumentContentRepository) Move(ctx context.Context, pathChanges []tuple.T2[string, string]) error {
+	return repo.client.Call(ctx, "Move", nil, pathChanges)
+}
+
+func (repo *PluginDocumentContentRepository) Delete(ctx context.Context, paths []string) error {
+	return repo.client.Call(ctx, "Delete", nil, paths)
+}
+
+func (repo *PluginDocumentContentRepository) Get(ctx context.Context, paths []string) (contents []string, err error) {
+	err = repo.client.Call(ctx, "Get", []any{&contents}, paths)
+
+	return
+}
+
+func (repo *PluginDocumentContentRepository) Trash(ctx context.Context, paths []string) error {
+	return repo.client.Call(ctx, "Trash", nil, paths)
+}
+
+func (repo *PluginDocumentContentRepository) Restore(ctx context.Context, paths []string) error {
+	return repo.client.Call(ctx, "Restore", nil, paths)
+}
+
+func (repo *PluginDocumentContentRepository) Purge(ctx context.Context, paths []string) error {
+	return repo.client.Call(ctx, "Purge", nil, paths)
+}
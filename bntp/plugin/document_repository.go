@@ -0,0 +1,251 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// THIS CODE IS GENERATED BY GO GENERATE, IT'S TEMPLATE IS /templates/plugin_repository.go.tpl
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// PluginDocumentRepository is a DocumentRepository provided by a plugin.
+type PluginDocumentRepository struct {
+	client        *RPCClient
+	tagRepository repository.TagRepository
+}
+
+type PluginDocumentRepositoryConstructorArgs struct {
+	Client        *RPCClient
+	TagRepository repository.TagRepository
+}
+
+func (repo *PluginDocumentRepository) New(args any) (newRepo repository.DocumentRepository, err error) {
+	constructorArgs, ok := args.(PluginDocumentRepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", PluginDocumentRepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.client = constructorArgs.Client
+	repo.tagRepository = constructorArgs.TagRepository
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *PluginDocumentRepository) Add(ctx context.Context, domainModels []*domain.Document) error {
+	return repo.client.Call(ctx, "Add", nil, domainModels)
+}
+
+func (repo *PluginDocumentRepository) Replace(ctx context.Context, domainModels []*domain.Document) error {
+	return repo.client.Call(ctx, "Replace", nil, domainModels)
+}
+
+func (repo *PluginDocumentRepository) Upsert(ctx context.Context, domainModels []*domain.Document) error {
+	return repo.client.Call(ctx, "Upsert", nil, domainModels)
+}
+
+func (repo *PluginDocumentRepository) Update(ctx context.Context, domainModels []*domain.Document, domainUpdaters *domain.DocumentUpdater) error {
+	return repo.client.Call(ctx, "Update", nil, domainModels, domainUpdaters)
+}
+
+func (repo *PluginDocumentRepository) UpdateWhere(ctx context.Context, domainFilter *domain.DocumentFilter, domainUpdaters *domain.DocumentUpdater) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "UpdateWhere", []any{&numAffectedRecords}, domainFilter, domainUpdaters)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) Delete(ctx context.Context, domainModels []*domain.Document) error {
+	return repo.client.Call(ctx, "Delete", nil, domainModels)
+}
+
+func (repo *PluginDocumentRepository) DeleteWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "DeleteWhere", []any{&numAffectedRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) CountWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountWhere", []any{&numRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountAll", []any{&numRecords})
+
+	return
+}
+
+func (repo *PluginDocumentRepository) CountGroupedWhere(ctx context.Context, domainFilter *domain.DocumentFilter, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedWhere", []any{&groups}, domainFilter, domainGrouper)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedAll", []any{&groups}, domainGrouper)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DoesExist(ctx context.Context, domainModel *domain.Document) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExist", []any{&doesExist}, domainModel)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DoesExistWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExistWhere", []any{&doesExist}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetWhere", []any{&records}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetFirstWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (record *domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhere", []any{&record}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetAll(ctx context.Context) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetAll", []any{&records})
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetWhereSorted(ctx context.Context, domainFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetWhereSorted", []any{&records}, domainFilter, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetAllSorted(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetAllSorted", []any{&records}, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetWhereSelected(ctx context.Context, domainFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetWhereSelected", []any{&records}, domainFilter, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetAllSelected(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetAllSelected", []any{&records}, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetFirstWhereSelected(ctx context.Context, domainFilter *domain.DocumentFilter, domainSelector domain.DocumentMemberSelector) (record *domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhereSelected", []any{&record}, domainFilter, domainSelector)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Document, err error) {
+	err = repo.client.Call(ctx, "GetFromIDs", []any{&records}, ids)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) AddType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "AddType", nil, types)
+}
+
+func (repo *PluginDocumentRepository) DeleteType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "DeleteType", nil, types)
+}
+
+func (repo *PluginDocumentRepository) UpdateType(ctx context.Context, oldType string, newType string) error {
+	return repo.client.Call(ctx, "UpdateType", nil, oldType, newType)
+}
+
+func (repo *PluginDocumentRepository) GetAllTypes(ctx context.Context) (types []string, err error) {
+	err = repo.client.Call(ctx, "GetAllTypes", []any{&types})
+
+	return
+}
+
+// GetTagRepository returns the tag repository of bntp.go, not the one used by the plugin.
+func (repo *PluginDocumentRepository) GetTagRepository() repository.TagRepository {
+	return repo.tagRepository
+}
+
+//******************************************************************//
+//                            Converters                            //
+//******************************************************************//
+// The repository specific values are received as decoded JSON.
+
+func (repo *PluginDocumentRepository) DocumentRepositoryToDomainModel(ctx context.Context, repositoryModel any) (domainModel *domain.Document, err error) {
+	err = repo.client.Call(ctx, "DocumentRepositoryToDomainModel", []any{&domainModel}, repositoryModel)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositoryModel(ctx context.Context, domainModel *domain.Document) (repositoryModel any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositoryModel", []any{&repositoryModel}, domainModel)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.DocumentFilter) (repositoryFilter any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositoryFilter", []any{&repositoryFilter}, domainFilter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.DocumentUpdater) (repositoryUpdater any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositoryUpdater", []any{&repositoryUpdater}, domainUpdater)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositorySorter(ctx context.Context, domainSorter domain.DocumentSorter) (repositorySorter any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositorySorter", []any{&repositorySorter}, domainSorter)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.DocumentMemberSelector) (repositorySelector any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositoryMemberSelector", []any{&repositorySelector}, domainSelector)
+
+	return
+}
+
+func (repo *PluginDocumentRepository) DocumentDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.DocumentGrouper) (repositoryGrouper any, err error) {
+	err = repo.client.Call(ctx, "DocumentDomainToRepositoryGrouper", []any{&repositoryGrouper}, domainGrouper)
+
+	return
+}
@@ -0,0 +1,132 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+)
+
+// Entity kinds hooks can be provided for.
+const (
+	bookmarkHookKind        = "bookmark"
+	tagHookKind             = "tag"
+	documentHookKind        = "document"
+	documentContentHookKind = "document_content"
+)
+
+// HookSet holds the hooks a plugin provides by name.
+// Changes a hook makes to the entity are passed back to bntp.go.
+type HookSet struct {
+	BookmarkHooks        map[string]func(context.Context, *domain.Bookmark) error
+	TagHooks             map[string]func(context.Context, *domain.Tag) error
+	DocumentHooks        map[string]func(context.Context, *domain.Document) error
+	DocumentContentHooks map[string]func(context.Context, *string) error
+}
+
+type hookSetServer struct {
+	hooks *HookSet
+}
+
+func (server *hookSetServer) HookNames() map[string][]string {
+	return map[string][]string{
+		bookmarkHookKind:        hookNames(server.hooks.BookmarkHooks),
+		tagHookKind:             hookNames(server.hooks.TagHooks),
+		documentHookKind:        hookNames(server.hooks.DocumentHooks),
+		documentContentHookKind: hookNames(server.hooks.DocumentContentHooks),
+	}
+}
+
+func (server *hookSetServer) Execute(ctx context.Context, kind string, name string, point string, entity json.RawMessage) (json.RawMessage, error) {
+	// Hook points without a name, e.g. combined ones, are passed as the zero value
+	hookPoint, _ := bntp.HookPointFromString(point)
+	ctx = bntp.ContextWithHookPoint(ctx, hookPoint)
+
+	switch kind {
+	case bookmarkHookKind:
+		return executeHook(ctx, server.hooks.BookmarkHooks, name, entity)
+	case tagHookKind:
+		return executeHook(ctx, server.hooks.TagHooks, name, entity)
+	case documentHookKind:
+		return executeHook(ctx, server.hooks.DocumentHooks, name, entity)
+	case documentContentHookKind:
+		return executeHook(ctx, server.hooks.DocumentContentHooks, name, entity)
+	default:
+		return nil, fmt.Errorf("unknown hook kind %q", kind)
+	}
+}
+
+func hookNames[TEntity any](hooks map[string]func(context.Context, *TEntity) error) []string {
+	names := make([]string, 0, len(hooks))
+
+	for name := range hooks {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func executeHook[TEntity any](ctx context.Context, hooks map[string]func(context.Context, *TEntity) error, name string, entityRaw json.RawMessage) (json.RawMessage, error) {
+	hook, ok := hooks[name]
+	if !ok {
+		return nil, bntp.UnknownHookError{Name: name}
+	}
+
+	entity := new(TEntity)
+
+	err := json.Unmarshal(entityRaw, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	hookErr := hook(ctx, entity)
+
+	entityRaw, err = json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return entityRaw, hookErr
+}
+
+// newRemoteHook creates a hook executing the hook called name on the plugin behind client.
+func newRemoteHook[TEntity any](client *RPCClient, kind string, name string) func(context.Context, *TEntity) error {
+	return func(ctx context.Context, entity *TEntity) error {
+		point, _ := bntp.HookPointFromContext(ctx)
+
+		var entityRaw json.RawMessage
+
+		hookErr := client.Call(ctx, "Execute", []any{&entityRaw}, kind, name, point.String(), entity)
+
+		if len(entityRaw) > 0 && string(entityRaw) != "null" {
+			err := json.Unmarshal(entityRaw, entity)
+			if err != nil {
+				return err
+			}
+		}
+
+		return hookErr
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package plugin
+
+import (
+	"context"
+	"io"
+	"os/exec"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+)
+
+// clientPluginMap lists everything a plugin can provide, Dispense fails for what it doesn't.
+var clientPluginMap = map[string]goplugin.Plugin{
+	BookmarkRepositoryPluginName:        &RPCPlugin{},
+	TagRepositoryPluginName:             &RPCPlugin{},
+	DocumentRepositoryPluginName:        &RPCPlugin{},
+	DocumentContentRepositoryPluginName: &RPCPlugin{},
+	HooksPluginName:                     &RPCPlugin{},
+}
+
+// Host runs plugin binaries and provides their implementations.
+type Host struct {
+	// Output receives the log output of the plugins.
+	Output  io.Writer
+	clients map[string]goplugin.ClientProtocol
+	closers []func()
+}
+
+func NewHost(output io.Writer) *Host {
+	return &Host{Output: output, clients: make(map[string]goplugin.ClientProtocol)}
+}
+
+// Load starts the plugin binary at path and registers the hooks it provides as "<name>.<hook>" in the registries of the backend package.
+func (host *Host) Load(name string, path string, args ...string) error {
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig: Handshake,
+		Plugins:         clientPluginMap,
+		Cmd:             exec.Command(path, args...),
+		Logger:          hclog.New(&hclog.LoggerOptions{Name: "plugin." + name, Output: host.Output, Level: hclog.Warn}),
+	})
+
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+
+		return err
+	}
+
+	return host.Add(name, rpcClient, client.Kill)
+}
+
+// Add makes the plugin connected through client available as name, closer is called by Close.
+func (host *Host) Add(name string, client goplugin.ClientProtocol, closer func()) error {
+	host.clients[name] = client
+	host.closers = append(host.closers, closer)
+
+	hooksClient, err := host.dispense(name, HooksPluginName)
+	if err != nil {
+		// Providing hooks is optional
+		return nil
+	}
+
+	var hookNames map[string][]string
+
+	err = hooksClient.Call(context.Background(), "HookNames", []any{&hookNames})
+	if err != nil {
+		return err
+	}
+
+	for _, hookName := range hookNames[bookmarkHookKind] {
+		err = backend.BookmarkHookRegistry.Register(name+"."+hookName, newRemoteHook[domain.Bookmark](hooksClient, bookmarkHookKind, hookName))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, hookName := range hookNames[tagHookKind] {
+		err = backend.TagHookRegistry.Register(name+"."+hookName, newRemoteHook[domain.Tag](hooksClient, tagHookKind, hookName))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, hookName := range hookNames[documentHookKind] {
+		err = backend.DocumentHookRegistry.Register(name+"."+hookName, newRemoteHook[domain.Document](hooksClient, documentHookKind, hookName))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, hookName := range hookNames[documentContentHookKind] {
+		err = backend.DocumentContentHookRegistry.Register(name+"."+hookName, newRemoteHook[string](hooksClient, documentContentHookKind, hookName))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close stops all plugins.
+func (host *Host) Close() {
+	for _, closer := range host.closers {
+		closer()
+	}
+
+	host.clients = make(map[string]goplugin.ClientProtocol)
+	host.closers = nil
+}
+
+func (host *Host) BookmarkRepository(name string, tagRepository repository.TagRepository) (repository.BookmarkRepository, error) {
+	client, err := host.dispense(name, BookmarkRepositoryPluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(PluginBookmarkRepository).New(PluginBookmarkRepositoryConstructorArgs{Client: client, TagRepository: tagRepository})
+}
+
+func (host *Host) TagRepository(name string) (repository.TagRepository, error) {
+	client, err := host.dispense(name, TagRepositoryPluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(PluginTagRepository).New(PluginTagRepositoryConstructorArgs{Client: client})
+}
+
+func (host *Host) DocumentRepository(name string, tagRepository repository.TagRepository) (repository.DocumentRepository, error) {
+	client, err := host.dispense(name, DocumentRepositoryPluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(PluginDocumentRepository).New(PluginDocumentRepositoryConstructorArgs{Client: client, TagRepository: tagRepository})
+}
+
+func (host *Host) DocumentContentRepository(name string) (repository.DocumentContentRepository, error) {
+	client, err := host.dispense(name, DocumentContentRepositoryPluginName)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(PluginDocumentContentRepository).New(PluginDocumentContentRepositoryConstructorArgs{Client: client})
+}
+
+func (host *Host) dispense(name string, kind string) (*RPCClient, error) {
+	client, ok := host.clients[name]
+	if !ok {
+		return nil, UnknownPluginError{Plugin: name}
+	}
+
+	raw, err := client.Dispense(kind)
+	if err != nil {
+		return nil, UnsupportedPluginError{Plugin: name, Kind: kind}
+	}
+
+	rpcClient, ok := raw.(*RPCClient)
+	if !ok {
+		return nil, UnsupportedPluginError{Plugin: name, Kind: kind}
+	}
+
+	return rpcClient, nil
+}
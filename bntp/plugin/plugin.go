@@ -0,0 +1,366 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package plugin allows providing repositories and hooks through out of process plugins based on hashicorp/go-plugin.
+//
+// A plugin binary calls Serve with the implementations it provides,
+// bntp.go loads the plugins listed in the config through a Host.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/rpc"
+	"reflect"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+	goplugin "github.com/hashicorp/go-plugin"
+)
+
+// Handshake is shared by bntp.go and its plugins, a mismatch means the plugin is incompatible.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "BNTP_PLUGIN",
+	MagicCookieValue: "bntp",
+}
+
+// Names under which plugins provide their implementations.
+const (
+	BookmarkRepositoryPluginName        = "bookmark_repository"
+	TagRepositoryPluginName             = "tag_repository"
+	DocumentRepositoryPluginName        = "document_repository"
+	DocumentContentRepositoryPluginName = "document_content_repository"
+	HooksPluginName                     = "hooks"
+)
+
+// ******************************************************************//
+//                              Errors                              //
+// ******************************************************************//
+
+type UnsupportedPluginError struct {
+	Plugin string
+	Kind   string
+}
+
+func (err UnsupportedPluginError) Error() string {
+	return fmt.Sprintf("Plugin %q does not provide a %v", err.Plugin, err.Kind)
+}
+
+func (err UnsupportedPluginError) Is(other error) bool {
+	switch other.(type) {
+	case UnsupportedPluginError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnsupportedPluginError) As(target any) bool {
+	switch target.(type) {
+	case UnsupportedPluginError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+type UnknownPluginError struct {
+	Plugin string
+}
+
+func (err UnknownPluginError) Error() string {
+	return fmt.Sprintf("No plugin named %q is loaded", err.Plugin)
+}
+
+func (err UnknownPluginError) Is(other error) bool {
+	switch other.(type) {
+	case UnknownPluginError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnknownPluginError) As(target any) bool {
+	switch target.(type) {
+	case UnknownPluginError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// remoteErrorKinds are errors which keep their identity when returned from a plugin.
+var remoteErrorKinds = map[string]error{
+	"empty_input":             helper.EmptyInputError{},
+	"nil_input":               helper.NilInputError{},
+	"ineffective_operation":   helper.IneffectiveOperationError{},
+	"duplicate_insertion":     helper.DuplicateInsertionError{},
+	"non_existent_primary":    helper.NonExistentPrimaryDataError{},
+	"non_existent_dependency": helper.NonExistentDependencyError{},
+	"nop_updater":             helper.NopUpdaterError{},
+}
+
+// RemoteError is an error returned by a plugin.
+// errors.Is matches it against all errors in remoteErrorKinds the original error matched.
+type RemoteError struct {
+	Kinds   []string
+	Message string
+}
+
+func newRemoteError(err error) *RemoteError {
+	if err == nil {
+		return nil
+	}
+
+	remoteErr := &RemoteError{Message: err.Error()}
+
+	for kind, kindErr := range remoteErrorKinds {
+		if errors.Is(err, kindErr) {
+			remoteErr.Kinds = append(remoteErr.Kinds, kind)
+		}
+	}
+
+	return remoteErr
+}
+
+func (err RemoteError) Error() string {
+	return err.Message
+}
+
+func (err RemoteError) Is(other error) bool {
+	for _, kind := range err.Kinds {
+		if kindErr, ok := remoteErrorKinds[kind]; ok && errors.Is(kindErr, other) {
+			return true
+		}
+	}
+
+	switch other.(type) {
+	case RemoteError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err RemoteError) As(target any) bool {
+	switch target.(type) {
+	case RemoteError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
+
+// ******************************************************************//
+//                             Transport                            //
+// ******************************************************************//
+
+// CallArgs describes a method call on the implementation served by a plugin, arguments are JSON encoded.
+// The context of the caller is not transmitted.
+type CallArgs struct {
+	Method string
+	Args   [][]byte
+}
+
+type CallReply struct {
+	Results [][]byte
+	Err     *RemoteError
+}
+
+// RPCServer calls the methods of Impl as requested by an RPCClient.
+type RPCServer struct {
+	Impl any
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func (server *RPCServer) Call(args CallArgs, reply *CallReply) error {
+	method := reflect.ValueOf(server.Impl).MethodByName(args.Method)
+	if !method.IsValid() {
+		return fmt.Errorf("%T has no method %v", server.Impl, args.Method)
+	}
+
+	methodType := method.Type()
+	in := make([]reflect.Value, 0, methodType.NumIn())
+	iArg := 0
+
+	for i := 0; i < methodType.NumIn(); i++ {
+		paramType := methodType.In(i)
+
+		if paramType == contextType {
+			in = append(in, reflect.ValueOf(context.Background()))
+
+			continue
+		}
+
+		if iArg >= len(args.Args) {
+			return fmt.Errorf("missing argument %v for %v", i, args.Method)
+		}
+
+		param := reflect.New(paramType)
+
+		err := json.Unmarshal(args.Args[iArg], param.Interface())
+		if err != nil {
+			return err
+		}
+
+		in = append(in, param.Elem())
+		iArg++
+	}
+
+	out := method.Call(in)
+
+	for _, result := range out {
+		if result.Type() == errorType {
+			if !result.IsNil() {
+				reply.Err = newRemoteError(result.Interface().(error))
+			}
+
+			continue
+		}
+
+		resultRaw, err := json.Marshal(result.Interface())
+		if err != nil {
+			return err
+		}
+
+		reply.Results = append(reply.Results, resultRaw)
+	}
+
+	return nil
+}
+
+// RPCClient calls methods on the implementation served by a plugin.
+type RPCClient struct {
+	client *rpc.Client
+}
+
+// Call calls method with args and decodes the non-error results into results, which have to be pointers.
+func (client *RPCClient) Call(ctx context.Context, method string, results []any, args ...any) error {
+	callArgs := CallArgs{Method: method, Args: make([][]byte, 0, len(args))}
+
+	for _, arg := range args {
+		argRaw, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+
+		callArgs.Args = append(callArgs.Args, argRaw)
+	}
+
+	reply := new(CallReply)
+
+	call := client.client.Go("Plugin.Call", callArgs, reply, nil)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-call.Done:
+	}
+
+	if call.Error != nil {
+		return call.Error
+	}
+
+	for i, result := range results {
+		if i >= len(reply.Results) {
+			break
+		}
+
+		err := json.Unmarshal(reply.Results[i], result)
+		if err != nil {
+			return err
+		}
+	}
+
+	if reply.Err != nil {
+		return *reply.Err
+	}
+
+	return nil
+}
+
+// RPCPlugin serves Impl through an RPCServer and connects to it through an RPCClient.
+type RPCPlugin struct {
+	Impl any
+}
+
+func (p *RPCPlugin) Server(*goplugin.MuxBroker) (any, error) {
+	return &RPCServer{Impl: p.Impl}, nil
+}
+
+func (p *RPCPlugin) Client(_ *goplugin.MuxBroker, client *rpc.Client) (any, error) {
+	return &RPCClient{client: client}, nil
+}
+
+// ******************************************************************//
+//                              Serving                             //
+// ******************************************************************//
+
+// ServeArgs holds the implementations a plugin provides, unset ones are not served.
+type ServeArgs struct {
+	BookmarkRepository        repository.BookmarkRepository
+	TagRepository             repository.TagRepository
+	DocumentRepository        repository.DocumentRepository
+	DocumentContentRepository repository.DocumentContentRepository
+	Hooks                     *HookSet
+}
+
+// PluginMap returns the plugins served for args.
+func PluginMap(args ServeArgs) map[string]goplugin.Plugin {
+	plugins := map[string]goplugin.Plugin{}
+
+	if args.BookmarkRepository != nil {
+		plugins[BookmarkRepositoryPluginName] = &RPCPlugin{Impl: args.BookmarkRepository}
+	}
+	if args.TagRepository != nil {
+		plugins[TagRepositoryPluginName] = &RPCPlugin{Impl: args.TagRepository}
+	}
+	if args.DocumentRepository != nil {
+		plugins[DocumentRepositoryPluginName] = &RPCPlugin{Impl: args.DocumentRepository}
+	}
+	if args.DocumentContentRepository != nil {
+		plugins[DocumentContentRepositoryPluginName] = &RPCPlugin{Impl: args.DocumentContentRepository}
+	}
+	if args.Hooks != nil {
+		plugins[HooksPluginName] = &RPCPlugin{Impl: &hookSetServer{hooks: args.Hooks}}
+	}
+
+	return plugins
+}
+
+// Serve is called by plugin binaries to provide the implementations in args, it blocks until bntp.go exits.
+func Serve(args ServeArgs) {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins:         PluginMap(args),
+	})
+}
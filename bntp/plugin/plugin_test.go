@@ -0,0 +1,112 @@
+package plugin_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/bntp/plugin"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	repository "github.com/JonasMuehlmann/bntp.go/model/repository/sqlite3"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/optional.go"
+	goplugin "github.com/hashicorp/go-plugin"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHost(t *testing.T, name string, serveArgs plugin.ServeArgs) *plugin.Host {
+	client, _ := goplugin.TestPluginRPCConn(t, plugin.PluginMap(serveArgs), nil)
+
+	host := plugin.NewHost(testCommon.NewBufferString(""))
+
+	err := host.Add(name, client, func() { client.Close() })
+	require.NoError(t, err)
+
+	t.Cleanup(host.Close)
+
+	return host
+}
+
+func TestPluginBookmarkRepository(t *testing.T) {
+	db, err := testCommon.GetDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	tagRepository, err := new(repository.Sqlite3TagRepository).New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+	require.NoError(t, err)
+
+	bookmarkRepository, err := new(repository.Sqlite3BookmarkRepository).New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepository})
+	require.NoError(t, err)
+
+	host := newTestHost(t, "repository_test", plugin.ServeArgs{BookmarkRepository: bookmarkRepository})
+
+	repo, err := host.BookmarkRepository("repository_test", nil)
+	require.NoError(t, err)
+
+	_, err = repo.GetAll(context.Background())
+	assert.ErrorIs(t, err, helper.IneffectiveOperationError{}, "assert error kind is kept")
+
+	err = repo.Add(context.Background(), []*domain.Bookmark{{ID: 1, URL: "foo", Title: optional.Make("Foo")}, {ID: 2, URL: "bar"}})
+	assert.NoError(t, err)
+
+	numRecords, err := repo.CountAll(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), numRecords)
+
+	records, err := repo.GetWhere(context.Background(), &domain.BookmarkFilter{URL: optional.Make(model.FilterOperation[string]{
+		Operator: model.FilterEqual,
+		Operand:  model.ScalarOperand[string]{Operand: "foo"},
+	})})
+	assert.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "foo", records[0].URL)
+	assert.Equal(t, optional.Make("Foo"), records[0].Title)
+
+	_, err = host.TagRepository("repository_test")
+	assert.ErrorIs(t, err, plugin.UnsupportedPluginError{})
+
+	_, err = host.TagRepository("foo")
+	assert.ErrorIs(t, err, plugin.UnknownPluginError{})
+}
+
+func TestPluginHooks(t *testing.T) {
+	var receivedHookPoint bntp.HookPoint
+
+	newTestHost(t, "hooks_test", plugin.ServeArgs{Hooks: &plugin.HookSet{
+		BookmarkHooks: map[string]func(context.Context, *domain.Bookmark) error{
+			"set_title": func(ctx context.Context, bookmark *domain.Bookmark) error {
+				receivedHookPoint, _ = bntp.HookPointFromContext(ctx)
+				bookmark.Title = optional.Make("Set by plugin")
+
+				return nil
+			},
+			"fail": func(_ context.Context, _ *domain.Bookmark) error {
+				return errors.New("failed in plugin")
+			},
+		},
+	}})
+
+	hook, err := backend.BookmarkHookRegistry.Get("hooks_test.set_title")
+	require.NoError(t, err)
+
+	bookmark := &domain.Bookmark{ID: 1, URL: "foo"}
+
+	err = hook(bntp.ContextWithHookPoint(context.Background(), bntp.BeforeAddHook), bookmark)
+	assert.NoError(t, err)
+	assert.Equal(t, optional.Make("Set by plugin"), bookmark.Title, "assert changes to entity are passed back")
+	assert.Equal(t, bntp.BeforeAddHook, receivedHookPoint)
+
+	hook, err = backend.BookmarkHookRegistry.Get("hooks_test.fail")
+	require.NoError(t, err)
+
+	err = hook(context.Background(), bookmark)
+	assert.ErrorContains(t, err, "failed in plugin")
+
+	assert.False(t, backend.TagHookRegistry.Has("hooks_test.set_title"))
+}
@@ -0,0 +1,225 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// THIS CODE IS GENERATED BY GO GENERATE, IT'S TEMPLATE IS /templates/plugin_repository.go.tpl
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// PluginTagRepository is a TagRepository provided by a plugin.
+type PluginTagRepository struct {
+	client *RPCClient
+}
+
+type PluginTagRepositoryConstructorArgs struct {
+	Client *RPCClient
+}
+
+func (repo *PluginTagRepository) New(args any) (newRepo repository.TagRepository, err error) {
+	constructorArgs, ok := args.(PluginTagRepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", PluginTagRepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.client = constructorArgs.Client
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *PluginTagRepository) Add(ctx context.Context, domainModels []*domain.Tag) error {
+	return repo.client.Call(ctx, "Add", nil, domainModels)
+}
+
+func (repo *PluginTagRepository) Replace(ctx context.Context, domainModels []*domain.Tag) error {
+	return repo.client.Call(ctx, "Replace", nil, domainModels)
+}
+
+func (repo *PluginTagRepository) Upsert(ctx context.Context, domainModels []*domain.Tag) error {
+	return repo.client.Call(ctx, "Upsert", nil, domainModels)
+}
+
+func (repo *PluginTagRepository) Update(ctx context.Context, domainModels []*domain.Tag, domainUpdaters *domain.TagUpdater) error {
+	return repo.client.Call(ctx, "Update", nil, domainModels, domainUpdaters)
+}
+
+func (repo *PluginTagRepository) UpdateWhere(ctx context.Context, domainFilter *domain.TagFilter, domainUpdaters *domain.TagUpdater) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "UpdateWhere", []any{&numAffectedRecords}, domainFilter, domainUpdaters)
+
+	return
+}
+
+func (repo *PluginTagRepository) Delete(ctx context.Context, domainModels []*domain.Tag) error {
+	return repo.client.Call(ctx, "Delete", nil, domainModels)
+}
+
+func (repo *PluginTagRepository) DeleteWhere(ctx context.Context, domainFilter *domain.TagFilter) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "DeleteWhere", []any{&numAffectedRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) CountWhere(ctx context.Context, domainFilter *domain.TagFilter) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountWhere", []any{&numRecords}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountAll", []any{&numRecords})
+
+	return
+}
+
+func (repo *PluginTagRepository) CountGroupedWhere(ctx context.Context, domainFilter *domain.TagFilter, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedWhere", []any{&groups}, domainFilter, domainGrouper)
+
+	return
+}
+
+func (repo *PluginTagRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedAll", []any{&groups}, domainGrouper)
+
+	return
+}
+
+func (repo *PluginTagRepository) DoesExist(ctx context.Context, domainModel *domain.Tag) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExist", []any{&doesExist}, domainModel)
+
+	return
+}
+
+func (repo *PluginTagRepository) DoesExistWhere(ctx context.Context, domainFilter *domain.TagFilter) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExistWhere", []any{&doesExist}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetWhere(ctx context.Context, domainFilter *domain.TagFilter) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetWhere", []any{&records}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetFirstWhere(ctx context.Context, domainFilter *domain.TagFilter) (record *domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhere", []any{&record}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetAll(ctx context.Context) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetAll", []any{&records})
+
+	return
+}
+
+func (repo *PluginTagRepository) GetWhereSorted(ctx context.Context, domainFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetWhereSorted", []any{&records}, domainFilter, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetAllSorted(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetAllSorted", []any{&records}, domainSorter, limiter)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetWhereSelected(ctx context.Context, domainFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetWhereSelected", []any{&records}, domainFilter, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetAllSelected(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetAllSelected", []any{&records}, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetFirstWhereSelected(ctx context.Context, domainFilter *domain.TagFilter, domainSelector domain.TagMemberSelector) (record *domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhereSelected", []any{&record}, domainFilter, domainSelector)
+
+	return
+}
+
+func (repo *PluginTagRepository) GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Tag, err error) {
+	err = repo.client.Call(ctx, "GetFromIDs", []any{&records}, ids)
+
+	return
+}
+
+//******************************************************************//
+//                            Converters                            //
+//******************************************************************//
+// The repository specific values are received as decoded JSON.
+
+func (repo *PluginTagRepository) TagRepositoryToDomainModel(ctx context.Context, repositoryModel any) (domainModel *domain.Tag, err error) {
+	err = repo.client.Call(ctx, "TagRepositoryToDomainModel", []any{&domainModel}, repositoryModel)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositoryModel(ctx context.Context, domainModel *domain.Tag) (repositoryModel any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositoryModel", []any{&repositoryModel}, domainModel)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.TagFilter) (repositoryFilter any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositoryFilter", []any{&repositoryFilter}, domainFilter)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.TagUpdater) (repositoryUpdater any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositoryUpdater", []any{&repositoryUpdater}, domainUpdater)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositorySorter(ctx context.Context, domainSorter domain.TagSorter) (repositorySorter any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositorySorter", []any{&repositorySorter}, domainSorter)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.TagMemberSelector) (repositorySelector any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositoryMemberSelector", []any{&repositorySelector}, domainSelector)
+
+	return
+}
+
+func (repo *PluginTagRepository) TagDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.TagGrouper) (repositoryGrouper any, err error) {
+	err = repo.client.Call(ctx, "TagDomainToRepositoryGrouper", []any{&repositoryGrouper}, domainGrouper)
+
+	return
+}
@@ -0,0 +1,164 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//******************************************************************//
+//                       MalformedRequestError                      //
+//******************************************************************//
+
+type MalformedRequestError struct {
+	Inner error
+}
+
+func (err MalformedRequestError) Error() string {
+	return fmt.Sprintf("Malformed request: %v", err.Inner)
+}
+
+func (err MalformedRequestError) Unwrap() error {
+	return err.Inner
+}
+
+func (err MalformedRequestError) Is(other error) bool {
+	switch other.(type) {
+	case MalformedRequestError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err MalformedRequestError) As(target any) bool {
+	switch target.(type) {
+	case MalformedRequestError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                       MethodNotAllowedError                      //
+//******************************************************************//
+
+type MethodNotAllowedError struct {
+	Method string
+}
+
+func (err MethodNotAllowedError) Error() string {
+	return fmt.Sprintf("Method %v is not allowed for this resource", err.Method)
+}
+
+func (err MethodNotAllowedError) Is(other error) bool {
+	switch other.(type) {
+	case MethodNotAllowedError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err MethodNotAllowedError) As(target any) bool {
+	switch target.(type) {
+	case MethodNotAllowedError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+// GRPCCodeFromError maps an error returned by the backend to the gRPC status code describing it best.
+func GRPCCodeFromError(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, helper.NonExistentPrimaryDataError{}):
+		return codes.NotFound
+	case errors.Is(err, helper.DuplicateInsertionError{}):
+		return codes.AlreadyExists
+	case errors.Is(err, repository.ReferenceToNonExistentDependencyError{}),
+		errors.Is(err, helper.NonExistentDependencyError{}):
+		return codes.FailedPrecondition
+	case errors.Is(err, libdocuments.MissingSearchRepositoryError{}):
+		return codes.Unimplemented
+	case errors.Is(err, MethodNotAllowedError{}):
+		return codes.Unimplemented
+	case errors.Is(err, MalformedRequestError{}),
+		errors.Is(err, repository.UnsortableFieldError{}),
+		errors.Is(err, repository.UnselectableFieldError{}),
+		errors.Is(err, repository.UngroupableFieldError{}),
+		errors.Is(err, repository.InvalidLimiterError{}),
+		errors.Is(err, repository.UnsupportedFilterOperationError{}),
+		errors.Is(err, helper.EmptyInputError{}),
+		errors.Is(err, helper.NilInputError{}),
+		errors.Is(err, helper.NopUpdaterError{}),
+		errors.Is(err, helper.IneffectiveOperationError{}):
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
+
+// HTTPStatusFromError maps an error returned by the backend to the HTTP status code describing it best.
+func HTTPStatusFromError(err error) int {
+	if errors.Is(err, MethodNotAllowedError{}) {
+		return http.StatusMethodNotAllowed
+	}
+
+	switch GRPCCodeFromError(err) {
+	case codes.OK:
+		return http.StatusOK
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func grpcStatusFromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return status.Error(GRPCCodeFromError(err), err.Error())
+}
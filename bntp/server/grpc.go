@@ -0,0 +1,624 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/bntp/server/bntppb"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/barweiss/go-tuple"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewGRPCServer creates a gRPC server with the services of bntppb registered.
+// Panics in the handlers are recovered through RecoverUnaryInterceptor.
+func NewGRPCServer(bntpBackend *backend.Backend, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(RecoverUnaryInterceptor)}, opts...)
+	server := grpc.NewServer(opts...)
+
+	RegisterGRPCServices(server, bntpBackend)
+
+	return server
+}
+
+// RegisterGRPCServices registers the bookmark, tag, document and document content services of bntpBackend with registrar.
+//
+// The services are described by bntppb/bntp.proto, filters and updaters are passed as JSON
+// in the same format accepted by the CLI's --filter and --updater flags.
+// Errors are reported with a status code chosen by GRPCCodeFromError.
+func RegisterGRPCServices(registrar grpc.ServiceRegistrar, bntpBackend *backend.Backend) {
+	bntppb.RegisterBookmarkServiceServer(registrar, &bookmarkService{
+		entities: entityService[*domain.Bookmark, *domain.BookmarkFilter, *domain.BookmarkUpdater, *bntppb.Bookmark]{
+			manager:   &bntpBackend.BookmarkManager,
+			toProto:   bookmarkToProto,
+			fromProto: bookmarkFromProto,
+		},
+		types: typeService{manager: &bntpBackend.BookmarkManager},
+	})
+
+	bntppb.RegisterTagServiceServer(registrar, &tagService{
+		entities: entityService[*domain.Tag, *domain.TagFilter, *domain.TagUpdater, *bntppb.Tag]{
+			manager:   &bntpBackend.TagManager,
+			toProto:   tagToProto,
+			fromProto: tagFromProto,
+		},
+	})
+
+	bntppb.RegisterDocumentServiceServer(registrar, &documentService{
+		entities: entityService[*domain.Document, *domain.DocumentFilter, *domain.DocumentUpdater, *bntppb.Document]{
+			manager:   &bntpBackend.DocumentManager,
+			toProto:   documentToProto,
+			fromProto: documentFromProto,
+		},
+		types: typeService{manager: &bntpBackend.DocumentManager},
+	})
+
+	bntppb.RegisterDocumentContentServiceServer(registrar, &documentContentService{
+		manager:         &bntpBackend.DocumentContentManager,
+		documentManager: &bntpBackend.DocumentManager,
+	})
+}
+
+// RecoverUnaryInterceptor converts panics of handler into a codes.Internal status instead of crashing the server.
+// Malformed requests are reported through errors, a panic always hints at a bug.
+func RecoverUnaryInterceptor(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (response any, err error) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+
+		err = status.Error(codes.Internal, fmt.Sprintf("%v panicked: %v", info.FullMethod, recovered))
+		response = nil
+	}()
+
+	return handler(ctx, request)
+}
+
+// decodeJSONField unmarshals the JSON encoded field of a request, leaving the value at its zero value if the field is not set.
+func decodeJSONField[T any](raw string) (value T, err error) {
+	if raw == "" {
+		return
+	}
+
+	err = json.Unmarshal([]byte(raw), &value)
+	if err != nil {
+		err = MalformedRequestError{Inner: err}
+	}
+
+	return
+}
+
+//******************************************************************//
+//                           entityService                          //
+//******************************************************************//
+
+// entityService implements the methods shared by the bookmark, tag and document services,
+// TMessage is the protobuf message of TEntity.
+type entityService[TEntity any, TFilter any, TUpdater any, TMessage any] struct {
+	manager   EntityManager[TEntity, TFilter, TUpdater]
+	toProto   func(TEntity) TMessage
+	fromProto func(TMessage) TEntity
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) entitiesFromProto(messages []TMessage) []TEntity {
+	entities, _ := goaoi.TransformCopySliceUnsafe(messages, service.fromProto)
+
+	return entities
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) entitiesToProto(entities []TEntity) []TMessage {
+	messages, _ := goaoi.TransformCopySliceUnsafe(entities, service.toProto)
+
+	return messages
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) add(ctx context.Context, messages []TMessage) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Add(ctx, service.entitiesFromProto(messages)))
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) replace(ctx context.Context, messages []TMessage) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Replace(ctx, service.entitiesFromProto(messages)))
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) upsert(ctx context.Context, messages []TMessage) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Upsert(ctx, service.entitiesFromProto(messages)))
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) update(ctx context.Context, messages []TMessage, updaterRaw string) (*bntppb.EmptyResponse, error) {
+	updater, err := decodeJSONField[TUpdater](updaterRaw)
+	if err == nil {
+		err = service.manager.Update(ctx, service.entitiesFromProto(messages), updater)
+	}
+
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) updateWhere(ctx context.Context, request *bntppb.FilterUpdateRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	var numAffectedRecords int64
+
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err == nil {
+		var updater TUpdater
+
+		updater, err = decodeJSONField[TUpdater](request.Updater)
+		if err == nil {
+			numAffectedRecords, err = service.manager.UpdateWhere(ctx, filter, updater)
+		}
+	}
+
+	return &bntppb.NumAffectedRecordsResponse{NumAffectedRecords: numAffectedRecords}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) delete(ctx context.Context, messages []TMessage) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Delete(ctx, service.entitiesFromProto(messages)))
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) deleteWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	var numAffectedRecords int64
+
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err == nil {
+		numAffectedRecords, err = service.manager.DeleteWhere(ctx, filter)
+	}
+
+	return &bntppb.NumAffectedRecordsResponse{NumAffectedRecords: numAffectedRecords}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) countWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.CountResponse, error) {
+	var count int64
+
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err == nil {
+		count, err = service.manager.CountWhere(ctx, filter)
+	}
+
+	return &bntppb.CountResponse{Count: count}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) countAll(ctx context.Context) (*bntppb.CountResponse, error) {
+	count, err := service.manager.CountAll(ctx)
+
+	return &bntppb.CountResponse{Count: count}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) doesExist(ctx context.Context, message TMessage) (*bntppb.DoesExistResponse, error) {
+	doesExist, err := service.manager.DoesExist(ctx, service.fromProto(message))
+
+	return &bntppb.DoesExistResponse{DoesExist: doesExist}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) doesExistWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DoesExistResponse, error) {
+	var doesExist bool
+
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err == nil {
+		doesExist, err = service.manager.DoesExistWhere(ctx, filter)
+	}
+
+	return &bntppb.DoesExistResponse{DoesExist: doesExist}, grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) getWhere(ctx context.Context, request *bntppb.FilterRequest) ([]TMessage, error) {
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err != nil {
+		return nil, grpcStatusFromError(err)
+	}
+
+	entities, err := service.manager.GetWhere(ctx, filter)
+
+	return service.entitiesToProto(entities), grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) getFirstWhere(ctx context.Context, request *bntppb.FilterRequest) (message TMessage, err error) {
+	filter, err := decodeJSONField[TFilter](request.Filter)
+	if err != nil {
+		return message, grpcStatusFromError(err)
+	}
+
+	entity, err := service.manager.GetFirstWhere(ctx, filter)
+	if err != nil {
+		return message, grpcStatusFromError(err)
+	}
+
+	return service.toProto(entity), nil
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) getAll(ctx context.Context) ([]TMessage, error) {
+	entities, err := service.manager.GetAll(ctx)
+
+	return service.entitiesToProto(entities), grpcStatusFromError(err)
+}
+
+func (service entityService[TEntity, TFilter, TUpdater, TMessage]) getFromIDs(ctx context.Context, request *bntppb.IDsRequest) ([]TMessage, error) {
+	entities, err := service.manager.GetFromIDs(ctx, request.Ids)
+
+	return service.entitiesToProto(entities), grpcStatusFromError(err)
+}
+
+//******************************************************************//
+//                            typeService                           //
+//******************************************************************//
+
+// typeService implements the type methods shared by the bookmark and document services.
+type typeService struct {
+	manager TypeManager
+}
+
+func (service typeService) addType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.AddType(ctx, request.Types))
+}
+
+func (service typeService) deleteType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.DeleteType(ctx, request.Types))
+}
+
+func (service typeService) updateType(ctx context.Context, request *bntppb.UpdateTypeRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.UpdateType(ctx, request.OldType, request.NewType))
+}
+
+func (service typeService) getAllTypes(ctx context.Context) (*bntppb.TypesResponse, error) {
+	types, err := service.manager.GetAllTypes(ctx)
+
+	return &bntppb.TypesResponse{Types: types}, grpcStatusFromError(err)
+}
+
+//******************************************************************//
+//                          bookmarkService                         //
+//******************************************************************//
+
+type bookmarkService struct {
+	bntppb.UnimplementedBookmarkServiceServer
+
+	entities entityService[*domain.Bookmark, *domain.BookmarkFilter, *domain.BookmarkUpdater, *bntppb.Bookmark]
+	types    typeService
+}
+
+func (service *bookmarkService) Add(ctx context.Context, request *bntppb.BookmarksRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.add(ctx, request.Bookmarks)
+}
+
+func (service *bookmarkService) Replace(ctx context.Context, request *bntppb.BookmarksRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.replace(ctx, request.Bookmarks)
+}
+
+func (service *bookmarkService) Upsert(ctx context.Context, request *bntppb.BookmarksRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.upsert(ctx, request.Bookmarks)
+}
+
+func (service *bookmarkService) Update(ctx context.Context, request *bntppb.UpdateBookmarksRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.update(ctx, request.Bookmarks, request.Updater)
+}
+
+func (service *bookmarkService) UpdateWhere(ctx context.Context, request *bntppb.FilterUpdateRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.updateWhere(ctx, request)
+}
+
+func (service *bookmarkService) Delete(ctx context.Context, request *bntppb.BookmarksRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.delete(ctx, request.Bookmarks)
+}
+
+func (service *bookmarkService) DeleteWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.deleteWhere(ctx, request)
+}
+
+func (service *bookmarkService) CountWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countWhere(ctx, request)
+}
+
+func (service *bookmarkService) CountAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countAll(ctx)
+}
+
+func (service *bookmarkService) DoesExist(ctx context.Context, request *bntppb.BookmarkRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExist(ctx, request.Bookmark)
+}
+
+func (service *bookmarkService) DoesExistWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExistWhere(ctx, request)
+}
+
+func (service *bookmarkService) GetWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.BookmarksResponse, error) {
+	bookmarks, err := service.entities.getWhere(ctx, request)
+
+	return &bntppb.BookmarksResponse{Bookmarks: bookmarks}, err
+}
+
+func (service *bookmarkService) GetFirstWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.BookmarkResponse, error) {
+	bookmark, err := service.entities.getFirstWhere(ctx, request)
+
+	return &bntppb.BookmarkResponse{Bookmark: bookmark}, err
+}
+
+func (service *bookmarkService) GetAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.BookmarksResponse, error) {
+	bookmarks, err := service.entities.getAll(ctx)
+
+	return &bntppb.BookmarksResponse{Bookmarks: bookmarks}, err
+}
+
+func (service *bookmarkService) GetFromIDs(ctx context.Context, request *bntppb.IDsRequest) (*bntppb.BookmarksResponse, error) {
+	bookmarks, err := service.entities.getFromIDs(ctx, request)
+
+	return &bntppb.BookmarksResponse{Bookmarks: bookmarks}, err
+}
+
+func (service *bookmarkService) AddType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.addType(ctx, request)
+}
+
+func (service *bookmarkService) DeleteType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.deleteType(ctx, request)
+}
+
+func (service *bookmarkService) UpdateType(ctx context.Context, request *bntppb.UpdateTypeRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.updateType(ctx, request)
+}
+
+func (service *bookmarkService) GetAllTypes(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.TypesResponse, error) {
+	return service.types.getAllTypes(ctx)
+}
+
+//******************************************************************//
+//                            tagService                            //
+//******************************************************************//
+
+type tagService struct {
+	bntppb.UnimplementedTagServiceServer
+
+	entities entityService[*domain.Tag, *domain.TagFilter, *domain.TagUpdater, *bntppb.Tag]
+}
+
+func (service *tagService) Add(ctx context.Context, request *bntppb.TagsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.add(ctx, request.Tags)
+}
+
+func (service *tagService) Replace(ctx context.Context, request *bntppb.TagsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.replace(ctx, request.Tags)
+}
+
+func (service *tagService) Upsert(ctx context.Context, request *bntppb.TagsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.upsert(ctx, request.Tags)
+}
+
+func (service *tagService) Update(ctx context.Context, request *bntppb.UpdateTagsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.update(ctx, request.Tags, request.Updater)
+}
+
+func (service *tagService) UpdateWhere(ctx context.Context, request *bntppb.FilterUpdateRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.updateWhere(ctx, request)
+}
+
+func (service *tagService) Delete(ctx context.Context, request *bntppb.TagsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.delete(ctx, request.Tags)
+}
+
+func (service *tagService) DeleteWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.deleteWhere(ctx, request)
+}
+
+func (service *tagService) CountWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countWhere(ctx, request)
+}
+
+func (service *tagService) CountAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countAll(ctx)
+}
+
+func (service *tagService) DoesExist(ctx context.Context, request *bntppb.TagRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExist(ctx, request.Tag)
+}
+
+func (service *tagService) DoesExistWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExistWhere(ctx, request)
+}
+
+func (service *tagService) GetWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.TagsResponse, error) {
+	tags, err := service.entities.getWhere(ctx, request)
+
+	return &bntppb.TagsResponse{Tags: tags}, err
+}
+
+func (service *tagService) GetFirstWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.TagResponse, error) {
+	tag, err := service.entities.getFirstWhere(ctx, request)
+
+	return &bntppb.TagResponse{Tag: tag}, err
+}
+
+func (service *tagService) GetAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.TagsResponse, error) {
+	tags, err := service.entities.getAll(ctx)
+
+	return &bntppb.TagsResponse{Tags: tags}, err
+}
+
+func (service *tagService) GetFromIDs(ctx context.Context, request *bntppb.IDsRequest) (*bntppb.TagsResponse, error) {
+	tags, err := service.entities.getFromIDs(ctx, request)
+
+	return &bntppb.TagsResponse{Tags: tags}, err
+}
+
+//******************************************************************//
+//                          documentService                         //
+//******************************************************************//
+
+type documentService struct {
+	bntppb.UnimplementedDocumentServiceServer
+
+	entities entityService[*domain.Document, *domain.DocumentFilter, *domain.DocumentUpdater, *bntppb.Document]
+	types    typeService
+}
+
+func (service *documentService) Add(ctx context.Context, request *bntppb.DocumentsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.add(ctx, request.Documents)
+}
+
+func (service *documentService) Replace(ctx context.Context, request *bntppb.DocumentsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.replace(ctx, request.Documents)
+}
+
+func (service *documentService) Upsert(ctx context.Context, request *bntppb.DocumentsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.upsert(ctx, request.Documents)
+}
+
+func (service *documentService) Update(ctx context.Context, request *bntppb.UpdateDocumentsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.update(ctx, request.Documents, request.Updater)
+}
+
+func (service *documentService) UpdateWhere(ctx context.Context, request *bntppb.FilterUpdateRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.updateWhere(ctx, request)
+}
+
+func (service *documentService) Delete(ctx context.Context, request *bntppb.DocumentsRequest) (*bntppb.EmptyResponse, error) {
+	return service.entities.delete(ctx, request.Documents)
+}
+
+func (service *documentService) DeleteWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.NumAffectedRecordsResponse, error) {
+	return service.entities.deleteWhere(ctx, request)
+}
+
+func (service *documentService) CountWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countWhere(ctx, request)
+}
+
+func (service *documentService) CountAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.CountResponse, error) {
+	return service.entities.countAll(ctx)
+}
+
+func (service *documentService) DoesExist(ctx context.Context, request *bntppb.DocumentRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExist(ctx, request.Document)
+}
+
+func (service *documentService) DoesExistWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DoesExistResponse, error) {
+	return service.entities.doesExistWhere(ctx, request)
+}
+
+func (service *documentService) GetWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DocumentsResponse, error) {
+	documents, err := service.entities.getWhere(ctx, request)
+
+	return &bntppb.DocumentsResponse{Documents: documents}, err
+}
+
+func (service *documentService) GetFirstWhere(ctx context.Context, request *bntppb.FilterRequest) (*bntppb.DocumentResponse, error) {
+	document, err := service.entities.getFirstWhere(ctx, request)
+
+	return &bntppb.DocumentResponse{Document: document}, err
+}
+
+func (service *documentService) GetAll(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.DocumentsResponse, error) {
+	documents, err := service.entities.getAll(ctx)
+
+	return &bntppb.DocumentsResponse{Documents: documents}, err
+}
+
+func (service *documentService) GetFromIDs(ctx context.Context, request *bntppb.IDsRequest) (*bntppb.DocumentsResponse, error) {
+	documents, err := service.entities.getFromIDs(ctx, request)
+
+	return &bntppb.DocumentsResponse{Documents: documents}, err
+}
+
+func (service *documentService) AddType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.addType(ctx, request)
+}
+
+func (service *documentService) DeleteType(ctx context.Context, request *bntppb.TypesRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.deleteType(ctx, request)
+}
+
+func (service *documentService) UpdateType(ctx context.Context, request *bntppb.UpdateTypeRequest) (*bntppb.EmptyResponse, error) {
+	return service.types.updateType(ctx, request)
+}
+
+func (service *documentService) GetAllTypes(ctx context.Context, _ *bntppb.EmptyRequest) (*bntppb.TypesResponse, error) {
+	return service.types.getAllTypes(ctx)
+}
+
+//******************************************************************//
+//                      documentContentService                      //
+//******************************************************************//
+
+type documentContentService struct {
+	bntppb.UnimplementedDocumentContentServiceServer
+
+	manager         *libdocuments.DocumentContentManager
+	documentManager *libdocuments.DocumentManager
+}
+
+func (service *documentContentService) Add(ctx context.Context, request *bntppb.PathContentsRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Add(ctx, pathContentsToTuples(request.PathContents)))
+}
+
+func (service *documentContentService) Update(ctx context.Context, request *bntppb.PathContentsRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Update(ctx, pathContentsToTuples(request.PathContents)))
+}
+
+func (service *documentContentService) Move(ctx context.Context, request *bntppb.PathChangesRequest) (*bntppb.EmptyResponse, error) {
+	pathChanges := make([]tuple.T2[string, string], 0, len(request.PathChanges))
+	for _, pathChange := range request.PathChanges {
+		pathChanges = append(pathChanges, tuple.New2(pathChange.OldPath, pathChange.NewPath))
+	}
+
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Move(ctx, pathChanges))
+}
+
+func (service *documentContentService) Delete(ctx context.Context, request *bntppb.PathsRequest) (*bntppb.EmptyResponse, error) {
+	return &bntppb.EmptyResponse{}, grpcStatusFromError(service.manager.Delete(ctx, request.Paths))
+}
+
+func (service *documentContentService) Get(ctx context.Context, request *bntppb.PathsRequest) (*bntppb.ContentsResponse, error) {
+	contents, err := service.manager.Get(ctx, request.Paths)
+
+	return &bntppb.ContentsResponse{Contents: contents}, grpcStatusFromError(err)
+}
+
+func (service *documentContentService) Search(ctx context.Context, request *bntppb.SearchRequest) (*bntppb.SearchResponse, error) {
+	filter, err := decodeJSONField[*domain.DocumentFilter](request.Filter)
+	if err != nil {
+		return nil, grpcStatusFromError(err)
+	}
+
+	results, err := service.manager.Search(ctx, request.Query, filter, service.documentManager)
+	if err != nil {
+		return nil, grpcStatusFromError(err)
+	}
+
+	response := &bntppb.SearchResponse{Results: make([]*bntppb.SearchResult, 0, len(results))}
+	for _, result := range results {
+		response.Results = append(response.Results, &bntppb.SearchResult{Path: result.Path, Snippet: result.Snippet, Rank: result.Rank})
+	}
+
+	return response, nil
+}
+
+func pathContentsToTuples(pathContents []*bntppb.PathContent) []tuple.T2[string, string] {
+	tuples := make([]tuple.T2[string, string], 0, len(pathContents))
+	for _, pathContent := range pathContents {
+		tuples = append(tuples, tuple.New2(pathContent.Path, pathContent.Content))
+	}
+
+	return tuples
+}
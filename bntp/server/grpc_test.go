@@ -0,0 +1,185 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/server"
+	"github.com/JonasMuehlmann/bntp.go/bntp/server/bntppb"
+	"github.com/JonasMuehlmann/bntp.go/internal/config"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGRPCServer(t *testing.T) {
+	tagFilter := string(drop.From2To1(json.Marshal(domain.TagFilter{Tag: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: "foo"}})})))
+
+	tests := []struct {
+		err      codes.Code
+		name     string
+		call     func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error)
+		expected proto.Message
+		tags     []*domain.Tag
+	}{
+		{
+			name: "count all empty",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).CountAll(ctx, &bntppb.EmptyRequest{})
+			},
+			expected: &bntppb.CountResponse{Count: 0},
+		},
+		{
+			name: "count all",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).CountAll(ctx, &bntppb.EmptyRequest{})
+			},
+			expected: &bntppb.CountResponse{Count: 2},
+			tags:     []*domain.Tag{{ID: 1, Tag: "foo"}, {ID: 2, Tag: "bar"}},
+		},
+		{
+			name: "get from ids",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).GetFromIDs(ctx, &bntppb.IDsRequest{Ids: []int64{2}})
+			},
+			expected: &bntppb.TagsResponse{Tags: []*bntppb.Tag{{Id: 2, Tag: "bar"}}},
+			tags:     []*domain.Tag{{ID: 1, Tag: "foo"}, {ID: 2, Tag: "bar"}},
+		},
+		{
+			name: "get where",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).GetWhere(ctx, &bntppb.FilterRequest{Filter: tagFilter})
+			},
+			expected: &bntppb.TagsResponse{Tags: []*bntppb.Tag{{Id: 1, Tag: "foo"}}},
+			tags:     []*domain.Tag{{ID: 1, Tag: "foo"}, {ID: 2, Tag: "bar"}},
+		},
+		{
+			name: "add and get bookmark",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				client := bntppb.NewBookmarkServiceClient(conn)
+
+				_, err := client.Add(ctx, &bntppb.BookmarksRequest{Bookmarks: []*bntppb.Bookmark{{Id: 1, Url: "https://example.com", Title: proto.String("foo")}}})
+				if err != nil {
+					return nil, err
+				}
+
+				response, err := client.GetFromIDs(ctx, &bntppb.IDsRequest{Ids: []int64{1}})
+				if err != nil {
+					return nil, err
+				}
+
+				for _, bookmark := range response.Bookmarks {
+					bookmark.CreatedAt = nil
+					bookmark.UpdatedAt = nil
+				}
+
+				return response, nil
+			},
+			expected: &bntppb.BookmarksResponse{Bookmarks: []*bntppb.Bookmark{{Id: 1, Url: "https://example.com", Title: proto.String("foo")}}},
+		},
+		{
+			name: "get all empty",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewBookmarkServiceClient(conn).GetAll(ctx, &bntppb.EmptyRequest{})
+			},
+			err: codes.NotFound,
+		},
+		{
+			name: "add no entities",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewDocumentServiceClient(conn).Add(ctx, &bntppb.DocumentsRequest{})
+			},
+			err: codes.InvalidArgument,
+		},
+		{
+			name: "duplicate tag",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).Add(ctx, &bntppb.TagsRequest{Tags: []*bntppb.Tag{{Id: 1, Tag: "foo"}}})
+			},
+			err:  codes.AlreadyExists,
+			tags: []*domain.Tag{{ID: 1, Tag: "foo"}},
+		},
+		{
+			name: "set operator on scalar field",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).GetWhere(ctx, &bntppb.FilterRequest{Filter: `{"Tag":{"operator":"FilterContains","operand":{"operand":"foo"}}}`})
+			},
+			err:  codes.InvalidArgument,
+			tags: []*domain.Tag{{ID: 1, Tag: "foo"}},
+		},
+		{
+			name: "malformed filter",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				return bntppb.NewTagServiceClient(conn).GetWhere(ctx, &bntppb.FilterRequest{Filter: "{"})
+			},
+			err: codes.InvalidArgument,
+		},
+		{
+			name: "unknown method",
+			call: func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+				response := &bntppb.EmptyResponse{}
+
+				return response, conn.Invoke(ctx, "/bntp.TagService/Foo", &bntppb.EmptyRequest{}, response)
+			},
+			err: codes.Unimplemented,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			configManager, err := config.NewConfigManager(&testCommon.Buffer{}, db, afero.NewMemMapFs())
+			assert.NoError(t, err, test.name+", assert config manager creation")
+
+			bntpBackend, err := configManager.NewBackendFromConfig()
+			assert.NoError(t, err, test.name+", assert backend creation")
+
+			if test.tags != nil {
+				err = bntpBackend.TagManager.Add(context.Background(), test.tags)
+				assert.NoError(t, err, test.name+", assert tag creation")
+			}
+
+			listener := bufconn.Listen(1024 * 1024)
+			grpcServer := server.NewGRPCServer(bntpBackend)
+			go grpcServer.Serve(listener)
+			defer grpcServer.Stop()
+
+			conn, err := grpc.DialContext(
+				context.Background(),
+				"bufnet",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			assert.NoError(t, err, test.name+", assert client creation")
+			defer conn.Close()
+
+			response, err := test.call(context.Background(), conn)
+
+			if test.err != codes.OK {
+				assert.Equal(t, test.err, status.Code(err), test.name+", assert status code matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+				assert.True(t, proto.Equal(test.expected, response), test.name+", assert response matches expected, got "+fmt.Sprint(response))
+			}
+		})
+	}
+}
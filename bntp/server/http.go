@@ -0,0 +1,306 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+)
+
+// NewHTTPHandler creates a handler serving a REST API over the bookmark, tag and document managers.
+//
+// The entity resources /bookmarks, /tags and /documents provide the following endpoints:
+//
+//	GET    /{resource}              Get all entities
+//	POST   /{resource}              Add the entities of an EntitiesRequest
+//	PUT    /{resource}              Replace the entities of an EntitiesRequest
+//	PATCH  /{resource}              Update the entities of an UpdateRequest
+//	DELETE /{resource}              Delete the entities of an EntitiesRequest
+//	GET    /{resource}/{id}         Get the entity with the given id
+//	POST   /{resource}/upsert       Upsert the entities of an EntitiesRequest
+//	GET    /{resource}/where        Get the entities matching the filter query parameter
+//	PATCH  /{resource}/where        Update the entities matching the filter of a FilterUpdateRequest
+//	DELETE /{resource}/where        Delete the entities matching the filter query parameter
+//	GET    /{resource}/first        Get the first entity matching the filter query parameter
+//	GET    /{resource}/count        Count the entities matching the optional filter query parameter
+//	GET    /{resource}/exists       Check if an entity matching the filter query parameter exists
+//	POST   /{resource}/exists       Check if the entity of an EntityRequest exists
+//
+// The type resources /bookmark-types and /document-types provide the following endpoints:
+//
+//	GET    /{resource}              Get all types
+//	POST   /{resource}              Add the types of a TypesRequest
+//	PATCH  /{resource}              Rename a type through an UpdateTypeRequest
+//	DELETE /{resource}              Delete the types of a TypesRequest
+//
+// Filters are passed as JSON in the same format accepted by the CLI's --filter flag,
+// operators a field does not support, e.g. set operators on scalar fields, are rejected before querying.
+// Errors are reported as an ErrorResponse with a status code chosen by HTTPStatusFromError,
+// panics are recovered through RecoverHTTPHandler.
+func NewHTTPHandler(bntpBackend *backend.Backend) http.Handler {
+	mux := http.NewServeMux()
+
+	mount(mux, "/bookmarks", newEntityHTTPHandler[*domain.Bookmark, *domain.BookmarkFilter, *domain.BookmarkUpdater](&bntpBackend.BookmarkManager))
+	mount(mux, "/tags", newEntityHTTPHandler[*domain.Tag, *domain.TagFilter, *domain.TagUpdater](&bntpBackend.TagManager))
+	mount(mux, "/documents", newEntityHTTPHandler[*domain.Document, *domain.DocumentFilter, *domain.DocumentUpdater](&bntpBackend.DocumentManager))
+	mount(mux, "/bookmark-types", newTypeHTTPHandler(&bntpBackend.BookmarkManager))
+	mount(mux, "/document-types", newTypeHTTPHandler(&bntpBackend.DocumentManager))
+
+	return RecoverHTTPHandler(mux)
+}
+
+// RecoverHTTPHandler converts panics of handler into an ErrorResponse with http.StatusInternalServerError instead of dropping the connection.
+// Malformed requests are reported through errors, a panic always hints at a bug.
+func RecoverHTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			// Deliberate aborts are handled by the http.Server
+			if recovered == http.ErrAbortHandler {
+				panic(recovered)
+			}
+
+			writeError(w, fmt.Errorf("%v %v panicked: %v", r.Method, r.URL.Path, recovered))
+		}()
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func mount(mux *http.ServeMux, prefix string, handler http.Handler) {
+	mux.Handle(prefix, http.StripPrefix(prefix, handler))
+	mux.Handle(prefix+"/", http.StripPrefix(prefix, handler))
+}
+
+func newEntityHTTPHandler[TEntity any, TFilter any, TUpdater any](manager EntityManager[TEntity, TFilter, TUpdater]) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		switch route := strings.Trim(r.URL.Path, "/"); {
+		case route == "" && r.Method == http.MethodGet:
+			entities, err := manager.GetAll(ctx)
+			respond(w, http.StatusOK, &EntitiesResponse[TEntity]{Entities: entities}, err)
+		case route == "" && r.Method == http.MethodPost:
+			request, err := decodeBody[EntitiesRequest[TEntity]](r)
+			if err == nil {
+				err = manager.Add(ctx, request.Entities)
+			}
+			respond(w, http.StatusCreated, &EmptyResponse{}, err)
+		case route == "" && r.Method == http.MethodPut:
+			request, err := decodeBody[EntitiesRequest[TEntity]](r)
+			if err == nil {
+				err = manager.Replace(ctx, request.Entities)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		case route == "" && r.Method == http.MethodPatch:
+			request, err := decodeBody[UpdateRequest[TEntity, TUpdater]](r)
+			if err == nil {
+				err = manager.Update(ctx, request.Entities, request.Updater)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		case route == "" && r.Method == http.MethodDelete:
+			request, err := decodeBody[EntitiesRequest[TEntity]](r)
+			if err == nil {
+				err = manager.Delete(ctx, request.Entities)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		case route == "upsert" && r.Method == http.MethodPost:
+			request, err := decodeBody[EntitiesRequest[TEntity]](r)
+			if err == nil {
+				err = manager.Upsert(ctx, request.Entities)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		case route == "where" && r.Method == http.MethodGet:
+			var entities []TEntity
+			filter, err := decodeFilterQuery[TFilter](r)
+			if err == nil {
+				entities, err = manager.GetWhere(ctx, filter)
+			}
+			respond(w, http.StatusOK, &EntitiesResponse[TEntity]{Entities: entities}, err)
+		case route == "where" && r.Method == http.MethodPatch:
+			var numAffectedRecords int64
+			request, err := decodeBody[FilterUpdateRequest[TFilter, TUpdater]](r)
+			if err == nil {
+				numAffectedRecords, err = manager.UpdateWhere(ctx, request.Filter, request.Updater)
+			}
+			respond(w, http.StatusOK, &NumAffectedRecordsResponse{NumAffectedRecords: numAffectedRecords}, err)
+		case route == "where" && r.Method == http.MethodDelete:
+			var numAffectedRecords int64
+			filter, err := decodeFilterQuery[TFilter](r)
+			if err == nil {
+				numAffectedRecords, err = manager.DeleteWhere(ctx, filter)
+			}
+			respond(w, http.StatusOK, &NumAffectedRecordsResponse{NumAffectedRecords: numAffectedRecords}, err)
+		case route == "first" && r.Method == http.MethodGet:
+			var entity TEntity
+			filter, err := decodeFilterQuery[TFilter](r)
+			if err == nil {
+				entity, err = manager.GetFirstWhere(ctx, filter)
+			}
+			respond(w, http.StatusOK, &EntityResponse[TEntity]{Entity: entity}, err)
+		case route == "count" && r.Method == http.MethodGet:
+			var count int64
+			filter, err := decodeFilterQuery[TFilter](r)
+			if err == nil && r.URL.Query().Has("filter") {
+				count, err = manager.CountWhere(ctx, filter)
+			} else if err == nil {
+				count, err = manager.CountAll(ctx)
+			}
+			respond(w, http.StatusOK, &CountResponse{Count: count}, err)
+		case route == "exists" && r.Method == http.MethodGet:
+			var doesExist bool
+			filter, err := decodeFilterQuery[TFilter](r)
+			if err == nil {
+				doesExist, err = manager.DoesExistWhere(ctx, filter)
+			}
+			respond(w, http.StatusOK, &DoesExistResponse{DoesExist: doesExist}, err)
+		case route == "exists" && r.Method == http.MethodPost:
+			var doesExist bool
+			request, err := decodeBody[EntityRequest[TEntity]](r)
+			if err == nil {
+				doesExist, err = manager.DoesExist(ctx, request.Entity)
+			}
+			respond(w, http.StatusOK, &DoesExistResponse{DoesExist: doesExist}, err)
+		case isID(route) && r.Method == http.MethodGet:
+			var entities []TEntity
+			id, err := strconv.ParseInt(route, 10, 64)
+			if err == nil {
+				entities, err = manager.GetFromIDs(ctx, []int64{id})
+			}
+			if err == nil && len(entities) == 0 {
+				err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+			}
+			if err != nil {
+				writeError(w, err)
+
+				return
+			}
+			respond(w, http.StatusOK, &EntityResponse[TEntity]{Entity: entities[0]}, nil)
+		case route == "" || route == "upsert" || route == "where" || route == "first" || route == "count" || route == "exists" || isID(route):
+			writeError(w, MethodNotAllowedError{Method: r.Method})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+func newTypeHTTPHandler(manager TypeManager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if route := strings.Trim(r.URL.Path, "/"); route != "" {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			types, err := manager.GetAllTypes(ctx)
+			respond(w, http.StatusOK, &TypesResponse{Types: types}, err)
+		case http.MethodPost:
+			request, err := decodeBody[TypesRequest](r)
+			if err == nil {
+				err = manager.AddType(ctx, request.Types)
+			}
+			respond(w, http.StatusCreated, &EmptyResponse{}, err)
+		case http.MethodPatch:
+			request, err := decodeBody[UpdateTypeRequest](r)
+			if err == nil {
+				err = manager.UpdateType(ctx, request.OldType, request.NewType)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		case http.MethodDelete:
+			request, err := decodeBody[TypesRequest](r)
+			if err == nil {
+				err = manager.DeleteType(ctx, request.Types)
+			}
+			respond(w, http.StatusOK, &EmptyResponse{}, err)
+		default:
+			writeError(w, MethodNotAllowedError{Method: r.Method})
+		}
+	})
+}
+
+func isID(route string) bool {
+	_, err := strconv.ParseInt(route, 10, 64)
+
+	return err == nil
+}
+
+func decodeBody[T any](r *http.Request) (*T, error) {
+	request := new(T)
+
+	err := json.NewDecoder(r.Body).Decode(request)
+	if err != nil {
+		return nil, MalformedRequestError{Inner: err}
+	}
+
+	return request, nil
+}
+
+// decodeFilterQuery unmarshals the filter query parameter of r, leaving the filter at its zero value if it is not set.
+func decodeFilterQuery[TFilter any](r *http.Request) (filter TFilter, err error) {
+	filterRaw := r.URL.Query().Get("filter")
+	if filterRaw == "" {
+		return
+	}
+
+	err = json.Unmarshal([]byte(filterRaw), &filter)
+	if err != nil {
+		err = MalformedRequestError{Inner: err}
+	}
+
+	return
+}
+
+func respond(w http.ResponseWriter, statusCode int, response any, err error) {
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	writeJSON(w, statusCode, response)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, HTTPStatusFromError(err), &ErrorResponse{Error: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	// The status code has already been sent, there is no way left to report a failure to the client.
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,191 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/server"
+	"github.com/JonasMuehlmann/bntp.go/internal/config"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPHandler(t *testing.T) {
+	tagFilter := string(drop.From2To1(json.Marshal(domain.TagFilter{Tag: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: "foo"}})})))
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expected       string
+		expectedStatus int
+		tags           []*domain.Tag
+	}{
+		{
+			name:           "count all empty",
+			method:         http.MethodGet,
+			target:         "/tags/count",
+			expected:       `{"count":0}`,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "count where",
+			method:         http.MethodGet,
+			target:         "/tags/count?filter=" + url.QueryEscape(tagFilter),
+			expected:       `{"count":1}`,
+			expectedStatus: http.StatusOK,
+			tags:           []*domain.Tag{{ID: 1, Tag: "foo"}, {ID: 2, Tag: "bar"}},
+		},
+		{
+			name:           "exists where",
+			method:         http.MethodGet,
+			target:         "/tags/exists?filter=" + url.QueryEscape(tagFilter),
+			expected:       `{"doesExist":false}`,
+			expectedStatus: http.StatusOK,
+			tags:           []*domain.Tag{{ID: 2, Tag: "bar"}},
+		},
+		{
+			name:           "add",
+			method:         http.MethodPost,
+			target:         "/tags",
+			body:           `{"entities":[{"id":1,"tag":"foo"}]}`,
+			expected:       `{}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "add duplicate",
+			method:         http.MethodPost,
+			target:         "/tags",
+			body:           `{"entities":[{"id":1,"tag":"foo"}]}`,
+			expectedStatus: http.StatusConflict,
+			tags:           []*domain.Tag{{ID: 1, Tag: "foo"}},
+		},
+		{
+			name:           "add malformed",
+			method:         http.MethodPost,
+			target:         "/tags",
+			body:           `{"entities":`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "add no entities",
+			method:         http.MethodPost,
+			target:         "/bookmarks",
+			body:           `{"entities":[]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "get by id",
+			method:         http.MethodGet,
+			target:         "/tags/2",
+			expected:       `{"entity":{"tag":"bar","id":2,"parentPathIDs":null,"subtagsIDs":null}}`,
+			expectedStatus: http.StatusOK,
+			tags:           []*domain.Tag{{ID: 1, Tag: "foo"}, {ID: 2, Tag: "bar"}},
+		},
+		{
+			name:           "get by id not found",
+			method:         http.MethodGet,
+			target:         "/tags/3",
+			expectedStatus: http.StatusNotFound,
+			tags:           []*domain.Tag{{ID: 1, Tag: "foo"}},
+		},
+		{
+			name:           "get all empty",
+			method:         http.MethodGet,
+			target:         "/documents",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "get where without filter",
+			method:         http.MethodGet,
+			target:         "/tags/where",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "set operator on scalar field",
+			method:         http.MethodGet,
+			target:         "/tags/where?filter=" + url.QueryEscape(`{"Tag":{"operator":"FilterContains","operand":{"operand":"foo"}}}`),
+			expectedStatus: http.StatusBadRequest,
+			tags:           []*domain.Tag{{ID: 1, Tag: "foo"}},
+		},
+		{
+			name:           "unknown operator",
+			method:         http.MethodGet,
+			target:         "/tags/count?filter=" + url.QueryEscape(`{"Tag":{"operator":"FilterFoo","operand":{"operand":"foo"}}}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "add type",
+			method:         http.MethodPost,
+			target:         "/bookmark-types",
+			body:           `{"types":["foo"]}`,
+			expected:       `{}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "method not allowed",
+			method:         http.MethodPut,
+			target:         "/tags/count",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "unknown route",
+			method:         http.MethodGet,
+			target:         "/tags/foo",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			configManager, err := config.NewConfigManager(&testCommon.Buffer{}, db, afero.NewMemMapFs())
+			assert.NoError(t, err, test.name+", assert config manager creation")
+
+			bntpBackend, err := configManager.NewBackendFromConfig()
+			assert.NoError(t, err, test.name+", assert backend creation")
+
+			if test.tags != nil {
+				err = bntpBackend.TagManager.Add(context.Background(), test.tags)
+				assert.NoError(t, err, test.name+", assert tag creation")
+			}
+
+			recorder := httptest.NewRecorder()
+			server.NewHTTPHandler(bntpBackend).ServeHTTP(recorder, httptest.NewRequest(test.method, test.target, strings.NewReader(test.body)))
+
+			assert.Equal(t, test.expectedStatus, recorder.Code, test.name+", assert status code matches expected")
+			if test.expected != "" {
+				assert.JSONEq(t, test.expected, recorder.Body.String(), test.name+", assert response matches expected")
+			}
+		})
+	}
+}
+
+func TestRecoverHTTPHandler(t *testing.T) {
+	handler := server.RecoverHTTPHandler(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("foo")
+	}))
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/tags", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code, "assert status code matches expected")
+	assert.JSONEq(t, `{"error":"GET /tags panicked: foo"}`, recorder.Body.String(), "assert response matches expected")
+}
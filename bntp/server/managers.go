@@ -0,0 +1,53 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// Package server exposes the bntp backend to other processes.
+package server
+
+import (
+	"context"
+)
+
+// EntityManager is the set of operations shared by the bookmark, tag and document managers.
+type EntityManager[TEntity any, TFilter any, TUpdater any] interface {
+	Add(ctx context.Context, entities []TEntity) error
+	Replace(ctx context.Context, entities []TEntity) error
+	Upsert(ctx context.Context, entities []TEntity) error
+	Update(ctx context.Context, entities []TEntity, updater TUpdater) error
+	UpdateWhere(ctx context.Context, filter TFilter, updater TUpdater) (numAffectedRecords int64, err error)
+	Delete(ctx context.Context, entities []TEntity) error
+	DeleteWhere(ctx context.Context, filter TFilter) (numAffectedRecords int64, err error)
+	CountWhere(ctx context.Context, filter TFilter) (numRecords int64, err error)
+	CountAll(ctx context.Context) (numRecords int64, err error)
+	DoesExist(ctx context.Context, entity TEntity) (doesExist bool, err error)
+	DoesExistWhere(ctx context.Context, filter TFilter) (doesExist bool, err error)
+	GetWhere(ctx context.Context, filter TFilter) (records []TEntity, err error)
+	GetFirstWhere(ctx context.Context, filter TFilter) (record TEntity, err error)
+	GetAll(ctx context.Context) (records []TEntity, err error)
+	GetFromIDs(ctx context.Context, ids []int64) (records []TEntity, err error)
+}
+
+// TypeManager is the set of operations of managers whose entities have a type.
+type TypeManager interface {
+	AddType(ctx context.Context, types []string) error
+	DeleteType(ctx context.Context, types []string) error
+	UpdateType(ctx context.Context, oldType string, newType string) error
+	GetAllTypes(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package server
+
+// The messages of the HTTP API, the messages of the gRPC API are generated into bntppb.
+
+//******************************************************************//
+//                             Requests                             //
+//******************************************************************//
+
+type EntitiesRequest[TEntity any] struct {
+	Entities []TEntity `json:"entities"`
+}
+
+type EntityRequest[TEntity any] struct {
+	Entity TEntity `json:"entity"`
+}
+
+type UpdateRequest[TEntity any, TUpdater any] struct {
+	Entities []TEntity `json:"entities"`
+	Updater  TUpdater  `json:"updater"`
+}
+
+type FilterUpdateRequest[TFilter any, TUpdater any] struct {
+	Filter  TFilter  `json:"filter"`
+	Updater TUpdater `json:"updater"`
+}
+
+type TypesRequest struct {
+	Types []string `json:"types"`
+}
+
+type UpdateTypeRequest struct {
+	OldType string `json:"oldType"`
+	NewType string `json:"newType"`
+}
+
+//******************************************************************//
+//                             Responses                            //
+//******************************************************************//
+
+type EmptyResponse struct{}
+
+type EntitiesResponse[TEntity any] struct {
+	Entities []TEntity `json:"entities"`
+}
+
+type EntityResponse[TEntity any] struct {
+	Entity TEntity `json:"entity"`
+}
+
+type CountResponse struct {
+	Count int64 `json:"count"`
+}
+
+type NumAffectedRecordsResponse struct {
+	NumAffectedRecords int64 `json:"numAffectedRecords"`
+}
+
+type DoesExistResponse struct {
+	DoesExist bool `json:"doesExist"`
+}
+
+type TypesResponse struct {
+	Types []string `json:"types"`
+}
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,180 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package server
+
+import (
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/server/bntppb"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func bookmarkToProto(bookmark *domain.Bookmark) *bntppb.Bookmark {
+	if bookmark == nil {
+		return nil
+	}
+
+	return &bntppb.Bookmark{
+		Id:           bookmark.ID,
+		Url:          bookmark.URL,
+		Title:        optionalToProto(bookmark.Title),
+		BookmarkType: optionalToProto(bookmark.BookmarkType),
+		IsCollection: bookmark.IsCollection,
+		IsRead:       bookmark.IsRead,
+		TagIds:       bookmark.TagIDs,
+		CreatedAt:    timeToProto(bookmark.CreatedAt),
+		UpdatedAt:    timeToProto(bookmark.UpdatedAt),
+		DeletedAt:    optionalTimeToProto(bookmark.DeletedAt),
+	}
+}
+
+func bookmarkFromProto(bookmark *bntppb.Bookmark) *domain.Bookmark {
+	if bookmark == nil {
+		return nil
+	}
+
+	return &domain.Bookmark{
+		ID:           bookmark.Id,
+		URL:          bookmark.Url,
+		Title:        optionalFromProto(bookmark.Title),
+		BookmarkType: optionalFromProto(bookmark.BookmarkType),
+		IsCollection: bookmark.IsCollection,
+		IsRead:       bookmark.IsRead,
+		TagIDs:       bookmark.TagIds,
+		CreatedAt:    timeFromProto(bookmark.CreatedAt),
+		UpdatedAt:    timeFromProto(bookmark.UpdatedAt),
+		DeletedAt:    optionalTimeFromProto(bookmark.DeletedAt),
+	}
+}
+
+func tagToProto(tag *domain.Tag) *bntppb.Tag {
+	if tag == nil {
+		return nil
+	}
+
+	return &bntppb.Tag{
+		Id:            tag.ID,
+		Tag:           tag.Tag,
+		ParentPathIds: tag.ParentPathIDs,
+		SubtagIds:     tag.SubtagIDs,
+	}
+}
+
+func tagFromProto(tag *bntppb.Tag) *domain.Tag {
+	if tag == nil {
+		return nil
+	}
+
+	return &domain.Tag{
+		ID:            tag.Id,
+		Tag:           tag.Tag,
+		ParentPathIDs: tag.ParentPathIds,
+		SubtagIDs:     tag.SubtagIds,
+	}
+}
+
+func documentToProto(document *domain.Document) *bntppb.Document {
+	if document == nil {
+		return nil
+	}
+
+	return &bntppb.Document{
+		Id:                    document.ID,
+		Path:                  document.Path,
+		DocumentType:          optionalToProto(document.DocumentType),
+		TagIds:                document.TagIDs,
+		LinkedDocumentIds:     document.LinkedDocumentIDs,
+		BacklinkedDocumentIds: document.BacklinkedDocumentsIDs,
+		CreatedAt:             timeToProto(document.CreatedAt),
+		UpdatedAt:             timeToProto(document.UpdatedAt),
+		DeletedAt:             optionalTimeToProto(document.DeletedAt),
+	}
+}
+
+func documentFromProto(document *bntppb.Document) *domain.Document {
+	if document == nil {
+		return nil
+	}
+
+	return &domain.Document{
+		ID:                     document.Id,
+		Path:                   document.Path,
+		DocumentType:           optionalFromProto(document.DocumentType),
+		TagIDs:                 document.TagIds,
+		LinkedDocumentIDs:      document.LinkedDocumentIds,
+		BacklinkedDocumentsIDs: document.BacklinkedDocumentIds,
+		CreatedAt:              timeFromProto(document.CreatedAt),
+		UpdatedAt:              timeFromProto(document.UpdatedAt),
+		DeletedAt:              optionalTimeFromProto(document.DeletedAt),
+	}
+}
+
+// optionalToProto converts value to the pointer protobuf uses for optional fields.
+func optionalToProto[T any](value optional.Optional[T]) *T {
+	if !value.HasValue {
+		return nil
+	}
+
+	return &value.Wrappee
+}
+
+func optionalFromProto[T any](value *T) optional.Optional[T] {
+	if value == nil {
+		return optional.Optional[T]{}
+	}
+
+	return optional.Make(*value)
+}
+
+// timeToProto converts t to a timestamp, leaving the zero time unset.
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func timeFromProto(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Time{}
+	}
+
+	return timestamp.AsTime()
+}
+
+func optionalTimeToProto(t optional.Optional[time.Time]) *timestamppb.Timestamp {
+	if !t.HasValue {
+		return nil
+	}
+
+	return timestamppb.New(t.Wrappee)
+}
+
+func optionalTimeFromProto(timestamp *timestamppb.Timestamp) optional.Optional[time.Time] {
+	if timestamp == nil {
+		return optional.Optional[time.Time]{}
+	}
+
+	return optional.Make(timestamp.AsTime())
+}
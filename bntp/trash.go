@@ -0,0 +1,300 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package bntp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	log "github.com/sirupsen/logrus"
+)
+
+// TrashRepository is the part of a repository needed to move entities to and out of the trash and to purge them.
+type TrashRepository[TEntity any, TFilter any, TUpdater any] interface {
+	GetWhere(ctx context.Context, domainFilter *TFilter) (records []*TEntity, err error)
+	UpdateWhere(ctx context.Context, domainFilter *TFilter, domainUpdaters *TUpdater) (numAffectedRecords int64, err error)
+	Delete(ctx context.Context, domainModels []*TEntity) error
+	DeleteWhere(ctx context.Context, domainFilter *TFilter) (numAffectedRecords int64, err error)
+}
+
+// TrashFilters creates the entity specific filters and updaters the trash is built on.
+type TrashFilters[TEntity any, TFilter any, TUpdater any] struct {
+	// Trashed and NotTrashed return new filters matching trashed entities and the ones, which are not trashed.
+	Trashed    func() *TFilter
+	NotTrashed func() *TFilter
+	// And and Or combine filters by AND and OR.
+	And func(filters ...*TFilter) *TFilter
+	Or  func(filters ...*TFilter) *TFilter
+	// FiltersByDeletedAt reports whether filter sets DeletedAt, ignoring its nested filters.
+	FiltersByDeletedAt func(filter *TFilter) bool
+	// Nested returns the And, Or and Not filters of filter.
+	Nested func(filter *TFilter) []*TFilter
+	// ID returns the ID of entity.
+	ID func(entity *TEntity) int64
+	// IDIn returns a filter matching entities with any of ids.
+	IDIn func(ids []int64) *TFilter
+	// SetDeletedAt returns an updater setting DeletedAt to deletedAt.
+	SetDeletedAt func(deletedAt optional.Optional[time.Time]) *TUpdater
+}
+
+// ExcludeTrashed restricts filter to entities, which are not trashed, unless it filters by DeletedAt itself.
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) ExcludeTrashed(filter *TFilter) *TFilter {
+	if filter == nil || filters.filtersByDeletedAt(filter) {
+		return filter
+	}
+
+	return filters.And(filter, filters.NotTrashed())
+}
+
+// IncludeTrashed makes filter match entities whether they are trashed or not, ExcludeTrashed leaves the result unchanged.
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) IncludeTrashed(filter *TFilter) *TFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return filters.And(filter, filters.Or(filters.Trashed(), filters.NotTrashed()))
+}
+
+// TrashedFilter restricts filter to trashed entities.
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) TrashedFilter(filter *TFilter) *TFilter {
+	if filter == nil {
+		return nil
+	}
+
+	return filters.And(filter, filters.Trashed())
+}
+
+// TrashUpdater returns an updater moving entities to the trash now.
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) TrashUpdater() *TUpdater {
+	return filters.SetDeletedAt(optional.Make(time.Now().UTC()))
+}
+
+// RestoreUpdater returns an updater moving entities out of the trash.
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) RestoreUpdater() *TUpdater {
+	return filters.SetDeletedAt(optional.Optional[time.Time]{})
+}
+
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) filtersByDeletedAt(filter *TFilter) bool {
+	if filter == nil {
+		return false
+	}
+
+	if filters.FiltersByDeletedAt(filter) {
+		return true
+	}
+
+	for _, nestedFilter := range filters.Nested(filter) {
+		if filters.filtersByDeletedAt(nestedFilter) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (filters TrashFilters[TEntity, TFilter, TUpdater]) idFilter(entities []*TEntity) (*TFilter, error) {
+	if len(entities) == 0 {
+		return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	ids, err := goaoi.TransformCopySlice(entities, func(entity *TEntity) (int64, error) {
+		if entity == nil {
+			return 0, helper.NilInputError{}
+		}
+
+		return filters.ID(entity), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return filters.IDIn(ids), nil
+}
+
+// Trash implements moving entities to and out of the trash and purging them for the manager of an entity.
+// The manager's hooks are executed and its cache is invalidated like for the manager's own operations.
+type Trash[TEntity any, TFilter any, TUpdater any] struct {
+	Filters    TrashFilters[TEntity, TFilter, TUpdater]
+	Hooks      *Hooks[TEntity]
+	Repository TrashRepository[TEntity, TFilter, TUpdater]
+	Logger     *log.Logger
+	Cache      *ManagerCache[TEntity]
+}
+
+// Trash moves entities to the trash without executing hooks, it fails if not all of them are stored and not trashed.
+func (trash Trash[TEntity, TFilter, TUpdater]) Trash(ctx context.Context, entities []*TEntity) error {
+	filter, err := trash.Filters.idFilter(entities)
+	if err != nil {
+		return err
+	}
+
+	return trash.updateAll(ctx, trash.Filters.ExcludeTrashed(filter), len(entities), trash.Filters.TrashUpdater())
+}
+
+// Restore moves trashed entities out of the trash.
+func (trash Trash[TEntity, TFilter, TUpdater]) Restore(ctx context.Context, entities []*TEntity) error {
+	hookErr := goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, BeforeAnyHook|BeforeUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+
+		return hookErr
+	}
+
+	filter, err := trash.Filters.idFilter(entities)
+	if err == nil {
+		err = trash.updateAll(ctx, trash.Filters.TrashedFilter(filter), len(entities), trash.Filters.RestoreUpdater())
+	}
+
+	trash.Cache.InvalidateAfterWrite(ctx)
+
+	if err != nil {
+		trash.Logger.Error(err)
+	}
+
+	hookErr = goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, AfterAnyHook|AfterUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+	}
+
+	return err
+}
+
+// RestoreWhere moves the trashed entities matching filter out of the trash.
+// The hooks are executed for the entities matching filter before they are restored.
+func (trash Trash[TEntity, TFilter, TUpdater]) RestoreWhere(ctx context.Context, filter *TFilter) (numAffectedRecords int64, err error) {
+	entities, err := trash.Repository.GetWhere(ctx, trash.Filters.TrashedFilter(filter))
+	// No matching entities is not an error for bulk operations
+	if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+		return 0, nil
+	}
+	if err != nil {
+		trash.Logger.Error(err)
+
+		return
+	}
+
+	hookErr := goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, BeforeAnyHook|BeforeUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	numAffectedRecords, err = trash.Repository.UpdateWhere(ctx, trash.Filters.TrashedFilter(filter), trash.Filters.RestoreUpdater())
+	trash.Cache.InvalidateAfterWrite(ctx)
+
+	if err != nil {
+		trash.Logger.Error(err)
+	}
+
+	hookErr = goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, AfterAnyHook|AfterUpdateHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+// Purge deletes entities permanently, whether they are trashed or not.
+func (trash Trash[TEntity, TFilter, TUpdater]) Purge(ctx context.Context, entities []*TEntity) error {
+	hookErr := goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, BeforeAnyHook|BeforeDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+
+		return hookErr
+	}
+
+	err := trash.Repository.Delete(ctx, entities)
+	trash.Cache.InvalidateAfterWrite(ctx)
+
+	if err != nil {
+		trash.Logger.Error(err)
+	}
+
+	hookErr = goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, AfterAnyHook|AfterDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+	}
+
+	return err
+}
+
+// PurgeWhere permanently deletes the trashed entities matching filter.
+// The hooks are executed for the entities matching filter before they are deleted.
+func (trash Trash[TEntity, TFilter, TUpdater]) PurgeWhere(ctx context.Context, filter *TFilter) (numAffectedRecords int64, err error) {
+	entities, err := trash.Repository.GetWhere(ctx, trash.Filters.TrashedFilter(filter))
+	// No matching entities is not an error for bulk operations
+	if errors.Is(err, helper.NonExistentPrimaryDataError{}) {
+		return 0, nil
+	}
+	if err != nil {
+		trash.Logger.Error(err)
+
+		return
+	}
+
+	hookErr := goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, BeforeAnyHook|BeforeDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+
+		err = hookErr
+
+		return
+	}
+
+	numAffectedRecords, err = trash.Repository.DeleteWhere(ctx, trash.Filters.TrashedFilter(filter))
+	trash.Cache.InvalidateAfterWrite(ctx)
+
+	if err != nil {
+		trash.Logger.Error(err)
+	}
+
+	hookErr = goaoi.ForeachSlice(entities, trash.Hooks.PartiallySpecializeExecuteHooks(ctx, AfterAnyHook|AfterDeleteHook))
+	if hookErr != nil && !errors.Is(hookErr, goaoi.EmptyIterableError{}) {
+		hookErr = HookExecutionError{Inner: hookErr}
+		trash.Logger.Error(hookErr)
+	}
+
+	return
+}
+
+// updateAll applies updater to all entities matching filter and fails if not all numEntities of them match.
+func (trash Trash[TEntity, TFilter, TUpdater]) updateAll(ctx context.Context, filter *TFilter, numEntities int, updater *TUpdater) error {
+	numAffectedRecords, err := trash.Repository.UpdateWhere(ctx, filter, updater)
+	if err == nil && numAffectedRecords < int64(numEntities) {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+	}
+
+	return err
+}
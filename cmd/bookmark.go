@@ -23,12 +23,15 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 	"github.com/JonasMuehlmann/datastructures.go/maps/hashmap"
 	"github.com/JonasMuehlmann/goaoi"
 	"github.com/JonasMuehlmann/goaoi/functional"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
 )
@@ -188,35 +191,60 @@ func WithBookmarkCommand() CliOption {
 			Long:  `A longer description`,
 			Args:  cobra.NoArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
+				sorter, err := ParseSorter(cli.SortRaw, domain.BookmarkFieldsList)
+				if err != nil {
+					return err
+				}
+
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.BookmarkFieldsList)
+				if err != nil {
+					return err
+				}
+
+				limiter := NewLimiterFromFlags(cli)
+
 				var bookmarks []*domain.Bookmark
 				filter := &domain.BookmarkFilter{}
 				var output string
-				var err error
 
-				if cli.FilterRaw == "" {
-					bookmarks, err = cli.BNTPBackend.BookmarkManager.GetAll(context.Background())
+				if cli.FilterRaw == "" && len(cli.TagsRaw) == 0 {
+					bookmarks, err = cli.BNTPBackend.BookmarkManager.GetAllSelected(context.Background(), sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
-
-					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
-						filter = domain.PredefinedBookmarkFilters[cli.FilterRaw]
-					} else {
-						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
-						if err != nil {
-							return EntityMarshallingError{Inner: err}
+					if cli.FilterRaw != "" {
+						tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+
+						if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
+							filter = domain.PredefinedBookmarkFilters[cli.FilterRaw]
+						} else {
+							err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+							if err != nil {
+								return EntityMarshallingError{Inner: err}
+							}
 						}
 					}
 
-					bookmarks, err = cli.BNTPBackend.BookmarkManager.GetWhere(context.Background(), filter)
+					tagFilter, err := NewTagFilterFromFlags(cli)
+					if err != nil {
+						return err
+					}
+
+					if tagFilter.HasValue {
+						// Copy to leave predefined filters untouched
+						filterCopy := *filter
+						filterCopy.TagIDs = tagFilter
+						filter = &filterCopy
+					}
+
+					bookmarks, err = cli.BNTPBackend.BookmarkManager.GetWhereSelected(context.Background(), filter, sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
 				}
 
-				output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(bookmarks)
+				output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(bookmarks, selector))
 				if err != nil {
 					return EntityMarshallingError{Inner: err}
 				}
@@ -229,7 +257,7 @@ func WithBookmarkCommand() CliOption {
 
 		cli.BookmarkRemoveCmd = &cobra.Command{
 			Use:   "remove [MODEL...]",
-			Short: "Remove a bntp bookmark",
+			Short: "Move bntp bookmarks to the trash",
 			Long:  `A longer description`,
 			Args:  cobra.ArbitraryArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -296,6 +324,11 @@ func WithBookmarkCommand() CliOption {
 				var result *domain.Bookmark
 				var output string
 
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.BookmarkFieldsList)
+				if err != nil {
+					return err
+				}
+
 				tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
 
 				if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
@@ -307,12 +340,12 @@ func WithBookmarkCommand() CliOption {
 					}
 				}
 
-				result, err = cli.BNTPBackend.BookmarkManager.GetFirstWhere(context.Background(), filter)
+				result, err = cli.BNTPBackend.BookmarkManager.GetFirstWhereSelected(context.Background(), filter, selector)
 				if err != nil {
 					return err
 				}
 
-				output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(result)
+				output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(result, selector))
 				if err != nil {
 					return EntityMarshallingError{Inner: err}
 				}
@@ -332,22 +365,35 @@ func WithBookmarkCommand() CliOption {
 				var countRaw int64
 				var err error
 
-				if cli.FilterRaw == "" {
+				if cli.FilterRaw == "" && len(cli.TagsRaw) == 0 {
 					countRaw, err = cli.BNTPBackend.BookmarkManager.CountAll(context.Background())
 					if err != nil {
 						return err
 					}
 				} else {
+					if cli.FilterRaw != "" {
+						tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+
+						if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
+							filter = domain.PredefinedBookmarkFilters[cli.FilterRaw]
+						} else {
+							err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+							if err != nil {
+								return EntityMarshallingError{Inner: err}
+							}
+						}
+					}
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tagFilter, err := NewTagFilterFromFlags(cli)
+					if err != nil {
+						return err
+					}
 
-					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
-						filter = domain.PredefinedBookmarkFilters[cli.FilterRaw]
-					} else {
-						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
-						if err != nil {
-							return EntityMarshallingError{Inner: err}
-						}
+					if tagFilter.HasValue {
+						// Copy to leave predefined filters untouched
+						filterCopy := *filter
+						filterCopy.TagIDs = tagFilter
+						filter = &filterCopy
 					}
 
 					countRaw, err = cli.BNTPBackend.BookmarkManager.CountWhere(context.Background(), filter)
@@ -424,6 +470,80 @@ func WithBookmarkCommand() CliOption {
 			},
 		}
 
+		cli.BookmarkImportCmd = &cobra.Command{
+			Use:   "import FILE",
+			Short: "Import bntp bookmarks from a browser's HTML bookmark file",
+			Long: `Import bookmarks from a file in the Netscape bookmark format used by browsers.
+Folders are mapped to hierarchical tags, which are created if they do not exist yet.
+Bookmarks with an already known URL are kept and only receive the tag of their folder.`,
+			Args: cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				file, err := cli.Fs.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				netscapeBookmarks, err := libbookmarks.ParseNetscapeBookmarks(file)
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				var numAffectedRecordsRaw int64
+
+				err = cli.BNTPBackend.InUnitOfWork(context.Background(), func(ctx context.Context) error {
+					var err error
+
+					numAffectedRecordsRaw, err = cli.BNTPBackend.BookmarkManager.ImportNetscapeBookmarks(ctx, &cli.BNTPBackend.TagManager, netscapeBookmarks)
+
+					return err
+				})
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+				return nil
+			},
+		}
+
+		cli.BookmarkExportCmd = &cobra.Command{
+			Use:   "export FILE",
+			Short: "Export bntp bookmarks to a browser's HTML bookmark file",
+			Long: `Export bookmarks to a file in the Netscape bookmark format used by browsers.
+Tags are mapped to folders, a bookmark with multiple tags is listed in the folder of every tag.`,
+			Args: cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				netscapeBookmarks, err := cli.BNTPBackend.BookmarkManager.ExportNetscapeBookmarks(context.Background(), &cli.BNTPBackend.TagManager)
+				if err != nil {
+					return err
+				}
+
+				var serializedBookmarks strings.Builder
+
+				err = libbookmarks.WriteNetscapeBookmarks(&serializedBookmarks, netscapeBookmarks)
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				return afero.WriteFile(cli.Fs, args[0], []byte(serializedBookmarks.String()), 0o644)
+			},
+		}
+
 		cli.RootCmd.AddCommand(cli.BookmarkCmd)
 
 		cli.BookmarkCmd.AddCommand(cli.BookmarkListCmd)
@@ -435,9 +555,11 @@ func WithBookmarkCommand() CliOption {
 		cli.BookmarkCmd.AddCommand(cli.BookmarkDoesExistCmd)
 		cli.BookmarkCmd.AddCommand(cli.BookmarkFindCmd)
 		cli.BookmarkCmd.AddCommand(cli.BookmarkUpsertCmd)
+		cli.BookmarkCmd.AddCommand(cli.BookmarkImportCmd)
+		cli.BookmarkCmd.AddCommand(cli.BookmarkExportCmd)
 
 		for _, subcommand := range cli.BookmarkCmd.Commands() {
-			if slices.Contains([]*cobra.Command{cli.BookmarkAddCmd, cli.BookmarkListCmd, cli.BookmarkRemoveCmd, cli.BookmarkFindCmd, cli.BookmarkDoesExistCmd}, subcommand) {
+			if slices.Contains([]*cobra.Command{cli.BookmarkAddCmd, cli.BookmarkListCmd, cli.BookmarkRemoveCmd, cli.BookmarkFindCmd, cli.BookmarkDoesExistCmd, cli.BookmarkImportCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.InFormat, "out-format", "json", "The serialization format to use for reading input")
 				subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
 			}
@@ -449,6 +571,23 @@ func WithBookmarkCommand() CliOption {
 			}
 		}
 
+		cli.BookmarkListCmd.PersistentFlags().StringVar(&cli.SortRaw, "sort", "", "The comma separated fields to sort by, each optionally suffixed with :asc or :desc")
+		cli.BookmarkListCmd.PersistentFlags().Int64Var(&cli.Limit, "limit", 0, "The maximum number of entities to list, 0 lists all")
+		cli.BookmarkListCmd.PersistentFlags().Int64Var(&cli.Cursor, "cursor", 0, "The number of entities to skip, the cursor of the next page is the current cursor plus the limit")
+
+		for _, subcommand := range cli.BookmarkCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.BookmarkListCmd, cli.BookmarkCountCmd}, subcommand) {
+				subcommand.PersistentFlags().StringArrayVar(&cli.TagsRaw, "tag", nil, "The path of a tag the bookmarks must have, e.g. foo::bar, can be repeated")
+				subcommand.PersistentFlags().BoolVar(&cli.Recursive, "recursive", false, "Also match descendants of the tags given by --tag")
+			}
+		}
+
+		for _, subcommand := range cli.BookmarkCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.BookmarkListCmd, cli.BookmarkFindCmd}, subcommand) {
+				subcommand.PersistentFlags().StringVar(&cli.FieldsRaw, "fields", "", "The comma separated fields to load and output, all fields are used if empty")
+			}
+		}
+
 		for _, subcommand := range cli.BookmarkCmd.Commands() {
 			if slices.Contains([]*cobra.Command{cli.BookmarkEditCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.UpdaterRaw, "updater", "", "The updater to use for processing entities")
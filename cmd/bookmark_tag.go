@@ -0,0 +1,128 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/spf13/cobra"
+)
+
+func WithBookmarkTagCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.BookmarkTagCmd = &cobra.Command{
+			Use:   "tag",
+			Short: "Manage tags of bntp bookmarks",
+			Long:  `A longer description`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				return nil
+			},
+		}
+
+		cli.BookmarkTagAddCmd = &cobra.Command{
+			Use:   "add TAG...",
+			Short: "Add tags to a bntp bookmark",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.AddBookmarkTags(context.Background(), cli.BookmarkURL, args)
+
+				return err
+			},
+		}
+
+		cli.BookmarkTagEditCmd = &cobra.Command{
+			Use:   "edit OLD_TAG NEW_TAG",
+			Short: "Change a tag of a bntp bookmark",
+			Long:  `A longer description`,
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				err := cli.BNTPBackend.EditBookmarkTag(context.Background(), cli.BookmarkURL, args[0], args[1])
+
+				return err
+			},
+		}
+
+		cli.BookmarkTagRemoveCmd = &cobra.Command{
+			Use:   "remove TAG...",
+			Short: "Remove tags from a bntp bookmark",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.RemoveBookmarkTags(context.Background(), cli.BookmarkURL, args)
+
+				return err
+			},
+		}
+
+		cli.BookmarkTagListCmd = &cobra.Command{
+			Use:   "list",
+			Short: "List the tags of a bntp bookmark",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				tags, err := cli.BNTPBackend.GetBookmarkTags(context.Background(), cli.BookmarkURL)
+				if err != nil {
+					return err
+				}
+
+				tagPaths, err := goaoi.TransformCopySlice(tags, func(tag *domain.Tag) (string, error) {
+					return cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+				})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), strings.Join(tagPaths, "\n"))
+
+				return nil
+			},
+		}
+
+		cli.BookmarkCmd.AddCommand(cli.BookmarkTagCmd)
+		cli.BookmarkTagCmd.AddCommand(cli.BookmarkTagAddCmd)
+		cli.BookmarkTagCmd.AddCommand(cli.BookmarkTagEditCmd)
+		cli.BookmarkTagCmd.AddCommand(cli.BookmarkTagRemoveCmd)
+		cli.BookmarkTagCmd.AddCommand(cli.BookmarkTagListCmd)
+
+		cli.BookmarkTagCmd.PersistentFlags().StringVarP(&cli.BookmarkURL, "bookmark", "b", "", "The URL of the bookmark to work with")
+		cli.BookmarkTagCmd.MarkPersistentFlagRequired("bookmark")
+
+		return
+	}
+}
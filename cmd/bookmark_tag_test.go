@@ -0,0 +1,203 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdBookmarkTag(t *testing.T) {
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		oldBookmarks    []*domain.Bookmark
+		args            []string
+		expectedTagIDs  []int64
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name: "No bookmark",
+			args: []string{
+				"bookmark",
+				"tag",
+				"add",
+				"foo",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("required flag"),
+			errorMatcher:    testCommon.ValidatorContains("required flag"),
+		},
+		{
+			name:         "Add tags",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com"}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"add",
+				"-b",
+				"example.com",
+				"foo",
+				"foo::bar",
+			},
+			expectedTagIDs:  []int64{1, 2},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Add unknown tag",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com"}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"add",
+				"-b",
+				"example.com",
+				"baz",
+			},
+			err:             libtags.UnknownTagPathError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("No tag with path"),
+		},
+		{
+			name:         "Add duplicate tag",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{1}}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"add",
+				"-b",
+				"example.com",
+				"foo",
+			},
+			err:             helper.DuplicateInsertionError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("duplicate"),
+		},
+		{
+			name:         "Remove tag",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{1, 2}}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"remove",
+				"-b",
+				"example.com",
+				"foo",
+			},
+			expectedTagIDs:  []int64{2},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Remove missing tag",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{2}}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"remove",
+				"-b",
+				"example.com",
+				"foo",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name:         "Edit tag",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{1}}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"edit",
+				"-b",
+				"example.com",
+				"foo",
+				"foo::bar",
+			},
+			expectedTagIDs:  []int64{2},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "List tags",
+			oldBookmarks: []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{1, 2}}},
+			args: []string{
+				"bookmark",
+				"tag",
+				"list",
+				"-b",
+				"example.com",
+			},
+			outputValidator: testCommon.ValidatorEqual("foo\nfoo::bar\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.oldBookmarks != nil {
+				for _, subcommand := range cli.BookmarkTagCmd.Commands() {
+					subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+						err = cli.BNTPBackend.TagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+						assert.NoError(t, err, test.name+", assert adding tags")
+
+						err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.oldBookmarks)
+						assert.NoError(t, err, test.name+", assert adding old bookmarks")
+					}
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.expectedTagIDs != nil {
+				bookmarks, err := cli.BNTPBackend.BookmarkManager.GetFromIDs(context.Background(), []int64{1})
+				assert.NoError(t, err, test.name+", assert getting bookmark")
+				assert.ElementsMatch(t, test.expectedTagIDs, bookmarks[0].TagIDs, test.name+", assert bookmark tags match")
+			}
+		})
+	}
+}
@@ -3,8 +3,12 @@ package cmd_test
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
 	"github.com/JonasMuehlmann/bntp.go/cmd"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/bntp.go/model"
@@ -551,6 +555,7 @@ func TestCmdBookmarkList(t *testing.T) {
 		name            string
 		args            []string
 		tags            []*domain.Bookmark
+		tagEntities     []*domain.Tag
 		outputValidator testCommon.OutputValidator
 		errorValidator  testCommon.OutputValidator
 	}{
@@ -613,6 +618,150 @@ func TestCmdBookmarkList(t *testing.T) {
 			outputValidator: testCommon.ValidatorContains("foo"),
 			errorValidator:  testCommon.ValidatorEmpty,
 		},
+		{
+			name: "Some tags, using composed filter",
+			args: []string{
+				"bookmark",
+				"list",
+				"--filter",
+				`{"or": [{"uRL": {"operator": "FilterEqual", "operand": {"operand": "foo"}}}, {"not": {"tagIDs": {"operator": "FilterEmpty"}}}]}`,
+				"--fields",
+				"url",
+			},
+			tagEntities:     []*domain.Tag{{ID: 1, Tag: "foo"}},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar", TagIDs: []int64{1}}, {ID: 3, URL: "baz"}},
+			outputValidator: testCommon.ValidatorContains(`[{"url":"foo"},{"url":"bar"}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some tags, sorted and limited",
+			args: []string{
+				"bookmark",
+				"list",
+				"--sort",
+				"url",
+				"--limit",
+				"1",
+			},
+			tags: []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			outputValidator: func(t *testing.T, actual string, message string) bool {
+				return assert.Contains(t, actual, "bar", message) && assert.NotContains(t, actual, "foo", message)
+			},
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some tags, sorted and limited, using cursor",
+			args: []string{
+				"bookmark",
+				"list",
+				"--sort",
+				"url:asc",
+				"--limit",
+				"1",
+				"--cursor",
+				"1",
+			},
+			tags: []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			outputValidator: func(t *testing.T, actual string, message string) bool {
+				return assert.Contains(t, actual, "foo", message) && assert.NotContains(t, actual, "bar", message)
+			},
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Bad sort key",
+			args: []string{
+				"bookmark",
+				"list",
+				"--sort",
+				"url:up",
+			},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			err:             cmd.InvalidSortKeyError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("Invalid sort key"),
+		},
+		{
+			name: "Bad field",
+			args: []string{
+				"bookmark",
+				"list",
+				"--fields",
+				"url,is_read",
+			},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			err:             cmd.InvalidFieldError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("Invalid field"),
+		},
+		{
+			name: "Some tags, selected fields",
+			args: []string{
+				"bookmark",
+				"list",
+				"--fields",
+				"url,isRead",
+			},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			outputValidator: testCommon.ValidatorContains(`[{"url":"foo"},{"url":"bar"}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some tags, repeated selected fields",
+			args: []string{
+				"bookmark",
+				"list",
+				"--fields",
+				"url,URL,id,id",
+			},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}},
+			outputValidator: testCommon.ValidatorContains(`[{"url":"foo","id":1}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Tag path",
+			args: []string{
+				"bookmark",
+				"list",
+				"--tag",
+				"foo::bar",
+				"--fields",
+				"url",
+			},
+			tagEntities:     []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo", TagIDs: []int64{2}}, {ID: 2, URL: "bar", TagIDs: []int64{1}}, {ID: 3, URL: "baz"}},
+			outputValidator: testCommon.ValidatorContains(`[{"url":"foo"}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Tag path, recursive",
+			args: []string{
+				"bookmark",
+				"list",
+				"--tag",
+				"foo",
+				"--recursive",
+				"--fields",
+				"url",
+			},
+			tagEntities:     []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo", TagIDs: []int64{2}}, {ID: 2, URL: "bar", TagIDs: []int64{1}}, {ID: 3, URL: "baz"}},
+			outputValidator: testCommon.ValidatorContains(`[{"url":"foo"},{"url":"bar"}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Unknown tag path",
+			args: []string{
+				"bookmark",
+				"list",
+				"--tag",
+				"bar::foo",
+			},
+			tagEntities:     []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo", TagIDs: []int64{2}}},
+			err:             libtags.UnknownTagPathError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("No tag with path"),
+		},
 	}
 
 	for _, test := range tests {
@@ -634,6 +783,11 @@ func TestCmdBookmarkList(t *testing.T) {
 
 			if test.tags != nil {
 				cli.BookmarkListCmd.PreRun = func(_ *cobra.Command, _ []string) {
+					if test.tagEntities != nil {
+						err = cli.BNTPBackend.TagManager.Add(context.Background(), test.tagEntities)
+						assert.NoError(t, err, test.name+", assert adding tags")
+					}
+
 					err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.tags)
 					assert.NoError(t, err, test.name+", assert adding old tags")
 				}
@@ -857,6 +1011,20 @@ func TestCmdBookmarkFind(t *testing.T) {
 			outputValidator: testCommon.ValidatorContains("foo"),
 			errorValidator:  testCommon.ValidatorEmpty,
 		},
+		{
+			name: "Some tags, selected fields",
+			args: []string{
+				"bookmark",
+				"find-first",
+				"--filter",
+				string(drop.From2To1(json.Marshal(domain.BookmarkFilter{URL: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: "foo"}})}))),
+				"--fields",
+				"id,url",
+			},
+			tags:            []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar"}},
+			outputValidator: testCommon.ValidatorContains(`{"id":1,"url":"foo"}`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
 	}
 
 	for _, test := range tests {
@@ -1163,3 +1331,417 @@ func TestCmdBookmarkDoesExist(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdBookmarkImport(t *testing.T) {
+	tests := []struct {
+		err              error
+		errorMatcher     testCommon.OutputValidator
+		name             string
+		fileContent      string
+		args             []string
+		bookmarks        []*domain.Bookmark
+		trashedBookmarks []*domain.Bookmark
+		importTwice      bool
+		storedBookmarks  []importedBookmark
+		storedTagPaths   []string
+		outputValidator  testCommon.OutputValidator
+		errorValidator   testCommon.OutputValidator
+	}{
+		{
+			name: "No args",
+			args: []string{
+				"bookmark",
+				"import",
+			},
+			errorMatcher:    testCommon.ValidatorContains("arg", "received"),
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("accepts", "received"),
+		},
+		{
+			name: "File does not exist",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			errorMatcher:    testCommon.ValidatorContains("does not exist"),
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("does not exist"),
+		},
+		{
+			name: "No bookmarks",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			fileContent:     "<!DOCTYPE NETSCAPE-Bookmark-file-1>\n<DL><p>\n</DL><p>\n",
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name: "Some bookmarks",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			fileContent: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+    <DT><H3>foo</H3>
+    <DL><p>
+        <DT><H3>bar</H3>
+        <DL><p>
+            <DT><A HREF="https://example.com/bar" ADD_DATE="1654500000">Bar</A>
+        </DL><p>
+        <DT><A HREF="https://example.com/foo" ADD_DATE="1654500000">Foo</A>
+    </DL><p>
+    <DT><A HREF="https://example.com" ADD_DATE="1654500000">Example</A>
+</DL><p>
+`,
+			storedBookmarks: []importedBookmark{
+				{URL: "https://example.com/bar", Title: "Bar", CreatedAt: 1654500000, TagPaths: []string{"foo::bar"}},
+				{URL: "https://example.com/foo", Title: "Foo", CreatedAt: 1654500000, TagPaths: []string{"foo"}},
+				{URL: "https://example.com", Title: "Example", CreatedAt: 1654500000, TagPaths: []string{}},
+			},
+			storedTagPaths:  []string{"foo", "foo::bar"},
+			outputValidator: testCommon.ValidatorContains("3"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Same bookmarks twice",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			fileContent: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+    <DT><H3>foo</H3>
+    <DL><p>
+        <DT><H3>bar</H3>
+        <DL><p>
+            <DT><A HREF="https://example.com/bar" ADD_DATE="1654500000">Bar</A>
+        </DL><p>
+        <DT><A HREF="https://example.com/foo" ADD_DATE="1654600000">Foo</A>
+    </DL><p>
+</DL><p>
+`,
+			importTwice: true,
+			storedBookmarks: []importedBookmark{
+				{URL: "https://example.com/bar", Title: "Bar", CreatedAt: 1654500000, TagPaths: []string{"foo::bar"}},
+				{URL: "https://example.com/foo", Title: "Foo", CreatedAt: 1654600000, TagPaths: []string{"foo"}},
+			},
+			storedTagPaths:  []string{"foo", "foo::bar"},
+			outputValidator: testCommon.ValidatorContains("0"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some bookmarks, some already existing",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			fileContent: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+    <DT><A HREF="https://example.com/foo" ADD_DATE="1654500000">Foo</A>
+    <DT><A HREF="https://example.com/bar" ADD_DATE="1654500000">Bar</A>
+</DL><p>
+`,
+			bookmarks: []*domain.Bookmark{{ID: 1, URL: "https://example.com/foo", Title: optional.Make("Old foo"), CreatedAt: time.Unix(1600000000, 0)}},
+			storedBookmarks: []importedBookmark{
+				{URL: "https://example.com/foo", Title: "Old foo", CreatedAt: 1600000000, TagPaths: []string{}},
+				{URL: "https://example.com/bar", Title: "Bar", CreatedAt: 1654500000, TagPaths: []string{}},
+			},
+			outputValidator: testCommon.ValidatorContains("1"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some bookmarks, some trashed",
+			args: []string{
+				"bookmark",
+				"import",
+				"in",
+			},
+			fileContent: `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<DL><p>
+    <DT><A HREF="https://example.com/bar" ADD_DATE="1654500000">Bar</A>
+    <DT><A HREF="https://example.com/baz" ADD_DATE="1654500000">Baz</A>
+</DL><p>
+`,
+			bookmarks:        []*domain.Bookmark{{URL: "https://example.com/foo", CreatedAt: time.Unix(1600000000, 0)}},
+			trashedBookmarks: []*domain.Bookmark{{URL: "https://example.com/bar", CreatedAt: time.Unix(1600000000, 0)}},
+			storedBookmarks: []importedBookmark{
+				{URL: "https://example.com/foo", CreatedAt: 1600000000, TagPaths: []string{}},
+				{URL: "https://example.com/bar", CreatedAt: 1600000000, TagPaths: []string{}},
+				{URL: "https://example.com/baz", Title: "Baz", CreatedAt: 1654500000, TagPaths: []string{}},
+			},
+			outputValidator: testCommon.ValidatorContains("2"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.fileContent != "" {
+				err = afero.WriteFile(fs, "in", []byte(test.fileContent), 0o644)
+				assert.NoError(t, err, test.name+", assert writing bookmarks to import")
+			}
+
+			if test.bookmarks != nil {
+				cli.BookmarkImportCmd.PreRun = func(_ *cobra.Command, _ []string) {
+					err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.bookmarks)
+					assert.NoError(t, err, test.name+", assert adding old bookmarks")
+
+					if test.trashedBookmarks != nil {
+						err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.trashedBookmarks)
+						assert.NoError(t, err, test.name+", assert adding trashed bookmarks")
+
+						err = cli.BNTPBackend.BookmarkManager.Delete(context.Background(), test.trashedBookmarks)
+						assert.NoError(t, err, test.name+", assert trashing bookmarks")
+					}
+				}
+			}
+
+			if test.importTwice {
+				err = cli.Execute()
+				assert.NoError(t, err, test.name+", assert first import does not error")
+
+				outputBuffer = testCommon.NewBufferString("")
+				cli.RootCmd.SetOut(outputBuffer)
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.storedBookmarks != nil {
+				assert.ElementsMatch(t, test.storedBookmarks, getImportedBookmarks(t, cli), test.name+", assert stored bookmarks match")
+			}
+
+			if test.storedTagPaths != nil {
+				tags, err := cli.BNTPBackend.TagManager.GetAll(context.Background())
+				assert.NoError(t, err, test.name+", assert getting stored tags")
+
+				storedTagPaths := []string{}
+				for _, tag := range tags {
+					path, err := cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+					assert.NoError(t, err, test.name+", assert marshalling tag path")
+
+					storedTagPaths = append(storedTagPaths, path)
+				}
+
+				assert.ElementsMatch(t, test.storedTagPaths, storedTagPaths, test.name+", assert stored tags match")
+			}
+		})
+	}
+}
+
+func TestCmdBookmarkExport(t *testing.T) {
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		tags            []*domain.Tag
+		bookmarks       []*domain.Bookmark
+		args            []string
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+		fileValidator   testCommon.OutputValidator
+		// exportedBookmarks are compared to the bookmarks parsed from the exported file, TagPaths holding the joined folders.
+		exportedBookmarks []importedBookmark
+	}{
+		{
+			name: "No args",
+			args: []string{
+				"bookmark",
+				"export",
+			},
+			errorMatcher:    testCommon.ValidatorContains("arg", "received"),
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("accepts", "received"),
+		},
+		{
+			name: "No bookmarks",
+			args: []string{
+				"bookmark",
+				"export",
+				"out",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name: "Some bookmarks",
+			args: []string{
+				"bookmark",
+				"export",
+				"out",
+			},
+			tags: []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}},
+			bookmarks: []*domain.Bookmark{
+				{ID: 1, URL: "https://example.com/bar", Title: optional.Make("Bar"), TagIDs: []int64{2}, CreatedAt: time.Unix(1654500000, 0)},
+				{ID: 2, URL: "https://example.com", TagIDs: []int64{1, 2}, CreatedAt: time.Unix(1654600000, 0)},
+				{ID: 3, URL: "https://example.com/foo", CreatedAt: time.Unix(1654700000, 0)},
+			},
+			exportedBookmarks: []importedBookmark{
+				{URL: "https://example.com/bar", Title: "Bar", CreatedAt: 1654500000, TagPaths: []string{"foo::bar"}},
+				{URL: "https://example.com", CreatedAt: 1654600000, TagPaths: []string{"foo"}},
+				{URL: "https://example.com", CreatedAt: 1654600000, TagPaths: []string{"foo::bar"}},
+				{URL: "https://example.com/foo", CreatedAt: 1654700000, TagPaths: []string{}},
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+			fileValidator:   testCommon.ValidatorContains("NETSCAPE-Bookmark-file-1", "<H3>foo</H3>", "<H3>bar</H3>", `HREF="https://example.com/bar"`, ">Bar</A>", `HREF="https://example.com"`),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			cli.BookmarkExportCmd.PreRun = func(_ *cobra.Command, _ []string) {
+				if test.tags != nil {
+					err = cli.BNTPBackend.TagManager.Add(context.Background(), test.tags)
+					assert.NoError(t, err, test.name+", assert adding old tags")
+				}
+
+				if test.bookmarks != nil {
+					err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.bookmarks)
+					assert.NoError(t, err, test.name+", assert adding old bookmarks")
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+			if test.fileValidator != nil {
+				exported, err := afero.ReadFile(fs, "out")
+				assert.NoError(t, err, test.name+", assert reading exported bookmarks")
+
+				test.fileValidator(t, string(exported), test.name+", assert exported file matches")
+			}
+			if test.exportedBookmarks != nil {
+				exported, err := cli.Fs.Open("out")
+				assert.NoError(t, err, test.name+", assert opening exported bookmarks")
+				defer exported.Close()
+
+				netscapeBookmarks, err := libbookmarks.ParseNetscapeBookmarks(exported)
+				assert.NoError(t, err, test.name+", assert parsing exported bookmarks")
+
+				exportedBookmarks := []importedBookmark{}
+				for _, netscapeBookmark := range netscapeBookmarks {
+					exportedBookmark := importedBookmark{URL: netscapeBookmark.URL, Title: netscapeBookmark.Title, CreatedAt: netscapeBookmark.AddDate.Unix(), TagPaths: []string{}}
+					if len(netscapeBookmark.Folders) > 0 {
+						exportedBookmark.TagPaths = []string{strings.Join(netscapeBookmark.Folders, libtags.PathSeparator)}
+					}
+
+					exportedBookmarks = append(exportedBookmarks, exportedBookmark)
+				}
+
+				assert.ElementsMatch(t, test.exportedBookmarks, exportedBookmarks, test.name+", assert exported bookmarks match")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
+
+// importedBookmark holds the parts of a stored bookmark a bookmark import sets.
+type importedBookmark struct {
+	URL       string
+	Title     string
+	CreatedAt int64
+	TagPaths  []string
+}
+
+func getImportedBookmarks(t *testing.T, cli *cmd.Cli) []importedBookmark {
+	bookmarks, err := cli.BNTPBackend.BookmarkManager.GetAll(context.Background())
+	assert.NoError(t, err, "assert getting stored bookmarks")
+
+	importedBookmarks := []importedBookmark{}
+	for _, bookmark := range bookmarks {
+		importedBookmark := importedBookmark{URL: bookmark.URL, CreatedAt: bookmark.CreatedAt.Unix(), TagPaths: []string{}}
+
+		if bookmark.Title.HasValue {
+			importedBookmark.Title = bookmark.Title.Wrappee
+		}
+
+		if len(bookmark.TagIDs) > 0 {
+			tags, err := cli.BNTPBackend.TagManager.GetFromIDs(context.Background(), bookmark.TagIDs)
+			assert.NoError(t, err, "assert getting tags of stored bookmark")
+
+			for _, tag := range tags {
+				path, err := cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+				assert.NoError(t, err, "assert marshalling tag path")
+
+				importedBookmark.TagPaths = append(importedBookmark.TagPaths, path)
+			}
+		}
+
+		importedBookmarks = append(importedBookmarks, importedBookmark)
+	}
+
+	return importedBookmarks
+}
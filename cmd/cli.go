@@ -28,6 +28,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
 	"github.com/JonasMuehlmann/bntp.go/internal/config"
@@ -65,16 +66,61 @@ func WithAll() CliOption {
 			multierror.Append(multiErr, err)
 		}
 
+		err = WithBookmarkTrashCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithDocumentTrashCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithDocumentLinkCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithDocumentGraphCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithBookmarkTagCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithDocumentTagCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
 		err = WithTagCommand()(cli)
 		if err != nil {
 			multierror.Append(multiErr, err)
 		}
 
+		err = WithServeCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
+		err = WithStatsCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
 		err = WithConfigCommand()(cli)
 		if err != nil {
 			multierror.Append(multiErr, err)
 		}
 
+		err = WithWatchCommand()(cli)
+		if err != nil {
+			multierror.Append(multiErr, err)
+		}
+
 		err = WithConfigManager()(cli)
 		if err != nil {
 			multierror.Append(multiErr, err)
@@ -206,6 +252,10 @@ func (cli *Cli) Execute() error {
 	cli.RootCmd.SilenceUsage = true
 	cli.RootCmd.SilenceErrors = true
 
+	if cli.ConfigManager != nil && cli.ConfigManager.PluginHost != nil {
+		defer cli.ConfigManager.PluginHost.Close()
+	}
+
 	err := cli.RootCmd.Execute()
 
 	if err != nil {
@@ -228,65 +278,134 @@ type Cli struct {
 	OutFormat     string
 	FilterRaw     string
 	UpdaterRaw    string
+	SortRaw       string
+	Limit         int64
+	Cursor        int64
+	FieldsRaw     string
+	GroupByRaw    string
+	TagsRaw       []string
+	ColorTagsRaw  []string
+	GraphFormat   string
+	Direction     string
+	Hops          int
+	Recursive     bool
+	OlderThan     time.Duration
+	DocumentPath  string
+	BookmarkURL   string
+	MatchAny      bool
+	GRPCAddress   string
+	HTTPAddress   string
+	WatchInterval time.Duration
+	WatchDebounce time.Duration
 	PathFormat    bool
 	ShortFormat   bool
 	DebugMode     bool
+	RebuildIndex  bool
 	StdErr        io.Writer
 	DBOverride    *sql.DB
 	Logger        *log.Logger
 	FsOverride    afero.Fs
 	Fs            afero.Fs
 
-	BookmarkAddCmd          *cobra.Command
-	BookmarkCmd             *cobra.Command
-	BookmarkCountCmd        *cobra.Command
-	BookmarkDoesExistCmd    *cobra.Command
-	BookmarkEditCmd         *cobra.Command
-	BookmarkFindCmd         *cobra.Command
-	BookmarkListCmd         *cobra.Command
-	BookmarkRemoveCmd       *cobra.Command
-	BookmarkReplaceCmd      *cobra.Command
-	BookmarkTypeAddCmd      *cobra.Command
-	BookmarkTypeCmd         *cobra.Command
-	BookmarkTypeEditCmd     *cobra.Command
-	BookmarkTypeRemoveCmd   *cobra.Command
-	BookmarkTypeListCmd     *cobra.Command
-	BookmarkUpsertCmd       *cobra.Command
-	configBaseNameCmd       *cobra.Command
-	ConfigCmd               *cobra.Command
-	ConfigExtensionsCmd     *cobra.Command
-	ConfigPathsCmd          *cobra.Command
-	ConfigGetSchemaCmd      *cobra.Command
-	ConfigGetDBProvidersCmd *cobra.Command
-	DocumentAddCmd          *cobra.Command
-	DocumentCmd             *cobra.Command
-	DocumentCountCmd        *cobra.Command
-	DocumentDoesExistCmd    *cobra.Command
-	DocumentEditCmd         *cobra.Command
-	DocumentFindCmd         *cobra.Command
-	DocumentListCmd         *cobra.Command
-	DocumentRemoveCmd       *cobra.Command
-	DocumentReplaceCmd      *cobra.Command
-	DocumentTypeAddCmd      *cobra.Command
-	DocumentTypeCmd         *cobra.Command
-	DocumentTypeEditCmd     *cobra.Command
-	DocumentTypeRemoveCmd   *cobra.Command
-	DocumentTypeListCmd     *cobra.Command
-	DocumentUpsertCmd       *cobra.Command
-	exportConfigCmd         *cobra.Command
-	RootCmd                 *cobra.Command
-	TagAddCmd               *cobra.Command
-	TagAmbiguousCmd         *cobra.Command
-	TagCmd                  *cobra.Command
-	TagCountCmd             *cobra.Command
-	TagDoesExistCmd         *cobra.Command
-	TagEditCmd              *cobra.Command
-	TagExportCmd            *cobra.Command
-	TagFindCmd              *cobra.Command
-	TagImportCmd            *cobra.Command
-	TagListCmd              *cobra.Command
-	TagRemoveCmd            *cobra.Command
-	TagReplaceCmd           *cobra.Command
-	TagShortCmd             *cobra.Command
-	TagUpsertCmd            *cobra.Command
+	BookmarkAddCmd                *cobra.Command
+	BookmarkCmd                   *cobra.Command
+	BookmarkCountCmd              *cobra.Command
+	BookmarkDoesExistCmd          *cobra.Command
+	BookmarkEditCmd               *cobra.Command
+	BookmarkExportCmd             *cobra.Command
+	BookmarkFindCmd               *cobra.Command
+	BookmarkImportCmd             *cobra.Command
+	BookmarkListCmd               *cobra.Command
+	BookmarkRemoveCmd             *cobra.Command
+	BookmarkReplaceCmd            *cobra.Command
+	BookmarkTagAddCmd             *cobra.Command
+	BookmarkTagCmd                *cobra.Command
+	BookmarkTagEditCmd            *cobra.Command
+	BookmarkTagListCmd            *cobra.Command
+	BookmarkTagRemoveCmd          *cobra.Command
+	BookmarkTrashCmd              *cobra.Command
+	BookmarkTrashListCmd          *cobra.Command
+	BookmarkTrashPurgeCmd         *cobra.Command
+	BookmarkTrashRestoreCmd       *cobra.Command
+	BookmarkTypeAddCmd            *cobra.Command
+	BookmarkTypeCmd               *cobra.Command
+	BookmarkTypeEditCmd           *cobra.Command
+	BookmarkTypeRemoveCmd         *cobra.Command
+	BookmarkTypeListCmd           *cobra.Command
+	BookmarkUpsertCmd             *cobra.Command
+	configBaseNameCmd             *cobra.Command
+	ConfigCmd                     *cobra.Command
+	ConfigExtensionsCmd           *cobra.Command
+	ConfigPathsCmd                *cobra.Command
+	ConfigGetSchemaCmd            *cobra.Command
+	ConfigGetDBProvidersCmd       *cobra.Command
+	DocumentAddCmd                *cobra.Command
+	DocumentCmd                   *cobra.Command
+	DocumentCountCmd              *cobra.Command
+	DocumentDoesExistCmd          *cobra.Command
+	DocumentEditCmd               *cobra.Command
+	DocumentFindCmd               *cobra.Command
+	DocumentGraphCmd              *cobra.Command
+	DocumentGraphComponentsCmd    *cobra.Command
+	DocumentGraphDeadEndsCmd      *cobra.Command
+	DocumentGraphExportCmd        *cobra.Command
+	DocumentGraphHubsCmd          *cobra.Command
+	DocumentGraphNeighbourhoodCmd *cobra.Command
+	DocumentGraphOrphansCmd       *cobra.Command
+	DocumentGraphPathCmd          *cobra.Command
+	DocumentLinkAddCmd            *cobra.Command
+	DocumentLinkCmd               *cobra.Command
+	DocumentLinkEditCmd           *cobra.Command
+	DocumentLinkListCmd           *cobra.Command
+	DocumentLinkRemoveCmd         *cobra.Command
+	DocumentLinkSyncCmd           *cobra.Command
+	DocumentListCmd               *cobra.Command
+	DocumentMoveCmd               *cobra.Command
+	DocumentRemoveCmd             *cobra.Command
+	DocumentReplaceCmd            *cobra.Command
+	DocumentSearchCmd             *cobra.Command
+	DocumentTagAddCmd             *cobra.Command
+	DocumentTagCmd                *cobra.Command
+	DocumentTagEditCmd            *cobra.Command
+	DocumentTagFindWithCmd        *cobra.Command
+	DocumentTagHasCmd             *cobra.Command
+	DocumentTagListCmd            *cobra.Command
+	DocumentTagRemoveCmd          *cobra.Command
+	DocumentTrashCmd              *cobra.Command
+	DocumentTrashListCmd          *cobra.Command
+	DocumentTrashPurgeCmd         *cobra.Command
+	DocumentTrashRestoreCmd       *cobra.Command
+	DocumentTypeAddCmd            *cobra.Command
+	DocumentTypeCmd               *cobra.Command
+	DocumentTypeEditCmd           *cobra.Command
+	DocumentTypeRemoveCmd         *cobra.Command
+	DocumentTypeListCmd           *cobra.Command
+	DocumentUpsertCmd             *cobra.Command
+	exportConfigCmd               *cobra.Command
+	RootCmd                       *cobra.Command
+	ServeCmd                      *cobra.Command
+	ServeGRPCCmd                  *cobra.Command
+	ServeHTTPCmd                  *cobra.Command
+	StatsBookmarkCmd              *cobra.Command
+	StatsCmd                      *cobra.Command
+	StatsDocumentCmd              *cobra.Command
+	StatsTagCmd                   *cobra.Command
+	TagAddCmd                     *cobra.Command
+	TagAmbiguousCmd               *cobra.Command
+	TagCmd                        *cobra.Command
+	TagCountCmd                   *cobra.Command
+	TagDoesExistCmd               *cobra.Command
+	TagEditCmd                    *cobra.Command
+	TagExportCmd                  *cobra.Command
+	TagFindCmd                    *cobra.Command
+	TagImportCmd                  *cobra.Command
+	TagListCmd                    *cobra.Command
+	TagMergeCmd                   *cobra.Command
+	TagMoveCmd                    *cobra.Command
+	TagRemoveCmd                  *cobra.Command
+	TagRenameCmd                  *cobra.Command
+	TagReplaceCmd                 *cobra.Command
+	TagShortCmd                   *cobra.Command
+	TagUpsertCmd                  *cobra.Command
+	WatchCmd                      *cobra.Command
 }
@@ -155,7 +155,7 @@ func WithDocumentCommand() CliOption {
 
 					numAffectedRecordsRaw = int64(len(args))
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
@@ -189,29 +189,59 @@ func WithDocumentCommand() CliOption {
 			Long:  `A longer description`,
 			Args:  cobra.NoArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
+				sorter, err := ParseSorter(cli.SortRaw, domain.DocumentFieldsList)
+				if err != nil {
+					return err
+				}
+
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.DocumentFieldsList)
+				if err != nil {
+					return err
+				}
+
+				// Paths are built from a fixed set of fields
+				if cli.PathFormat {
+					selector = domain.DocumentMemberSelector{"Path"}
+				}
+
+				limiter := NewLimiterFromFlags(cli)
+
 				var documents []*domain.Document
 				filter := &domain.DocumentFilter{}
 				var output string
-				var err error
 
-				if cli.FilterRaw == "" {
-					documents, err = cli.BNTPBackend.DocumentManager.GetAll(context.Background())
+				if cli.FilterRaw == "" && len(cli.TagsRaw) == 0 {
+					documents, err = cli.BNTPBackend.DocumentManager.GetAllSelected(context.Background(), sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
-
-					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
-						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
-					} else {
-						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
-						if err != nil {
-							return EntityMarshallingError{Inner: err}
+					if cli.FilterRaw != "" {
+						tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
+
+						if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
+							filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
+						} else {
+							err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+							if err != nil {
+								return EntityMarshallingError{Inner: err}
+							}
 						}
 					}
 
-					documents, err = cli.BNTPBackend.DocumentManager.GetWhere(context.Background(), filter)
+					tagFilter, err := NewTagFilterFromFlags(cli)
+					if err != nil {
+						return err
+					}
+
+					if tagFilter.HasValue {
+						// Copy to leave predefined filters untouched
+						filterCopy := *filter
+						filterCopy.TagIDs = tagFilter
+						filter = &filterCopy
+					}
+
+					documents, err = cli.BNTPBackend.DocumentManager.GetWhereSelected(context.Background(), filter, sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
@@ -228,7 +258,7 @@ func WithDocumentCommand() CliOption {
 					output = strings.Join(paths, "\n")
 				} else {
 
-					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(documents)
+					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(documents, selector))
 					if err != nil {
 						return EntityMarshallingError{Inner: err}
 					}
@@ -242,7 +272,7 @@ func WithDocumentCommand() CliOption {
 
 		cli.DocumentRemoveCmd = &cobra.Command{
 			Use:   "remove [MODEL...]",
-			Short: "Remove a bntp document",
+			Short: "Move bntp documents and their contents to the trash",
 			Long:  `A longer description`,
 			Args:  cobra.ArbitraryArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
@@ -263,14 +293,14 @@ func WithDocumentCommand() CliOption {
 						return err
 					}
 
-					err = cli.BNTPBackend.DocumentManager.Delete(context.Background(), documents)
+					err = cli.BNTPBackend.TrashDocuments(context.Background(), documents)
 					if err != nil {
 						return err
 					}
 
 					numAffectedRecordsRaw = int64(len(args))
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
@@ -281,7 +311,7 @@ func WithDocumentCommand() CliOption {
 						}
 					}
 
-					numAffectedRecordsRaw, err = cli.BNTPBackend.DocumentManager.DeleteWhere(context.Background(), filter)
+					numAffectedRecordsRaw, err = cli.BNTPBackend.TrashDocumentsWhere(context.Background(), filter)
 					if err != nil {
 						return err
 					}
@@ -309,7 +339,17 @@ func WithDocumentCommand() CliOption {
 				var result *domain.Document
 				var output string
 
-				tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.DocumentFieldsList)
+				if err != nil {
+					return err
+				}
+
+				// Paths are built from a fixed set of fields
+				if cli.PathFormat {
+					selector = domain.DocumentMemberSelector{"Path"}
+				}
+
+				tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
 
 				if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 					filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
@@ -320,7 +360,7 @@ func WithDocumentCommand() CliOption {
 					}
 				}
 
-				result, err = cli.BNTPBackend.DocumentManager.GetFirstWhere(context.Background(), filter)
+				result, err = cli.BNTPBackend.DocumentManager.GetFirstWhereSelected(context.Background(), filter, selector)
 				if err != nil {
 					return err
 				}
@@ -329,7 +369,7 @@ func WithDocumentCommand() CliOption {
 					output = result.GetPath()
 				} else {
 
-					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(result)
+					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(result, selector))
 					if err != nil {
 						return EntityMarshallingError{Inner: err}
 					}
@@ -350,22 +390,35 @@ func WithDocumentCommand() CliOption {
 				var countRaw int64
 				var err error
 
-				if cli.FilterRaw == "" {
+				if cli.FilterRaw == "" && len(cli.TagsRaw) == 0 {
 					countRaw, err = cli.BNTPBackend.DocumentManager.CountAll(context.Background())
 					if err != nil {
 						return err
 					}
 				} else {
+					if cli.FilterRaw != "" {
+						tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
+
+						if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
+							filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
+						} else {
+							err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+							if err != nil {
+								return EntityMarshallingError{Inner: err}
+							}
+						}
+					}
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tagFilter, err := NewTagFilterFromFlags(cli)
+					if err != nil {
+						return err
+					}
 
-					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
-						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
-					} else {
-						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
-						if err != nil {
-							return EntityMarshallingError{Inner: err}
-						}
+					if tagFilter.HasValue {
+						// Copy to leave predefined filters untouched
+						filterCopy := *filter
+						filterCopy.TagIDs = tagFilter
+						filter = &filterCopy
 					}
 
 					countRaw, err = cli.BNTPBackend.DocumentManager.CountWhere(context.Background(), filter)
@@ -414,7 +467,7 @@ func WithDocumentCommand() CliOption {
 					}
 				} else {
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
@@ -442,6 +495,94 @@ func WithDocumentCommand() CliOption {
 			},
 		}
 
+		cli.DocumentSearchCmd = &cobra.Command{
+			Use:   "search QUERY...",
+			Short: "Search the contents of bntp documents",
+			Long: `Search the contents of bntp documents, best matches first.
+Multiple arguments are joined to a single query.
+Matches are highlighted in the returned snippets, the query syntax is the one of SQLite's full text search.`,
+			Args: cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				var filter *domain.DocumentFilter
+				var output string
+				var err error
+
+				if cli.FilterRaw != "" {
+					tmp := hashmap.NewFromMap(domain.PredefinedDocumentFilters)
+
+					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
+						filter = domain.PredefinedDocumentFilters[cli.FilterRaw]
+					} else {
+						filter = &domain.DocumentFilter{}
+
+						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+						if err != nil {
+							return EntityMarshallingError{Inner: err}
+						}
+					}
+				}
+
+				if cli.RebuildIndex {
+					_, err = cli.BNTPBackend.DocumentContentManager.RebuildSearchIndex(context.Background(), &cli.BNTPBackend.DocumentManager)
+					if err != nil {
+						return err
+					}
+				}
+
+				results, err := cli.BNTPBackend.DocumentContentManager.Search(context.Background(), strings.Join(args, " "), filter, &cli.BNTPBackend.DocumentManager)
+				if err != nil {
+					return err
+				}
+
+				if cli.PathFormat {
+					paths := make([]string, 0, len(results))
+					for _, result := range results {
+						paths = append(paths, result.Path)
+					}
+
+					output = strings.Join(paths, "\n")
+				} else {
+					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(results)
+					if err != nil {
+						return EntityMarshallingError{Inner: err}
+					}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+				return nil
+			},
+		}
+
+		cli.DocumentMoveCmd = &cobra.Command{
+			Use:   "move OLD_PATH NEW_PATH",
+			Short: "Move a bntp document and its content, rewriting the links to it",
+			Long: `Move a bntp document and its content, rewriting the links to it.
+The relative links in the moved document and the links to it in linked and linking documents are rewritten,
+the number of rewritten linked and linking documents is output.
+Linking documents are found through the stored links and by parsing the contents, which might not be synced yet.`,
+			Args: cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				numAffectedRecordsRaw, err := cli.BNTPBackend.MoveDocument(context.Background(), args[0], args[1])
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.InFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+				return nil
+			},
+		}
+
 		cli.RootCmd.AddCommand(cli.DocumentCmd)
 
 		cli.DocumentCmd.AddCommand(cli.DocumentListCmd)
@@ -453,20 +594,39 @@ func WithDocumentCommand() CliOption {
 		cli.DocumentCmd.AddCommand(cli.DocumentDoesExistCmd)
 		cli.DocumentCmd.AddCommand(cli.DocumentFindCmd)
 		cli.DocumentCmd.AddCommand(cli.DocumentUpsertCmd)
+		cli.DocumentCmd.AddCommand(cli.DocumentSearchCmd)
+		cli.DocumentCmd.AddCommand(cli.DocumentMoveCmd)
 
 		for _, subcommand := range cli.DocumentCmd.Commands() {
-			if slices.Contains([]*cobra.Command{cli.DocumentAddCmd, cli.DocumentListCmd, cli.DocumentRemoveCmd, cli.DocumentFindCmd, cli.DocumentDoesExistCmd}, subcommand) {
+			if slices.Contains([]*cobra.Command{cli.DocumentAddCmd, cli.DocumentListCmd, cli.DocumentRemoveCmd, cli.DocumentFindCmd, cli.DocumentDoesExistCmd, cli.DocumentSearchCmd, cli.DocumentMoveCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.InFormat, "out-format", "json", "The serialization format to use for reading input")
 				subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
 			}
 		}
 
 		for _, subcommand := range cli.DocumentCmd.Commands() {
-			if slices.Contains([]*cobra.Command{cli.DocumentEditCmd, cli.DocumentListCmd, cli.DocumentRemoveCmd, cli.DocumentFindCmd, cli.DocumentCountCmd, cli.DocumentDoesExistCmd}, subcommand) {
+			if slices.Contains([]*cobra.Command{cli.DocumentEditCmd, cli.DocumentListCmd, cli.DocumentRemoveCmd, cli.DocumentFindCmd, cli.DocumentCountCmd, cli.DocumentDoesExistCmd, cli.DocumentSearchCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.FilterRaw, "filter", "", "The filter to use for processing entities")
 			}
 		}
 
+		cli.DocumentListCmd.PersistentFlags().StringVar(&cli.SortRaw, "sort", "", "The comma separated fields to sort by, each optionally suffixed with :asc or :desc")
+		cli.DocumentListCmd.PersistentFlags().Int64Var(&cli.Limit, "limit", 0, "The maximum number of entities to list, 0 lists all")
+		cli.DocumentListCmd.PersistentFlags().Int64Var(&cli.Cursor, "cursor", 0, "The number of entities to skip, the cursor of the next page is the current cursor plus the limit")
+
+		for _, subcommand := range cli.DocumentCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.DocumentListCmd, cli.DocumentCountCmd}, subcommand) {
+				subcommand.PersistentFlags().StringArrayVar(&cli.TagsRaw, "tag", nil, "The path of a tag the documents must have, e.g. foo::bar, can be repeated")
+				subcommand.PersistentFlags().BoolVar(&cli.Recursive, "recursive", false, "Also match descendants of the tags given by --tag")
+			}
+		}
+
+		for _, subcommand := range cli.DocumentCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.DocumentListCmd, cli.DocumentFindCmd}, subcommand) {
+				subcommand.PersistentFlags().StringVar(&cli.FieldsRaw, "fields", "", "The comma separated fields to load and output, all fields are used if empty")
+			}
+		}
+
 		for _, subcommand := range cli.DocumentCmd.Commands() {
 			if slices.Contains([]*cobra.Command{cli.DocumentEditCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.UpdaterRaw, "updater", "", "The updater to use for processing entities")
@@ -477,11 +637,13 @@ func WithDocumentCommand() CliOption {
 		cli.DocumentEditCmd.MarkPersistentFlagRequired("updater")
 
 		for _, subcommand := range cli.DocumentCmd.Commands() {
-			if slices.Contains([]*cobra.Command{cli.DocumentListCmd, cli.DocumentFindCmd}, subcommand) {
+			if slices.Contains([]*cobra.Command{cli.DocumentListCmd, cli.DocumentFindCmd, cli.DocumentSearchCmd}, subcommand) {
 				subcommand.PersistentFlags().BoolVar(&cli.PathFormat, "path-format", false, "Whetever to list documents in path format instead of --format format")
 			}
 		}
 
+		cli.DocumentSearchCmd.PersistentFlags().BoolVar(&cli.RebuildIndex, "rebuild-index", false, "Whetever to rebuild the search index from all documents before searching")
+
 		return
 	}
 }
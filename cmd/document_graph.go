@@ -0,0 +1,239 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func WithDocumentGraphCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.DocumentGraphCmd = &cobra.Command{
+			Use:   "graph",
+			Short: "Inspect the graph of links between bntp documents",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+		}
+
+		cli.DocumentGraphExportCmd = &cobra.Command{
+			Use:   "export",
+			Short: "Export the graph of links between bntp documents",
+			Long: `Export the graph of links between bntp documents as DOT for Graphviz, GraphML for e.g. Gephi or JSON.
+Nodes are labelled with the documents' paths, types and tags,
+only links between documents matching --filter are exported.
+Nodes are colored by the first tag given by --color-tag they or an ancestor of their tags match.`,
+			Args: cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, cli.ColorTagsRaw)
+				if err != nil {
+					return err
+				}
+
+				var output string
+
+				switch cli.GraphFormat {
+				case "dot":
+					output = graph.MarshalDOT()
+				case "graphml":
+					output, err = graph.MarshalGraphML()
+				case "json":
+					output, err = cli.BNTPBackend.Marshallers["json"].Marshall(graph)
+				default:
+					return InvalidGraphFormatError{Format: cli.GraphFormat}
+				}
+
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+				return nil
+			},
+		}
+
+		cli.DocumentGraphNeighbourhoodCmd = &cobra.Command{
+			Use:     "neighbourhood",
+			Aliases: []string{"neighborhood"},
+			Short:   "List the bntp documents reachable from a document in a number of links",
+			Long:    `A longer description`,
+			Args:    cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				neighbours, err := graph.Neighbourhood(cli.DocumentPath, cli.Hops, libdocuments.GraphDirection(cli.Direction))
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, neighbours)
+			},
+		}
+
+		cli.DocumentGraphPathCmd = &cobra.Command{
+			Use:   "path SOURCE_PATH DESTINATION_PATH",
+			Short: "List the bntp documents on a shortest link path between two documents",
+			Long:  `List the bntp documents on a shortest link path between two documents, including both. The list is empty if there is no path.`,
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				path, err := graph.ShortestPath(args[0], args[1], libdocuments.GraphDirection(cli.Direction))
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, path)
+			},
+		}
+
+		cli.DocumentGraphOrphansCmd = &cobra.Command{
+			Use:   "orphans",
+			Short: "List the bntp documents without links and backlinks",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, graph.Orphans())
+			},
+		}
+
+		cli.DocumentGraphDeadEndsCmd = &cobra.Command{
+			Use:   "dead-ends",
+			Short: "List the bntp documents which are linked to, but do not link to other documents",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, graph.DeadEnds())
+			},
+		}
+
+		cli.DocumentGraphHubsCmd = &cobra.Command{
+			Use:   "hubs",
+			Short: "List the bntp documents with the most backlinks",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, graph.Hubs(int(cli.Limit)))
+			},
+		}
+
+		cli.DocumentGraphComponentsCmd = &cobra.Command{
+			Use:   "components",
+			Short: "List the groups of bntp documents connected by links, largest first",
+			Long:  `List the groups of bntp documents connected by links regardless of their direction, largest first.`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				graph, err := newDocumentGraphFromFlags(cli, nil)
+				if err != nil {
+					return err
+				}
+
+				return printDocumentGraphQueryResult(cli, graph.Components())
+			},
+		}
+
+		cli.DocumentGraphExportCmd.Flags().StringVar(&cli.GraphFormat, "format", "dot", "The format to export the graph in, one of dot, graphml or json")
+		cli.DocumentGraphExportCmd.Flags().StringArrayVar(&cli.ColorTagsRaw, "color-tag", nil, "The path of a tag to color documents by, e.g. foo::bar, can be repeated")
+
+		cli.DocumentGraphNeighbourhoodCmd.Flags().StringVarP(&cli.DocumentPath, "document", "d", "", "The path of the document to start from")
+		cli.DocumentGraphNeighbourhoodCmd.Flags().IntVar(&cli.Hops, "hops", 1, "The maximum number of links to follow, 0 follows links without limit")
+		cli.DocumentGraphNeighbourhoodCmd.MarkFlagRequired("document")
+
+		cli.DocumentGraphHubsCmd.Flags().Int64Var(&cli.Limit, "limit", 10, "The maximum number of documents to list, 0 lists all")
+
+		cli.DocumentCmd.AddCommand(cli.DocumentGraphCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphExportCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphNeighbourhoodCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphPathCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphOrphansCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphDeadEndsCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphHubsCmd)
+		cli.DocumentGraphCmd.AddCommand(cli.DocumentGraphComponentsCmd)
+
+		for _, subcommand := range cli.DocumentGraphCmd.Commands() {
+			subcommand.Flags().StringVar(&cli.FilterRaw, "filter", "", "The filter to use for processing entities")
+			subcommand.Flags().StringVar(&cli.InFormat, "out-format", "json", "The serialization format to use for reading input")
+
+			if subcommand != cli.DocumentGraphExportCmd {
+				subcommand.Flags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
+			}
+
+			if slices.Contains([]*cobra.Command{cli.DocumentGraphNeighbourhoodCmd, cli.DocumentGraphPathCmd}, subcommand) {
+				subcommand.Flags().StringVar(&cli.Direction, "direction", string(libdocuments.GraphDirectionBoth), "The direction to follow links in, one of out, in or both")
+			}
+		}
+
+		return
+	}
+}
+
+// newDocumentGraphFromFlags creates the graph of the documents matching the --filter flag, colored by colorTagPaths.
+func newDocumentGraphFromFlags(cli *Cli, colorTagPaths []string) (*libdocuments.DocumentGraph, error) {
+	var filter *domain.DocumentFilter
+	var err error
+
+	if cli.FilterRaw != "" {
+		filter, err = NewFilterFromFlags(cli, domain.PredefinedDocumentFilters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cli.BNTPBackend.DocumentGraph(context.Background(), filter, colorTagPaths)
+}
+
+func printDocumentGraphQueryResult(cli *Cli, result any) error {
+	output, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(result)
+	if err != nil {
+		return EntityMarshallingError{Inner: err}
+	}
+
+	fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdDocumentGraph(t *testing.T) {
+	oldDocuments := []*domain.Document{
+		{ID: 1, Path: "notes/foo.md", TagIDs: []int64{2}},
+		{ID: 2, Path: "notes/bar.md", DocumentType: optional.Make("note")},
+		{ID: 3, Path: "archive/baz.md"},
+	}
+	oldLinks := map[int64][]int64{1: {2}, 2: {3}}
+	oldBacklinks := map[int64][]int64{2: {1}, 3: {2}}
+
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		args            []string
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name:            "Invalid format",
+			args:            []string{"document", "graph", "export", "--format", "png"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("Invalid graph format"),
+			err:             cmd.InvalidGraphFormatError{},
+		},
+		{
+			name:            "Unknown color tag",
+			args:            []string{"document", "graph", "export", "--color-tag", "baz"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("No tag with path"),
+			err:             libtags.UnknownTagPathError{},
+		},
+		{
+			name: "Export JSON",
+			args: []string{"document", "graph", "export", "--format", "json"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(libdocuments.DocumentGraph{
+				Nodes: []libdocuments.DocumentGraphNode{
+					{ID: 1, Path: "notes/foo.md", Tags: []string{"foo::bar"}},
+					{ID: 2, Path: "notes/bar.md", DocumentType: "note", Tags: []string{}},
+					{ID: 3, Path: "archive/baz.md", Tags: []string{}},
+				},
+				Edges: []libdocuments.DocumentGraphEdge{{Source: 1, Target: 2}, {Source: 2, Target: 3}},
+			}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Export filtered DOT",
+			args: []string{
+				"document",
+				"graph",
+				"export",
+				"--color-tag",
+				"foo",
+				"--filter",
+				string(drop.From2To1(json.Marshal(domain.DocumentFilter{Path: optional.Make(model.FilterOperation[string]{Operator: model.FilterLike, Operand: model.ScalarOperand[string]{Operand: "notes/%"}})}))),
+			},
+			outputValidator: testCommon.ValidatorEqual(strings.Join([]string{
+				"digraph documents {",
+				"\tnode [shape=box];",
+				"\t1 [label=\"notes/foo.md\\ntags: foo::bar\", style=filled, fillcolor=\"#8dd3c7\"];",
+				"\t2 [label=\"notes/bar.md\\ntype: note\"];",
+				"\t1 -> 2;",
+				"}",
+			}, "\n") + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Export GraphML",
+			args:            []string{"document", "graph", "export", "--format", "graphml"},
+			outputValidator: testCommon.ValidatorContains(`<edge id="e1" source="2" target="3"></edge>`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Neighbourhood of unknown document",
+			args:            []string{"document", "graph", "neighbourhood", "-d", "notes/qux.md"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("No document with path"),
+			err:             libdocuments.UnknownGraphNodeError{},
+		},
+		{
+			name:            "Neighbourhood with invalid direction",
+			args:            []string{"document", "graph", "neighbourhood", "-d", "notes/bar.md", "--direction", "up"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("Invalid graph direction"),
+			err:             libdocuments.InvalidGraphDirectionError{},
+		},
+		{
+			name: "Neighbourhood",
+			args: []string{"document", "graph", "neighbourhood", "-d", "notes/bar.md"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphNeighbour{
+				{DocumentGraphNode: libdocuments.DocumentGraphNode{ID: 1, Path: "notes/foo.md", Tags: []string{"foo::bar"}}, Distance: 1},
+				{DocumentGraphNode: libdocuments.DocumentGraphNode{ID: 3, Path: "archive/baz.md", Tags: []string{}}, Distance: 1},
+			}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Outgoing neighbourhood without hop limit",
+			args: []string{"document", "graph", "neighbourhood", "-d", "notes/foo.md", "--hops", "0", "--direction", "out"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphNeighbour{
+				{DocumentGraphNode: libdocuments.DocumentGraphNode{ID: 2, Path: "notes/bar.md", DocumentType: "note", Tags: []string{}}, Distance: 1},
+				{DocumentGraphNode: libdocuments.DocumentGraphNode{ID: 3, Path: "archive/baz.md", Tags: []string{}}, Distance: 2},
+			}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Path",
+			args: []string{"document", "graph", "path", "notes/foo.md", "archive/baz.md"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphNode{
+				{ID: 1, Path: "notes/foo.md", Tags: []string{"foo::bar"}},
+				{ID: 2, Path: "notes/bar.md", DocumentType: "note", Tags: []string{}},
+				{ID: 3, Path: "archive/baz.md", Tags: []string{}},
+			}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "No outgoing path",
+			args:            []string{"document", "graph", "path", "archive/baz.md", "notes/foo.md", "--direction", "out"},
+			outputValidator: testCommon.ValidatorEqual("[]\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Orphans of filtered graph",
+			args:            []string{"document", "graph", "orphans", "--filter", string(drop.From2To1(json.Marshal(domain.DocumentFilter{Path: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: "notes/foo.md"}})})))},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphNode{{ID: 1, Path: "notes/foo.md", Tags: []string{"foo::bar"}}}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Dead ends",
+			args:            []string{"document", "graph", "dead-ends"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphNode{{ID: 3, Path: "archive/baz.md", Tags: []string{}}}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Hubs",
+			args: []string{"document", "graph", "hubs", "--limit", "1"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([]libdocuments.DocumentGraphHub{
+				{DocumentGraphNode: libdocuments.DocumentGraphNode{ID: 2, Path: "notes/bar.md", DocumentType: "note", Tags: []string{}}, NumLinks: 1, NumBacklinks: 1},
+			}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Components",
+			args: []string{"document", "graph", "components"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal([][]libdocuments.DocumentGraphNode{{
+				{ID: 1, Path: "notes/foo.md", Tags: []string{"foo::bar"}},
+				{ID: 2, Path: "notes/bar.md", DocumentType: "note", Tags: []string{}},
+				{ID: 3, Path: "archive/baz.md", Tags: []string{}},
+			}}))) + "\n"),
+			errorValidator: testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			for _, subcommand := range cli.DocumentGraphCmd.Commands() {
+				subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+					err = cli.BNTPBackend.TagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+					assert.NoError(t, err, test.name+", assert adding tags")
+
+					err = cli.BNTPBackend.DocumentManager.AddType(context.Background(), []string{"note"})
+					assert.NoError(t, err, test.name+", assert adding document type")
+
+					documents := make([]*domain.Document, 0, len(oldDocuments))
+					for _, document := range oldDocuments {
+						document := *document
+						documents = append(documents, &document)
+					}
+
+					err = cli.BNTPBackend.DocumentManager.Add(context.Background(), documents)
+					assert.NoError(t, err, test.name+", assert adding old documents")
+
+					// Links are added separately, since they can only reference existing documents
+					for _, document := range documents {
+						document.LinkedDocumentIDs = oldLinks[document.ID]
+						document.BacklinkedDocumentsIDs = oldBacklinks[document.ID]
+					}
+
+					err = cli.BNTPBackend.DocumentManager.Replace(context.Background(), documents)
+					assert.NoError(t, err, test.name+", assert adding old links")
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func WithDocumentLinkCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.DocumentLinkCmd = &cobra.Command{
+			Use:     "link",
+			Aliases: []string{"links"},
+			Short:   "Manage links between bntp documents",
+			Long:    `A longer description`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				return nil
+			},
+		}
+
+		cli.DocumentLinkAddCmd = &cobra.Command{
+			Use:   "add LINK...",
+			Short: "Add links to a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.AddDocumentLinks(context.Background(), cli.DocumentPath, args)
+
+				return err
+			},
+		}
+
+		cli.DocumentLinkEditCmd = &cobra.Command{
+			Use:   "edit OLD_LINK NEW_LINK",
+			Short: "Change a link between bntp documents",
+			Long:  `A longer description`,
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				err := cli.BNTPBackend.EditDocumentLink(context.Background(), cli.DocumentPath, args[0], args[1])
+
+				return err
+			},
+		}
+
+		cli.DocumentLinkRemoveCmd = &cobra.Command{
+			Use:   "remove LINK...",
+			Short: "Remove links from a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.RemoveDocumentLinks(context.Background(), cli.DocumentPath, args)
+
+				return err
+			},
+		}
+
+		cli.DocumentLinkListCmd = &cobra.Command{
+			Use:   "list",
+			Short: "List links from and to a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				links, backlinks, err := cli.BNTPBackend.GetDocumentLinks(context.Background(), cli.DocumentPath)
+				if err != nil {
+					return err
+				}
+
+				documentLinks := DocumentLinks{Links: []string{}, Backlinks: []string{}}
+
+				if len(links) > 0 {
+					documentLinks.Links, err = goaoi.TransformCopySliceUnsafe(links, (*domain.Document).GetPath)
+					if err != nil {
+						return err
+					}
+				}
+
+				if len(backlinks) > 0 {
+					documentLinks.Backlinks, err = goaoi.TransformCopySliceUnsafe(backlinks, (*domain.Document).GetPath)
+					if err != nil {
+						return err
+					}
+				}
+
+				output, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(documentLinks)
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+				return nil
+			},
+		}
+
+		cli.DocumentLinkSyncCmd = &cobra.Command{
+			Use:   "sync [PATH...]",
+			Short: "Replace the links of bntp documents with the ones found in their contents, all documents are synced if no paths are given",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				numAffectedRecordsRaw, err := cli.BNTPBackend.DocumentContentManager.SyncLinks(context.Background(), args, &cli.BNTPBackend.DocumentManager)
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+				return nil
+			},
+		}
+
+		cli.DocumentCmd.AddCommand(cli.DocumentLinkCmd)
+		cli.DocumentLinkCmd.AddCommand(cli.DocumentLinkAddCmd)
+		cli.DocumentLinkCmd.AddCommand(cli.DocumentLinkEditCmd)
+		cli.DocumentLinkCmd.AddCommand(cli.DocumentLinkRemoveCmd)
+		cli.DocumentLinkCmd.AddCommand(cli.DocumentLinkListCmd)
+
+		cli.DocumentLinkCmd.AddCommand(cli.DocumentLinkSyncCmd)
+
+		for _, subcommand := range cli.DocumentLinkCmd.Commands() {
+			if subcommand != cli.DocumentLinkSyncCmd {
+				subcommand.PersistentFlags().StringVarP(&cli.DocumentPath, "document", "d", "", "The path of the document to work with")
+				subcommand.MarkPersistentFlagRequired("document")
+			}
+		}
+
+		for _, subcommand := range cli.DocumentLinkCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.DocumentLinkListCmd, cli.DocumentLinkSyncCmd}, subcommand) {
+				subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
+			}
+		}
+
+		return
+	}
+}
@@ -0,0 +1,326 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestCmdDocumentLink(t *testing.T) {
+	tests := []struct {
+		err               error
+		errorMatcher      testCommon.OutputValidator
+		name              string
+		oldDocuments      []*domain.Document
+		args              []string
+		oldContents       map[string]string
+		expectedContents  map[string]string
+		expectedLinkedIDs []int64
+		outputValidator   testCommon.OutputValidator
+		errorValidator    testCommon.OutputValidator
+	}{
+		{
+			name: "No document",
+			args: []string{
+				"document",
+				"link",
+				"add",
+				"bar",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("required flag"),
+			errorMatcher:    testCommon.ValidatorContains("required flag"),
+		},
+		{
+			name: "Add no links",
+			args: []string{
+				"document",
+				"link",
+				"add",
+				"-d",
+				"foo",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name:         "Add links",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo"}, {ID: 2, Path: "bar"}, {ID: 3, Path: "baz"}},
+			args: []string{
+				"document",
+				"link",
+				"add",
+				"-d",
+				"foo",
+				"bar",
+				"baz",
+			},
+			expectedContents: map[string]string{
+				"foo": "# Tags\n# Links\n- (bar)[bar]\n- (baz)[baz]\n# Backlinks",
+				"bar": "# Tags\n# Links\n# Backlinks\n- (foo)[foo]",
+				"baz": "# Tags\n# Links\n# Backlinks\n- (foo)[foo]",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Add link to non-existent document",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo"}, {ID: 2, Path: "bar"}},
+			args: []string{
+				"document",
+				"link",
+				"add",
+				"-d",
+				"foo",
+				"baz",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("does not exist"),
+		},
+		{
+			name:         "Add duplicate link",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo", LinkedDocumentIDs: []int64{2}}, {ID: 2, Path: "bar"}},
+			args: []string{
+				"document",
+				"link",
+				"add",
+				"-d",
+				"foo",
+				"bar",
+			},
+			err:             helper.DuplicateInsertionError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("duplicate"),
+		},
+		{
+			name:         "Remove link",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo", LinkedDocumentIDs: []int64{2, 3}}, {ID: 2, Path: "bar"}, {ID: 3, Path: "baz"}},
+			args: []string{
+				"document",
+				"link",
+				"remove",
+				"-d",
+				"foo",
+				"bar",
+			},
+			expectedContents: map[string]string{
+				"foo": "# Tags\n# Links\n- (baz)[baz]\n# Backlinks",
+				"bar": "# Tags\n# Links\n# Backlinks\n",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Remove non-existent link",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo"}, {ID: 2, Path: "bar"}},
+			args: []string{
+				"document",
+				"link",
+				"remove",
+				"-d",
+				"foo",
+				"bar",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name:         "Edit link",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo", LinkedDocumentIDs: []int64{2}}, {ID: 2, Path: "bar"}, {ID: 3, Path: "baz"}},
+			args: []string{
+				"document",
+				"link",
+				"edit",
+				"-d",
+				"foo",
+				"bar",
+				"baz",
+			},
+			expectedContents: map[string]string{
+				"foo": "# Tags\n# Links\n- (baz)[baz]\n# Backlinks",
+				"bar": "# Tags\n# Links\n# Backlinks\n",
+				"baz": "# Tags\n# Links\n# Backlinks\n- (foo)[foo]",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "List links",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo", LinkedDocumentIDs: []int64{2}}, {ID: 2, Path: "bar"}, {ID: 3, Path: "baz", LinkedDocumentIDs: []int64{1}}},
+			args: []string{
+				"document",
+				"link",
+				"list",
+				"-d",
+				"foo",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.DocumentLinks{Links: []string{"bar"}, Backlinks: []string{"baz"}}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "List links without trashed documents",
+			oldDocuments: []*domain.Document{
+				{ID: 1, Path: "foo", LinkedDocumentIDs: []int64{2, 3}},
+				{ID: 2, Path: "bar"},
+				{ID: 3, Path: "baz", DeletedAt: optional.Make(time.Now())},
+				{ID: 4, Path: "qux", LinkedDocumentIDs: []int64{1}, DeletedAt: optional.Make(time.Now())},
+			},
+			args: []string{
+				"document",
+				"link",
+				"list",
+				"-d",
+				"foo",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.DocumentLinks{Links: []string{"bar"}, Backlinks: []string{}}))) + "\n"),
+		},
+		{
+			name:         "Sync links",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md", LinkedDocumentIDs: []int64{2}}, {ID: 2, Path: "notes/bar.md"}, {ID: 3, Path: "baz.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n# Backlinks\n\nSee [[../baz]], [web](https://example.com) and [missing](missing.md)",
+			},
+			args: []string{
+				"document",
+				"links",
+				"sync",
+			},
+			expectedLinkedIDs: []int64{3},
+			outputValidator:   testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:    testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Sync links of path",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md"}, {ID: 2, Path: "notes/bar.md"}, {ID: 3, Path: "baz.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n- (baz.md)[baz.md]\n# Backlinks\n\nSee [bar](bar.md)",
+			},
+			args: []string{
+				"document",
+				"links",
+				"sync",
+				"notes/foo.md",
+			},
+			expectedLinkedIDs: []int64{2, 3},
+			outputValidator:   testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:    testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.oldDocuments != nil {
+				for _, subcommand := range cli.DocumentLinkCmd.Commands() {
+					subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+						// Links are added separately, since they can only reference existing documents
+						links := make(map[int64][]int64, len(test.oldDocuments))
+						paths := make(map[int64]string, len(test.oldDocuments))
+						for _, document := range test.oldDocuments {
+							links[document.ID] = document.LinkedDocumentIDs
+							paths[document.ID] = document.Path
+							document.LinkedDocumentIDs = nil
+
+						}
+
+						err = cli.BNTPBackend.DocumentManager.Add(context.Background(), test.oldDocuments)
+						assert.NoError(t, err, test.name+", assert adding old documents")
+
+						// Replacing a document also replaces its backlinks, so they have to match the links
+						for _, document := range test.oldDocuments {
+							document.LinkedDocumentIDs = links[document.ID]
+
+							for _, source := range test.oldDocuments {
+								if slices.Contains(links[source.ID], document.ID) {
+									document.BacklinkedDocumentsIDs = append(document.BacklinkedDocumentsIDs, source.ID)
+								}
+							}
+						}
+
+						err = cli.BNTPBackend.DocumentManager.Replace(context.Background(), test.oldDocuments)
+						assert.NoError(t, err, test.name+", assert adding old links")
+
+						for _, document := range test.oldDocuments {
+							content := "# Tags\n# Links"
+							for _, link := range document.LinkedDocumentIDs {
+								content += "\n- (" + paths[link] + ")[" + paths[link] + "]"
+							}
+							content += "\n# Backlinks"
+							for _, backlink := range document.BacklinkedDocumentsIDs {
+								content += "\n- (" + paths[backlink] + ")[" + paths[backlink] + "]"
+							}
+
+							if oldContent, ok := test.oldContents[document.Path]; ok {
+								content = oldContent
+							}
+
+							err = afero.WriteFile(fs, document.Path, []byte(content), 0o644)
+							assert.NoError(t, err, test.name+", assert writing old document contents")
+						}
+					}
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.expectedLinkedIDs != nil {
+				documents, err := cli.BNTPBackend.DocumentManager.GetFromIDs(context.Background(), []int64{1})
+				assert.NoError(t, err, test.name+", assert getting document")
+				assert.ElementsMatch(t, test.expectedLinkedIDs, documents[0].LinkedDocumentIDs, test.name+", assert linked documents match")
+			}
+
+			for path, expectedContent := range test.expectedContents {
+				content, err := afero.ReadFile(fs, path)
+				assert.NoError(t, err, test.name+", assert reading document contents")
+				assert.Equal(t, expectedContent, string(content), test.name+", assert document contents match")
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdDocumentMove(t *testing.T) {
+	tests := []struct {
+		err              error
+		errorMatcher     testCommon.OutputValidator
+		name             string
+		oldDocuments     []*domain.Document
+		oldContents      map[string]string
+		args             []string
+		movedID          int64
+		expectedPath     string
+		expectedContents map[string]string
+		outputValidator  testCommon.OutputValidator
+		errorValidator   testCommon.OutputValidator
+	}{
+		{
+			name:            "No paths",
+			args:            []string{"document", "move", "notes/foo.md"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("accepts 2 arg(s)"),
+			errorMatcher:    testCommon.ValidatorContains("accepts 2 arg(s)"),
+		},
+		{
+			name:            "Unknown document",
+			oldDocuments:    []*domain.Document{{ID: 1, Path: "notes/foo.md"}},
+			oldContents:     map[string]string{"notes/foo.md": "# Tags\n# Links\n# Backlinks"},
+			args:            []string{"document", "move", "notes/bar.md", "notes/baz.md"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("does not exist"),
+			err:             helper.IneffectiveOperationError{},
+		},
+		{
+			name:         "Existing destination",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md"}, {ID: 2, Path: "notes/bar.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n# Backlinks",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks",
+			},
+			args:            []string{"document", "move", "notes/foo.md", "notes/bar.md"},
+			outputValidator: testCommon.ValidatorEmpty,
+			err:             helper.DuplicateInsertionError{},
+			movedID:         1,
+			expectedPath:    "notes/foo.md",
+		},
+		{
+			name: "Move linked document",
+			oldDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md", LinkedDocumentIDs: []int64{2}},
+				{ID: 2, Path: "notes/bar.md", BacklinkedDocumentsIDs: []int64{1}},
+				{ID: 3, Path: "notes/baz.md"},
+			},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n- (notes/bar.md)[notes/bar.md]\n# Backlinks\n\nSee [bar](bar.md#heading) and [baz](baz.md)",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks\n- (notes/foo.md)[notes/foo.md]\n\nSee [[foo]] and [[bar#heading]]",
+				"notes/baz.md": "# Tags\n# Links\n# Backlinks\n\nSee [bar](bar.md)",
+			},
+			args:         []string{"document", "move", "notes/bar.md", "archive/bar.md"},
+			movedID:      2,
+			expectedPath: "archive/bar.md",
+			expectedContents: map[string]string{
+				"notes/foo.md":   "# Tags\n# Links\n- (archive/bar.md)[archive/bar.md]\n# Backlinks\n\nSee [bar](../archive/bar.md#heading) and [baz](baz.md)",
+				"archive/bar.md": "# Tags\n# Links\n# Backlinks\n- (notes/foo.md)[notes/foo.md]\n\nSee [[../notes/foo]] and [[bar#heading]]",
+				// Documents linking to the moved one without being in the links table are rewritten as well
+				"notes/baz.md": "# Tags\n# Links\n# Backlinks\n\nSee [bar](../archive/bar.md)",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Move document linked only in contents",
+			oldDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md"},
+				{ID: 2, Path: "notes/bar.md"},
+				{ID: 3, Path: "notes/baz.md"},
+			},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n# Backlinks\n\nSee [[bar]]",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks",
+				"notes/baz.md": "# Tags\n# Links\n# Backlinks\n\nSee [foo](foo.md)",
+			},
+			args:         []string{"document", "move", "notes/bar.md", "archive/bar.md"},
+			movedID:      2,
+			expectedPath: "archive/bar.md",
+			expectedContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n# Backlinks\n\nSee [[../archive/bar]]",
+				"notes/baz.md": "# Tags\n# Links\n# Backlinks\n\nSee [foo](foo.md)",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.oldDocuments != nil {
+				cli.DocumentMoveCmd.PreRun = func(_ *cobra.Command, _ []string) {
+					// Links are added separately, since they can only reference existing documents
+					links := make(map[int64][]int64, len(test.oldDocuments))
+					for _, document := range test.oldDocuments {
+						links[document.ID] = document.LinkedDocumentIDs
+						document.LinkedDocumentIDs = nil
+					}
+
+					err = cli.BNTPBackend.DocumentManager.Add(context.Background(), test.oldDocuments)
+					assert.NoError(t, err, test.name+", assert adding old documents")
+
+					for _, document := range test.oldDocuments {
+						document.LinkedDocumentIDs = links[document.ID]
+					}
+
+					err = cli.BNTPBackend.DocumentManager.Replace(context.Background(), test.oldDocuments)
+					assert.NoError(t, err, test.name+", assert adding old links")
+
+					for path, content := range test.oldContents {
+						err = afero.WriteFile(fs, path, []byte(content), 0o644)
+						assert.NoError(t, err, test.name+", assert writing old document contents")
+					}
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.expectedPath != "" {
+				documents, err := cli.BNTPBackend.DocumentManager.GetFromIDs(context.Background(), []int64{test.movedID})
+				assert.NoError(t, err, test.name+", assert getting document")
+				assert.Equal(t, test.expectedPath, documents[0].Path, test.name+", assert document path matches")
+			}
+
+			for path, expectedContent := range test.expectedContents {
+				content, err := afero.ReadFile(fs, path)
+				assert.NoError(t, err, test.name+", assert reading document contents")
+				assert.Equal(t, expectedContent, string(content), test.name+", assert document contents match")
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/cobra"
+	"golang.org/x/exp/slices"
+)
+
+func WithDocumentTagCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.DocumentTagCmd = &cobra.Command{
+			Use:   "tag",
+			Short: "Manage tags of bntp documents",
+			Long:  `A longer description`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				return nil
+			},
+		}
+
+		cli.DocumentTagAddCmd = &cobra.Command{
+			Use:   "add TAG...",
+			Short: "Add tags to a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.AddDocumentTags(context.Background(), cli.DocumentPath, args)
+
+				return err
+			},
+		}
+
+		cli.DocumentTagEditCmd = &cobra.Command{
+			Use:   "edit OLD_TAG NEW_TAG",
+			Short: "Change a tag of a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				err := cli.BNTPBackend.EditDocumentTag(context.Background(), cli.DocumentPath, args[0], args[1])
+
+				return err
+			},
+		}
+
+		cli.DocumentTagRemoveCmd = &cobra.Command{
+			Use:   "remove TAG...",
+			Short: "Remove tags from a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				err := cli.BNTPBackend.RemoveDocumentTags(context.Background(), cli.DocumentPath, args)
+
+				return err
+			},
+		}
+
+		cli.DocumentTagListCmd = &cobra.Command{
+			Use:   "list",
+			Short: "List the tags of a bntp document",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				tags, err := cli.BNTPBackend.GetDocumentTags(context.Background(), cli.DocumentPath)
+				if err != nil {
+					return err
+				}
+
+				tagPaths, err := goaoi.TransformCopySlice(tags, func(tag *domain.Tag) (string, error) {
+					return cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+				})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), strings.Join(tagPaths, "\n"))
+
+				return nil
+			},
+		}
+
+		cli.DocumentTagHasCmd = &cobra.Command{
+			Use:   "has TAG...",
+			Short: "Check if a bntp document has tags",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				tagFilter, err := newTagsFilterFromArgs(cli, args)
+				if err != nil {
+					return err
+				}
+
+				filter := &domain.DocumentFilter{
+					Path: optional.Make(model.FilterOperation[string]{
+						Operator: model.FilterEqual,
+						Operand:  model.ScalarOperand[string]{Operand: cli.DocumentPath},
+					}),
+					TagIDs: tagFilter,
+				}
+
+				doesExistRaw, err := cli.BNTPBackend.DocumentManager.DoesExistWhere(context.Background(), filter)
+				if err != nil {
+					return err
+				}
+
+				doesExist, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(DoesExist{doesExistRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), doesExist)
+
+				return nil
+			},
+		}
+
+		cli.DocumentTagFindWithCmd = &cobra.Command{
+			Use:   "find-with TAG...",
+			Short: "List bntp documents with tags",
+			Long:  `A longer description`,
+			Args:  cobra.ArbitraryArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				tagFilter, err := newTagsFilterFromArgs(cli, args)
+				if err != nil {
+					return err
+				}
+
+				documents, err := cli.BNTPBackend.DocumentManager.GetWhere(context.Background(), &domain.DocumentFilter{TagIDs: tagFilter})
+				if err != nil {
+					return err
+				}
+
+				output, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(documents)
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+				return nil
+			},
+		}
+
+		cli.DocumentCmd.AddCommand(cli.DocumentTagCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagAddCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagEditCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagRemoveCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagListCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagHasCmd)
+		cli.DocumentTagCmd.AddCommand(cli.DocumentTagFindWithCmd)
+
+		for _, subcommand := range cli.DocumentTagCmd.Commands() {
+			if subcommand != cli.DocumentTagFindWithCmd {
+				subcommand.PersistentFlags().StringVarP(&cli.DocumentPath, "document", "d", "", "The path of the document to work with")
+				subcommand.MarkPersistentFlagRequired("document")
+			}
+		}
+
+		for _, subcommand := range cli.DocumentTagCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.DocumentTagHasCmd, cli.DocumentTagFindWithCmd}, subcommand) {
+				subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
+				subcommand.PersistentFlags().BoolVarP(&cli.MatchAny, "or", "o", false, "Whether to require any instead of all tags to match")
+			}
+		}
+
+		return
+	}
+}
@@ -0,0 +1,248 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdDocumentTag(t *testing.T) {
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		oldDocuments    []*domain.Document
+		oldContent      string
+		args            []string
+		expectedContent string
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name: "No document",
+			args: []string{
+				"document",
+				"tag",
+				"add",
+				"foo",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("required flag"),
+			errorMatcher:    testCommon.ValidatorContains("required flag"),
+		},
+		{
+			name:         "Add tags",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md"}},
+			oldContent:   "# Tags\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"add",
+				"-d",
+				"foo.md",
+				"foo",
+				"foo::bar",
+			},
+			expectedContent: "# Tags\nfoo,foo::bar\n# Links\n# Backlinks",
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Add tag by shortened path",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md"}},
+			oldContent:   "# Tags\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"add",
+				"-d",
+				"foo.md",
+				"bar",
+			},
+			expectedContent: "# Tags\nfoo::bar\n# Links\n# Backlinks",
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Add duplicate tag",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1}}},
+			oldContent:   "# Tags\nfoo\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"add",
+				"-d",
+				"foo.md",
+				"foo",
+			},
+			err:             helper.DuplicateInsertionError{},
+			expectedContent: "# Tags\nfoo\n# Links\n# Backlinks",
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("duplicate"),
+		},
+		{
+			name:         "Remove tag",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1, 2}}},
+			oldContent:   "# Tags\nfoo,foo::bar\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"remove",
+				"-d",
+				"foo.md",
+				"foo",
+			},
+			expectedContent: "# Tags\nfoo::bar\n# Links\n# Backlinks",
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Edit tag",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1}}},
+			oldContent:   "# Tags\nfoo\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"edit",
+				"-d",
+				"foo.md",
+				"foo",
+				"foo::bar",
+			},
+			expectedContent: "# Tags\nfoo::bar\n# Links\n# Backlinks",
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "List tags",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1, 2}}},
+			oldContent:   "# Tags\nfoo,foo::bar\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"list",
+				"-d",
+				"foo.md",
+			},
+			outputValidator: testCommon.ValidatorEqual("foo\nfoo::bar\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Has all tags",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1}}},
+			oldContent:   "# Tags\nfoo\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"has",
+				"-d",
+				"foo.md",
+				"foo",
+				"foo::bar",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.DoesExist{false}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Has any tag",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1}}},
+			oldContent:   "# Tags\nfoo\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"has",
+				"-d",
+				"foo.md",
+				"--or",
+				"foo",
+				"foo::bar",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.DoesExist{true}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:         "Find with tag",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "foo.md", TagIDs: []int64{1}}, {ID: 2, Path: "bar.md", TagIDs: []int64{2}}},
+			oldContent:   "# Tags\n# Links\n# Backlinks",
+			args: []string{
+				"document",
+				"tag",
+				"find-with",
+				"foo::bar",
+			},
+			outputValidator: testCommon.ValidatorContains("bar.md"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.oldDocuments != nil {
+				for _, subcommand := range cli.DocumentTagCmd.Commands() {
+					subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+						err = cli.BNTPBackend.TagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+						assert.NoError(t, err, test.name+", assert adding tags")
+
+						err = cli.BNTPBackend.DocumentManager.Add(context.Background(), test.oldDocuments)
+						assert.NoError(t, err, test.name+", assert adding old documents")
+
+						for _, document := range test.oldDocuments {
+							err = afero.WriteFile(fs, document.Path, []byte(test.oldContent), 0o644)
+							assert.NoError(t, err, test.name+", assert writing old document contents")
+						}
+					}
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.expectedContent != "" {
+				content, err := afero.ReadFile(fs, test.oldDocuments[0].Path)
+				assert.NoError(t, err, test.name+", assert reading document contents")
+				assert.Equal(t, test.expectedContent, string(content), test.name+", assert document contents match")
+			}
+		})
+	}
+}
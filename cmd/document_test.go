@@ -12,6 +12,7 @@ import (
 	testCommon "github.com/JonasMuehlmann/bntp.go/test"
 	"github.com/JonasMuehlmann/drop-return-values.go"
 	"github.com/JonasMuehlmann/optional.go"
+	"github.com/barweiss/go-tuple"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -1154,3 +1155,170 @@ func TestCmdDocumentDoesExist(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdDocumentSearch(t *testing.T) {
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		args            []string
+		documents       []*domain.Document
+		pathContents    []tuple.T2[string, string]
+		files           []tuple.T2[string, string]
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name: "No args",
+			args: []string{
+				"document",
+				"search",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name: "No documents",
+			args: []string{
+				"document",
+				"search",
+				"foo",
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name: "Some documents",
+			args: []string{
+				"document",
+				"search",
+				"foo",
+			},
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: getDocumentSkeleton() + "foo bar"},
+				{V1: "bar.md", V2: getDocumentSkeleton() + "bar"},
+			},
+			outputValidator: testCommon.ValidatorContains("foo.md", "**foo**"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some documents, path format",
+			args: []string{
+				"document",
+				"search",
+				"--path-format",
+				"bar",
+			},
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: getDocumentSkeleton() + "foo bar"},
+				{V1: "bar.md", V2: getDocumentSkeleton() + "bar bar"},
+			},
+			outputValidator: testCommon.ValidatorContains("bar.md\nfoo.md"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Some documents, using filter",
+			args: []string{
+				"document",
+				"search",
+				"--filter",
+				string(drop.From2To1(json.Marshal(domain.DocumentFilter{Path: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: "bar.md"}})}))),
+				"foo",
+			},
+			documents: []*domain.Document{{ID: 1, Path: "foo.md"}, {ID: 2, Path: "bar.md"}},
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: getDocumentSkeleton() + "foo"},
+				{V1: "bar.md", V2: getDocumentSkeleton() + "foo"},
+			},
+			outputValidator: testCommon.ValidatorContains("bar.md"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Unindexed documents, rebuilding index",
+			args: []string{
+				"document",
+				"search",
+				"--rebuild-index",
+				"foo",
+			},
+			documents: []*domain.Document{{ID: 1, Path: "foo.md"}, {ID: 2, Path: "bar.md"}},
+			files: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: getDocumentSkeleton() + "foo"},
+				{V1: "bar.md", V2: getDocumentSkeleton() + "bar"},
+			},
+			outputValidator: testCommon.ValidatorContains("foo.md"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Bad filter",
+			args: []string{
+				"document",
+				"search",
+				"--filter",
+				"foo",
+				"foo",
+			},
+			err:             cmd.EntityMarshallingError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("marshalling"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			for _, file := range test.files {
+				err = afero.WriteFile(fs, file.V1, []byte(file.V2), 0o644)
+				assert.NoError(t, err, test.name+", assert writing unindexed documents")
+			}
+
+			cli.DocumentSearchCmd.PreRun = func(_ *cobra.Command, _ []string) {
+				if test.documents != nil {
+					err = cli.BNTPBackend.DocumentManager.Add(context.Background(), test.documents)
+					assert.NoError(t, err, test.name+", assert adding old documents")
+				}
+
+				if test.pathContents != nil {
+					err = cli.BNTPBackend.DocumentContentManager.Add(context.Background(), test.pathContents)
+					assert.NoError(t, err, test.name+", assert adding old document contents")
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/optional.go"
+)
+
+func WithDocumentTrashCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.DocumentTrashCmd, cli.DocumentTrashListCmd, cli.DocumentTrashRestoreCmd, cli.DocumentTrashPurgeCmd = newTrashCommands(cli, trashOperations[domain.Document, domain.DocumentFilter]{
+			entities:          "documents",
+			description:       "bntp documents and their contents",
+			predefinedFilters: domain.PredefinedDocumentFilters,
+			setDeletedAt: func(filter *domain.DocumentFilter, deletedAt optional.Optional[model.FilterOperation[optional.Optional[time.Time]]]) {
+				filter.DeletedAt = deletedAt
+			},
+			getTrashedWhere: func(ctx context.Context, filter *domain.DocumentFilter) ([]*domain.Document, error) {
+				return cli.BNTPBackend.DocumentManager.GetWhere(ctx, libdocuments.TrashedFilter(filter))
+			},
+			restore: func(ctx context.Context, documents []*domain.Document) error {
+				return cli.BNTPBackend.RestoreDocuments(ctx, documents)
+			},
+			restoreWhere: func(ctx context.Context, filter *domain.DocumentFilter) (int64, error) {
+				return cli.BNTPBackend.RestoreDocumentsWhere(ctx, filter)
+			},
+			purge: func(ctx context.Context, documents []*domain.Document) error {
+				return cli.BNTPBackend.PurgeDocuments(ctx, documents)
+			},
+			purgeWhere: func(ctx context.Context, filter *domain.DocumentFilter) (int64, error) {
+				return cli.BNTPBackend.PurgeDocumentsWhere(ctx, filter)
+			},
+		})
+
+		cli.DocumentCmd.AddCommand(cli.DocumentTrashCmd)
+
+		return
+	}
+}
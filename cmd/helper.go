@@ -21,8 +21,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/goaoi"
+	"github.com/JonasMuehlmann/optional.go"
+	"golang.org/x/exp/slices"
 )
 
 func UnmarshalEntities[TEntity any](cli *Cli, args []string, format string) (entities []*TEntity, err error) {
@@ -39,6 +48,247 @@ func UnmarshalEntities[TEntity any](cli *Cli, args []string, format string) (ent
 	return tags, nil
 }
 
+// ParseSorter parses a comma separated list of sort keys of the form FIELD[:asc|:desc].
+// FIELD is matched case-insensitively against fields.
+func ParseSorter[TField ~string](sortRaw string, fields []TField) (sorter []model.SortKey[TField], err error) {
+	if sortRaw == "" {
+		return nil, nil
+	}
+
+	for _, sortKeyRaw := range strings.Split(sortRaw, ",") {
+		fieldRaw, directionRaw, _ := strings.Cut(strings.TrimSpace(sortKeyRaw), ":")
+
+		sortKey := model.SortKey[TField]{}
+
+		switch strings.ToLower(directionRaw) {
+		case "", "asc":
+			sortKey.Direction = model.SortAscending
+		case "desc":
+			sortKey.Direction = model.SortDescending
+		default:
+			return nil, InvalidSortKeyError{SortKey: sortKeyRaw}
+		}
+
+		for _, field := range fields {
+			if strings.EqualFold(string(field), fieldRaw) {
+				sortKey.Field = field
+
+				break
+			}
+		}
+
+		if sortKey.Field == "" {
+			return nil, InvalidSortKeyError{SortKey: sortKeyRaw}
+		}
+
+		sorter = append(sorter, sortKey)
+	}
+
+	return
+}
+
+// NewLimiterFromFlags creates a limiter from the --limit and --cursor flags, returning nil if neither is set.
+func NewLimiterFromFlags(cli *Cli) *model.Limiter {
+	if cli.Limit == 0 && cli.Cursor == 0 {
+		return nil
+	}
+
+	return &model.Limiter{Limit: cli.Limit, Offset: cli.Cursor}
+}
+
+// NewTagFilterFromFlags creates a filter matching entities tagged with all tag paths of the --tag flags,
+// or with any of their descendants if --recursive is set. The filter is unset if no --tag flag is set.
+func NewTagFilterFromFlags(cli *Cli) (tagFilter optional.Optional[model.FilterOperation[int64]], err error) {
+	if len(cli.TagsRaw) == 0 {
+		return
+	}
+
+	tagIDs := make([]int64, 0, len(cli.TagsRaw))
+
+	for _, tagPath := range cli.TagsRaw {
+		tag, err := cli.BNTPBackend.TagManager.UnmarshalPath(context.Background(), tagPath)
+		if err != nil {
+			return tagFilter, err
+		}
+
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	operator := model.FilterContains
+	if cli.Recursive {
+		operator = model.FilterContainsRecursive
+	}
+
+	tagFilter.Set(model.FilterOperation[int64]{Operator: operator, Operand: model.ListOperand[int64]{Operands: tagIDs}})
+
+	return
+}
+
+// newTagsFilterFromArgs creates a filter operation matching all tags with the paths in args, or any of them if the --or flag is set.
+func newTagsFilterFromArgs(cli *Cli, args []string) (tagFilter optional.Optional[model.FilterOperation[int64]], err error) {
+	tags, err := cli.BNTPBackend.TagsFromPaths(context.Background(), args)
+	if err != nil {
+		return
+	}
+
+	tagIDs, err := goaoi.TransformCopySliceUnsafe(tags, (*domain.Tag).GetID)
+	if err != nil {
+		return
+	}
+
+	operator := model.FilterContains
+	if cli.MatchAny {
+		operator = model.FilterContainsAny
+	}
+
+	tagFilter.Set(model.FilterOperation[int64]{Operator: operator, Operand: model.ListOperand[int64]{Operands: tagIDs}})
+
+	return
+}
+
+// NewFilterFromFlags creates a filter from the --filter flag, which is either the name of one of the predefined filters or a serialized filter.
+// The filter is empty if the flag is not set.
+func NewFilterFromFlags[TFilter any](cli *Cli, predefinedFilters map[string]*TFilter) (filter *TFilter, err error) {
+	filter = new(TFilter)
+
+	if cli.FilterRaw == "" {
+		return
+	}
+
+	if predefinedFilter, ok := predefinedFilters[cli.FilterRaw]; ok {
+		// Copy to leave predefined filters untouched
+		*filter = *predefinedFilter
+
+		return
+	}
+
+	err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+	if err != nil {
+		err = EntityMarshallingError{Inner: err}
+	}
+
+	return
+}
+
+// NewOlderThanFilterFromFlags creates a filter operation matching times before now minus the --older-than flag.
+// The filter operation is unset if the flag is not set.
+func NewOlderThanFilterFromFlags(cli *Cli) (olderThanFilter optional.Optional[model.FilterOperation[optional.Optional[time.Time]]]) {
+	if cli.OlderThan == 0 {
+		return
+	}
+
+	olderThanFilter.Set(model.FilterOperation[optional.Optional[time.Time]]{
+		Operator: model.FilterLessThan,
+		Operand:  model.ScalarOperand[optional.Optional[time.Time]]{Operand: optional.Make(time.Now().UTC().Add(-cli.OlderThan))},
+	})
+
+	return
+}
+
+// ParseGrouper parses a group key of the form FIELD[:day|:week|:month|:year].
+// FIELD is matched case-insensitively against fields.
+func ParseGrouper[TField ~string](groupByRaw string, fields []TField) (groupKey model.GroupKey[TField], err error) {
+	fieldRaw, bucketRaw, _ := strings.Cut(strings.TrimSpace(groupByRaw), ":")
+
+	switch strings.ToLower(bucketRaw) {
+	case "":
+	case "day":
+		groupKey.Bucket = model.BucketDay
+	case "week":
+		groupKey.Bucket = model.BucketWeek
+	case "month":
+		groupKey.Bucket = model.BucketMonth
+	case "year":
+		groupKey.Bucket = model.BucketYear
+	default:
+		return groupKey, InvalidGroupKeyError{GroupKey: groupByRaw}
+	}
+
+	i, err := goaoi.FindIfSlice(fields, func(field TField) bool { return strings.EqualFold(string(field), fieldRaw) })
+	if err != nil {
+		return groupKey, InvalidGroupKeyError{GroupKey: groupByRaw}
+	}
+
+	groupKey.Field = fields[i]
+
+	return
+}
+
+// ParseMemberSelector parses a comma separated list of fields.
+// The fields are matched case-insensitively against fields, fields listed more than once are selected once.
+func ParseMemberSelector[TField ~string](fieldsRaw string, fields []TField) (selector []TField, err error) {
+	if fieldsRaw == "" {
+		return nil, nil
+	}
+
+	for _, fieldRaw := range strings.Split(fieldsRaw, ",") {
+		fieldRaw = strings.TrimSpace(fieldRaw)
+
+		i, err := goaoi.FindIfSlice(fields, func(field TField) bool { return strings.EqualFold(string(field), fieldRaw) })
+		if err != nil {
+			return nil, InvalidFieldError{Field: fieldRaw}
+		}
+
+		if slices.Contains(selector, fields[i]) {
+			continue
+		}
+
+		selector = append(selector, fields[i])
+	}
+
+	return
+}
+
+// SelectMembers projects an entity or a slice of entities onto the fields in selector.
+// The projected structs keep the fields' tags, so they marshal like the full entities.
+func SelectMembers[TField ~string](entities any, selector []TField) any {
+	if len(selector) == 0 {
+		return entities
+	}
+
+	value := reflect.ValueOf(entities)
+
+	if value.Kind() != reflect.Slice {
+		return selectStructMembers(value, projectStructType(value.Type(), selector)).Interface()
+	}
+
+	projectedType := projectStructType(value.Type().Elem(), selector)
+	projected := reflect.MakeSlice(reflect.SliceOf(projectedType), 0, value.Len())
+
+	for i := 0; i < value.Len(); i++ {
+		projected = reflect.Append(projected, selectStructMembers(value.Index(i), projectedType))
+	}
+
+	return projected.Interface()
+}
+
+func projectStructType[TField ~string](entityType reflect.Type, selector []TField) reflect.Type {
+	if entityType.Kind() == reflect.Pointer {
+		entityType = entityType.Elem()
+	}
+
+	fields := make([]reflect.StructField, 0, len(selector))
+
+	for _, field := range selector {
+		structField, _ := entityType.FieldByName(string(field))
+
+		fields = append(fields, reflect.StructField{Name: structField.Name, Type: structField.Type, Tag: structField.Tag})
+	}
+
+	return reflect.StructOf(fields)
+}
+
+func selectStructMembers(entity reflect.Value, projectedType reflect.Type) reflect.Value {
+	entity = reflect.Indirect(entity)
+	projected := reflect.New(projectedType).Elem()
+
+	for i := 0; i < projectedType.NumField(); i++ {
+		projected.Field(i).Set(entity.FieldByName(projectedType.Field(i).Name))
+	}
+
+	return projected
+}
+
 //******************************************************************//
 //                      EntitymarshallingError                      //
 //******************************************************************//
@@ -106,6 +356,130 @@ func (err ConflictingPositionalArgsAndFlagError) As(target any) bool {
 	}
 }
 
+//******************************************************************//
+//                        InvalidSortKeyError                       //
+//******************************************************************//
+
+type InvalidSortKeyError struct {
+	SortKey string
+}
+
+func (err InvalidSortKeyError) Error() string {
+	return fmt.Sprintf("Invalid sort key %q, expected FIELD[:asc|:desc]", err.SortKey)
+}
+
+func (err InvalidSortKeyError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidSortKeyError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidSortKeyError) As(target any) bool {
+	switch target.(type) {
+	case InvalidSortKeyError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                       InvalidGroupKeyError                       //
+//******************************************************************//
+
+type InvalidGroupKeyError struct {
+	GroupKey string
+}
+
+func (err InvalidGroupKeyError) Error() string {
+	return fmt.Sprintf("Invalid group key %q, expected FIELD[:day|:week|:month|:year]", err.GroupKey)
+}
+
+func (err InvalidGroupKeyError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidGroupKeyError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidGroupKeyError) As(target any) bool {
+	switch target.(type) {
+	case InvalidGroupKeyError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                         InvalidFieldError                        //
+//******************************************************************//
+
+type InvalidFieldError struct {
+	Field string
+}
+
+func (err InvalidFieldError) Error() string {
+	return fmt.Sprintf("Invalid field %q", err.Field)
+}
+
+func (err InvalidFieldError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidFieldError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidFieldError) As(target any) bool {
+	switch target.(type) {
+	case InvalidFieldError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                      InvalidGraphFormatError                     //
+//******************************************************************//
+
+type InvalidGraphFormatError struct {
+	Format string
+}
+
+func (err InvalidGraphFormatError) Error() string {
+	return fmt.Sprintf("Invalid graph format %q, expected one of dot, graphml or json", err.Format)
+}
+
+func (err InvalidGraphFormatError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidGraphFormatError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidGraphFormatError) As(target any) bool {
+	switch target.(type) {
+	case InvalidGraphFormatError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
 //******************************************************************//
 //                     Non entity output structs                    //
 //******************************************************************//
@@ -121,3 +495,8 @@ type Count struct {
 type NumAffectedRecords struct {
 	NumAffectedRecords int64 `json:"numAffectedRecords"`
 }
+
+type DocumentLinks struct {
+	Links     []string `json:"links"`
+	Backlinks []string `json:"backlinks"`
+}
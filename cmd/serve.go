@@ -0,0 +1,124 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/server"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/spf13/cobra"
+)
+
+func WithServeCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.ServeCmd = &cobra.Command{
+			Use:   "serve",
+			Short: "Serve the bntp backend to other processes",
+			Long:  `A longer description`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				return nil
+			},
+		}
+
+		cli.ServeGRPCCmd = &cobra.Command{
+			Use:   "grpc",
+			Short: "Serve the bookmark, tag, document and document content managers over gRPC",
+			Long: `Serve the bookmark, tag, document and document content managers over gRPC.
+The services are described by bntp/server/bntppb/bntp.proto,
+filters and updaters are passed as JSON in the same format accepted by the --filter and --updater flags.`,
+			Args: cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				listener, err := net.Listen("tcp", cli.GRPCAddress)
+				if err != nil {
+					return err
+				}
+
+				grpcServer := server.NewGRPCServer(cli.BNTPBackend)
+
+				ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+				defer stop()
+
+				go func() {
+					<-ctx.Done()
+					grpcServer.GracefulStop()
+				}()
+
+				cli.Logger.Infof("Serving gRPC on %v", listener.Addr())
+
+				return grpcServer.Serve(listener)
+			},
+		}
+
+		cli.ServeHTTPCmd = &cobra.Command{
+			Use:   "http",
+			Short: "Serve a REST API over the bookmark, tag and document managers",
+			Long: `Serve a REST API over the bookmark, tag and document managers.
+Bookmarks, tags and documents are available under /bookmarks, /tags and /documents,
+their types under /bookmark-types and /document-types.
+Filters are passed as JSON through the filter query parameter, e.g. /bookmarks/count?filter={...}.`,
+			Args: cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				listener, err := net.Listen("tcp", cli.HTTPAddress)
+				if err != nil {
+					return err
+				}
+
+				httpServer := &http.Server{Handler: server.NewHTTPHandler(cli.BNTPBackend)}
+
+				ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+				defer stop()
+
+				go func() {
+					<-ctx.Done()
+					httpServer.Shutdown(context.Background())
+				}()
+
+				cli.Logger.Infof("Serving HTTP on %v", listener.Addr())
+
+				err = httpServer.Serve(listener)
+				if errors.Is(err, http.ErrServerClosed) {
+					return nil
+				}
+
+				return err
+			},
+		}
+
+		cli.ServeGRPCCmd.Flags().StringVar(&cli.GRPCAddress, "address", "localhost:50051", "The address to listen on")
+		cli.ServeHTTPCmd.Flags().StringVar(&cli.HTTPAddress, "address", "localhost:8080", "The address to listen on")
+
+		cli.RootCmd.AddCommand(cli.ServeCmd)
+		cli.ServeCmd.AddCommand(cli.ServeGRPCCmd)
+		cli.ServeCmd.AddCommand(cli.ServeHTTPCmd)
+
+		return
+	}
+}
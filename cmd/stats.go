@@ -0,0 +1,260 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/spf13/cobra"
+)
+
+func WithStatsCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.StatsCmd = &cobra.Command{
+			Use:   "stats",
+			Short: "Count bntp entities per group",
+			Long: `Count bntp entities per group.
+Groups are formed by a field passed as FIELD[:day|:week|:month|:year], timestamps are grouped into buckets.
+Tags are output as their paths.`,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+				}
+
+				return nil
+			},
+		}
+
+		cli.StatsBookmarkCmd = &cobra.Command{
+			Use:   "bookmark",
+			Short: "Count bntp bookmarks per group",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				groupKey, err := ParseGrouper(cli.GroupByRaw, domain.BookmarkFieldsList)
+				if err != nil {
+					return err
+				}
+
+				grouper := domain.BookmarkGrouper(groupKey)
+
+				var groups []*model.Group
+
+				if cli.FilterRaw == "" {
+					groups, err = cli.BNTPBackend.BookmarkManager.CountGroupedAll(context.Background(), &grouper)
+				} else {
+					filter, ok := domain.PredefinedBookmarkFilters[cli.FilterRaw]
+					if !ok {
+						filter = &domain.BookmarkFilter{}
+
+						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+						if err != nil {
+							return EntityMarshallingError{Inner: err}
+						}
+					}
+
+					groups, err = cli.BNTPBackend.BookmarkManager.CountGroupedWhere(context.Background(), filter, &grouper)
+				}
+				if err != nil {
+					return err
+				}
+
+				if grouper.Field == "TagIDs" {
+					err = tagGroupKeysToPaths(cli, groups)
+					if err != nil {
+						return err
+					}
+				}
+
+				return printGroups(cli, groups)
+			},
+		}
+
+		cli.StatsDocumentCmd = &cobra.Command{
+			Use:   "document",
+			Short: "Count bntp documents per group",
+			Long:  `A longer description`,
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				groupKey, err := ParseGrouper(cli.GroupByRaw, domain.DocumentFieldsList)
+				if err != nil {
+					return err
+				}
+
+				grouper := domain.DocumentGrouper(groupKey)
+
+				var groups []*model.Group
+
+				if cli.FilterRaw == "" {
+					groups, err = cli.BNTPBackend.DocumentManager.CountGroupedAll(context.Background(), &grouper)
+				} else {
+					filter, ok := domain.PredefinedDocumentFilters[cli.FilterRaw]
+					if !ok {
+						filter = &domain.DocumentFilter{}
+
+						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+						if err != nil {
+							return EntityMarshallingError{Inner: err}
+						}
+					}
+
+					groups, err = cli.BNTPBackend.DocumentManager.CountGroupedWhere(context.Background(), filter, &grouper)
+				}
+				if err != nil {
+					return err
+				}
+
+				if grouper.Field == "TagIDs" {
+					err = tagGroupKeysToPaths(cli, groups)
+					if err != nil {
+						return err
+					}
+				}
+
+				return printGroups(cli, groups)
+			},
+		}
+
+		cli.StatsTagCmd = &cobra.Command{
+			Use:   "tag",
+			Short: "Count bntp tags per group",
+			Long: `Count bntp tags per group.
+Grouping by ParentPathIDs counts the direct children of each tag.`,
+			Args: cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				groupKey, err := ParseGrouper(cli.GroupByRaw, domain.TagFieldsList)
+				if err != nil {
+					return err
+				}
+
+				grouper := domain.TagGrouper(groupKey)
+
+				var groups []*model.Group
+
+				if cli.FilterRaw == "" {
+					groups, err = cli.BNTPBackend.TagManager.CountGroupedAll(context.Background(), &grouper)
+				} else {
+					filter, ok := domain.PredefinedTagFilters[cli.FilterRaw]
+					if !ok {
+						filter = &domain.TagFilter{}
+
+						err = cli.BNTPBackend.Unmarshallers[cli.InFormat].Unmarshall(filter, cli.FilterRaw)
+						if err != nil {
+							return EntityMarshallingError{Inner: err}
+						}
+					}
+
+					groups, err = cli.BNTPBackend.TagManager.CountGroupedWhere(context.Background(), filter, &grouper)
+				}
+				if err != nil {
+					return err
+				}
+
+				if grouper.Field == "ParentPathIDs" {
+					err = tagGroupKeysToPaths(cli, groups)
+					if err != nil {
+						return err
+					}
+				}
+
+				return printGroups(cli, groups)
+			},
+		}
+
+		cli.RootCmd.AddCommand(cli.StatsCmd)
+
+		cli.StatsCmd.AddCommand(cli.StatsBookmarkCmd)
+		cli.StatsCmd.AddCommand(cli.StatsDocumentCmd)
+		cli.StatsCmd.AddCommand(cli.StatsTagCmd)
+
+		for _, subcommand := range cli.StatsCmd.Commands() {
+			subcommand.PersistentFlags().StringVar(&cli.GroupByRaw, "group-by", "", "The field to group by, optionally suffixed with :day, :week, :month or :year for timestamps")
+			subcommand.PersistentFlags().StringVar(&cli.FilterRaw, "filter", "", "The filter to use for processing entities")
+			subcommand.PersistentFlags().StringVar(&cli.InFormat, "in-format", "json", "The serialization format to use for reading input")
+			subcommand.PersistentFlags().StringVar(&cli.OutFormat, "out-format", "json", "The serialization format to use for writing output")
+
+			subcommand.MarkPersistentFlagRequired("group-by")
+		}
+
+		return
+	}
+}
+
+// tagGroupKeysToPaths replaces the tag IDs used as keys of groups with the tags' paths.
+func tagGroupKeysToPaths(cli *Cli, groups []*model.Group) error {
+	tagIDs := make([]int64, 0, len(groups))
+
+	for _, group := range groups {
+		// Entities without tags are grouped under the empty key
+		if group.Key == "" {
+			continue
+		}
+
+		tagID, err := strconv.ParseInt(group.Key, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		tagIDs = append(tagIDs, tagID)
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	tags, err := cli.BNTPBackend.TagManager.GetFromIDs(context.Background(), tagIDs)
+	if err != nil {
+		return err
+	}
+
+	paths := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		paths[strconv.FormatInt(tag.ID, 10)], err = cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, group := range groups {
+		if path, ok := paths[group.Key]; ok {
+			group.Key = path
+		}
+	}
+
+	return nil
+}
+
+func printGroups(cli *Cli, groups []*model.Group) error {
+	output, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(groups)
+	if err != nil {
+		return EntityMarshallingError{Inner: err}
+	}
+
+	fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+	return nil
+}
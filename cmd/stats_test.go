@@ -0,0 +1,139 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdStatsBookmark(t *testing.T) {
+	tests := []struct {
+		err             error
+		name            string
+		args            []string
+		bookmarks       []*domain.Bookmark
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name: "No bookmarks",
+			args: []string{
+				"stats",
+				"bookmark",
+				"--group-by",
+				"bookmarkType",
+			},
+			outputValidator: testCommon.ValidatorContains("[]"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Group by type",
+			args: []string{
+				"stats",
+				"bookmark",
+				"--group-by",
+				"bookmarkType",
+			},
+			bookmarks:       []*domain.Bookmark{{ID: 1, URL: "foo", BookmarkType: optional.Make("foo")}, {ID: 2, URL: "bar", BookmarkType: optional.Make("foo")}, {ID: 3, URL: "baz"}},
+			outputValidator: testCommon.ValidatorContains(`[{"key":"","count":1},{"key":"foo","count":2}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Group unread by tag paths",
+			args: []string{
+				"stats",
+				"bookmark",
+				"--group-by",
+				"tagIDs",
+				"--filter",
+				domain.BookmarkFilterUnread,
+			},
+			bookmarks:       []*domain.Bookmark{{ID: 1, URL: "foo", TagIDs: []int64{2}}, {ID: 2, URL: "bar", TagIDs: []int64{2}, IsRead: true}},
+			outputValidator: testCommon.ValidatorContains(`[{"key":"foo::bar","count":1}]`),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "CSV output",
+			args: []string{
+				"stats",
+				"bookmark",
+				"--group-by",
+				"isRead",
+				"--out-format",
+				"csv",
+			},
+			bookmarks:       []*domain.Bookmark{{ID: 1, URL: "foo"}, {ID: 2, URL: "bar", IsRead: true}},
+			outputValidator: testCommon.ValidatorContains("Key,Count\n0,1\n1,1"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Bad group key",
+			args: []string{
+				"stats",
+				"bookmark",
+				"--group-by",
+				"createdAt:fortnight",
+			},
+			err:             cmd.InvalidGroupKeyError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("Invalid group key"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			if test.bookmarks != nil {
+				cli.StatsBookmarkCmd.PreRun = func(_ *cobra.Command, _ []string) {
+					err = cli.BNTPBackend.TagManager.Add(context.Background(), []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+					assert.NoError(t, err, test.name+", assert adding tags")
+
+					err = cli.BNTPBackend.BookmarkManager.AddType(context.Background(), []string{"foo"})
+					assert.NoError(t, err, test.name+", assert adding types")
+
+					err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), test.bookmarks)
+					assert.NoError(t, err, test.name+", assert adding bookmarks")
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
@@ -59,6 +59,20 @@ func WithTagCommand() CliOption {
 				if len(args) == 0 {
 					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
 				}
+
+				if cli.PathFormat {
+					return cli.BNTPBackend.InUnitOfWork(context.Background(), func(ctx context.Context) error {
+						for _, path := range args {
+							_, err := cli.BNTPBackend.TagManager.AddPath(ctx, path)
+							if err != nil {
+								return err
+							}
+						}
+
+						return nil
+					})
+				}
+
 				tags, err := UnmarshalEntities[domain.Tag](cli, args, cli.InFormat)
 				if err != nil {
 					return err
@@ -168,7 +182,7 @@ func WithTagCommand() CliOption {
 					//************************    Use filter    ************************//
 				} else {
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -260,21 +274,37 @@ func WithTagCommand() CliOption {
 			Long:  `A longer description`,
 			Args:  cobra.NoArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
+				sorter, err := ParseSorter(cli.SortRaw, domain.TagFieldsList)
+				if err != nil {
+					return err
+				}
+
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.TagFieldsList)
+				if err != nil {
+					return err
+				}
+
+				// Paths are built from a fixed set of fields
+				if cli.PathFormat || cli.ShortFormat {
+					selector = domain.TagMemberSelector{"Tag", "ParentPathIDs"}
+				}
+
+				limiter := NewLimiterFromFlags(cli)
+
 				var tags []*domain.Tag
 				filter := &domain.TagFilter{}
 				var output string
-				var err error
 
 				//**************************    Get all    *************************//
 				if cli.FilterRaw == "" {
-					tags, err = cli.BNTPBackend.TagManager.GetAll(context.Background())
+					tags, err = cli.BNTPBackend.TagManager.GetAllSelected(context.Background(), sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
 
 					//********************    Use provided filter    *******************//
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -285,7 +315,7 @@ func WithTagCommand() CliOption {
 						}
 					}
 
-					tags, err = cli.BNTPBackend.TagManager.GetWhere(context.Background(), filter)
+					tags, err = cli.BNTPBackend.TagManager.GetWhereSelected(context.Background(), filter, sorter, limiter, selector)
 					if err != nil {
 						return err
 					}
@@ -305,7 +335,7 @@ func WithTagCommand() CliOption {
 					output = strings.Join(paths, "\n")
 				} else {
 
-					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(tags)
+					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(tags, selector))
 					if err != nil {
 						return EntityMarshallingError{Inner: err}
 					}
@@ -350,7 +380,7 @@ func WithTagCommand() CliOption {
 
 					//********************       Use filter      *******************//
 				} else {
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -389,7 +419,17 @@ func WithTagCommand() CliOption {
 				var result *domain.Tag
 				var output string
 
-				tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+				selector, err := ParseMemberSelector(cli.FieldsRaw, domain.TagFieldsList)
+				if err != nil {
+					return err
+				}
+
+				// Paths are built from a fixed set of fields
+				if cli.PathFormat || cli.ShortFormat {
+					selector = domain.TagMemberSelector{"Tag", "ParentPathIDs"}
+				}
+
+				tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 				if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 					filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -400,7 +440,7 @@ func WithTagCommand() CliOption {
 					}
 				}
 
-				result, err = cli.BNTPBackend.TagManager.GetFirstWhere(context.Background(), filter)
+				result, err = cli.BNTPBackend.TagManager.GetFirstWhereSelected(context.Background(), filter, selector)
 				if err != nil {
 					return err
 				}
@@ -412,7 +452,7 @@ func WithTagCommand() CliOption {
 					}
 				} else {
 
-					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(result)
+					output, err = cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(SelectMembers(result, selector))
 					if err != nil {
 						return EntityMarshallingError{Inner: err}
 					}
@@ -441,7 +481,7 @@ func WithTagCommand() CliOption {
 					}
 				} else {
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -499,7 +539,7 @@ func WithTagCommand() CliOption {
 					}
 				} else {
 
-					tmp := hashmap.NewFromMap(domain.PredefinedBookmarkFilters)
+					tmp := hashmap.NewFromMap(domain.PredefinedTagFilters)
 
 					if _, err := goaoi.FindIfSlice(tmp.GetKeys(), functional.AreEqualPartial(cli.FilterRaw)); err == nil {
 						filter = domain.PredefinedTagFilters[cli.FilterRaw]
@@ -530,14 +570,104 @@ func WithTagCommand() CliOption {
 		cli.TagShortCmd = &cobra.Command{
 			Use:   "short TAG...",
 			Short: "Return shortened bntp tags",
-			Long:  `A longer description`,
+			Long:  `Return the shortest ends of the paths of bntp tags, which still identify them, e.g. "generics" for "lang::go::generics".`,
 			Args:  cobra.ArbitraryArgs,
 			RunE: func(cmd *cobra.Command, args []string) error {
 				if len(args) == 0 {
 					return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
 				}
 
-				panic("not implemented")
+				tags, err := cli.BNTPBackend.TagsFromPaths(context.Background(), args)
+				if err != nil {
+					return err
+				}
+
+				paths, err := goaoi.TransformCopySlice(tags, func(tag *domain.Tag) (string, error) {
+					return cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, true)
+				})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), strings.Join(paths, "\n"))
+
+				return nil
+			},
+		}
+
+		cli.TagRenameCmd = &cobra.Command{
+			Use:   "rename TAG NEW_NAME",
+			Short: "Rename a bntp tag",
+			Long: `Rename a bntp tag, changing the paths of its descendants as well.
+The "# Tags" lines of documents tagged with the renamed tags are rewritten,
+the number of documents and bookmarks tagged with them is output.`,
+			Args: cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				numAffectedRecordsRaw, err := cli.BNTPBackend.RenameTag(context.Background(), args[0], args[1])
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.InFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+				return nil
+			},
+		}
+
+		cli.TagMoveCmd = &cobra.Command{
+			Use:   "move TAG [NEW_PARENT]",
+			Short: "Move a bntp tag and its descendants below another tag",
+			Long: `Move a bntp tag and its descendants below another tag, or make it a root tag if no new parent is given.
+The "# Tags" lines of documents tagged with the moved tags are rewritten,
+the number of documents and bookmarks tagged with them is output.`,
+			Args: cobra.RangeArgs(1, 2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var newParentPath string
+				if len(args) == 2 {
+					newParentPath = args[1]
+				}
+
+				numAffectedRecordsRaw, err := cli.BNTPBackend.MoveTag(context.Background(), args[0], newParentPath)
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.InFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+				return nil
+			},
+		}
+
+		cli.TagMergeCmd = &cobra.Command{
+			Use:   "merge SOURCE DESTINATION",
+			Short: "Merge a bntp tag into another one",
+			Long: `Merge a bntp tag into another one, which replaces it on all documents and bookmarks.
+The children of the source tag are moved below the destination tag and merged with its children of the same name.
+The "# Tags" lines of documents tagged with the merged or moved tags are rewritten,
+the number of documents and bookmarks tagged with them is output.`,
+			Args: cobra.ExactArgs(2),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				numAffectedRecordsRaw, err := cli.BNTPBackend.MergeTag(context.Background(), args[0], args[1])
+				if err != nil {
+					return err
+				}
+
+				numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.InFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+				if err != nil {
+					return EntityMarshallingError{Inner: err}
+				}
+
+				fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
 
 				return nil
 			},
@@ -558,10 +688,13 @@ func WithTagCommand() CliOption {
 		cli.TagCmd.AddCommand(cli.TagFindCmd)
 		cli.TagCmd.AddCommand(cli.TagDoesExistCmd)
 		cli.TagCmd.AddCommand(cli.TagAddCmd)
+		cli.TagCmd.AddCommand(cli.TagRenameCmd)
+		cli.TagCmd.AddCommand(cli.TagMoveCmd)
+		cli.TagCmd.AddCommand(cli.TagMergeCmd)
 
 		for _, subcommand := range cli.TagCmd.Commands() {
 			// TODO: Should this flag be used for every command?
-			if slices.Contains([]*cobra.Command{cli.TagAddCmd, cli.TagListCmd, cli.TagRemoveCmd, cli.TagFindCmd, cli.TagDoesExistCmd}, subcommand) {
+			if slices.Contains([]*cobra.Command{cli.TagAddCmd, cli.TagListCmd, cli.TagRemoveCmd, cli.TagFindCmd, cli.TagDoesExistCmd, cli.TagRenameCmd, cli.TagMoveCmd, cli.TagMergeCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.InFormat, "out-format", "json", "The serialization format to use for reading input")
 				subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
 			}
@@ -573,12 +706,25 @@ func WithTagCommand() CliOption {
 			}
 		}
 
+		cli.TagListCmd.PersistentFlags().StringVar(&cli.SortRaw, "sort", "", "The comma separated fields to sort by, each optionally suffixed with :asc or :desc")
+		cli.TagListCmd.PersistentFlags().Int64Var(&cli.Limit, "limit", 0, "The maximum number of entities to list, 0 lists all")
+		cli.TagListCmd.PersistentFlags().Int64Var(&cli.Cursor, "cursor", 0, "The number of entities to skip, the cursor of the next page is the current cursor plus the limit")
+
+		for _, subcommand := range cli.TagCmd.Commands() {
+			if slices.Contains([]*cobra.Command{cli.TagListCmd, cli.TagFindCmd}, subcommand) {
+				subcommand.PersistentFlags().StringVar(&cli.FieldsRaw, "fields", "", "The comma separated fields to load and output, all fields are used if empty")
+			}
+		}
+
 		for _, subcommand := range cli.TagCmd.Commands() {
 			if slices.Contains([]*cobra.Command{cli.TagEditCmd}, subcommand) {
 				subcommand.PersistentFlags().StringVar(&cli.UpdaterRaw, "updater", "", "The updater to use for processing entities")
 			}
 		}
 
+		cli.TagAddCmd.PersistentFlags().BoolVar(&cli.PathFormat, "path-format", false, "Whetever to read tags in path format instead of --out-format format, missing parents are added as well and existing tags are kept")
+		cli.TagAddCmd.MarkFlagsMutuallyExclusive("path-format", "out-format")
+
 		cli.TagFindCmd.MarkPersistentFlagRequired("filter")
 		cli.TagEditCmd.MarkPersistentFlagRequired("updater")
 
@@ -0,0 +1,260 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdTagHierarchy(t *testing.T) {
+	oldTags := []*domain.Tag{
+		{ID: 1, Tag: "foo", SubtagIDs: []int64{2}},
+		{ID: 2, Tag: "bar", ParentPathIDs: []int64{1}, SubtagIDs: []int64{3}},
+		{ID: 3, Tag: "baz", ParentPathIDs: []int64{1, 2}},
+		{ID: 4, Tag: "golang", SubtagIDs: []int64{5, 9}},
+		{ID: 5, Tag: "tools", ParentPathIDs: []int64{4}},
+		{ID: 6, Tag: "go", SubtagIDs: []int64{7}},
+		{ID: 7, Tag: "tools", ParentPathIDs: []int64{6}},
+		{ID: 8, Tag: "programming"},
+		{ID: 9, Tag: "generics", ParentPathIDs: []int64{4}},
+	}
+	oldDocuments := []*domain.Document{
+		{ID: 1, Path: "notes/foo.md", TagIDs: []int64{2}},
+		{ID: 2, Path: "notes/bar.md", TagIDs: []int64{3, 4}},
+		{ID: 3, Path: "notes/baz.md", TagIDs: []int64{5, 7}},
+	}
+	oldContents := map[string]string{
+		"notes/foo.md": "# Tags\nfoo::bar\n# Links\n# Backlinks",
+		"notes/bar.md": "# Tags\nfoo::bar::baz,golang\n# Links\n# Backlinks",
+		"notes/baz.md": "# Tags\ngolang::tools,go::tools\n# Links\n# Backlinks",
+	}
+
+	tests := []struct {
+		err                    error
+		errorMatcher           testCommon.OutputValidator
+		name                   string
+		args                   []string
+		expectedTagPaths       []string
+		expectedDocumentTagIDs map[int64][]int64
+		expectedBookmarkTagIDs []int64
+		expectedContents       map[string]string
+		outputValidator        testCommon.OutputValidator
+		errorValidator         testCommon.OutputValidator
+	}{
+		{
+			name:            "Rename unknown tag",
+			args:            []string{"tag", "rename", "foo::qux", "quux"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("No tag with path"),
+			err:             libtags.UnknownTagPathError{},
+		},
+		{
+			name:            "Rename to path",
+			args:            []string{"tag", "rename", "foo::bar", "qux::quux"},
+			outputValidator: testCommon.ValidatorEmpty,
+			err:             libtags.InvalidTagNameError{},
+		},
+		{
+			name:            "Rename to existing tag",
+			args:            []string{"tag", "rename", "golang", "go"},
+			outputValidator: testCommon.ValidatorEmpty,
+			err:             helper.DuplicateInsertionError{},
+		},
+		{
+			name:             "Rename",
+			args:             []string{"tag", "rename", "foo::bar", "qux"},
+			expectedTagPaths: []string{"foo", "foo::qux", "foo::qux::baz", "golang", "golang::tools", "go", "go::tools", "programming", "golang::generics"},
+			expectedContents: map[string]string{
+				"notes/foo.md": "# Tags\nfoo::qux\n# Links\n# Backlinks",
+				"notes/bar.md": "# Tags\nfoo::qux::baz,golang\n# Links\n# Backlinks",
+				"notes/baz.md": "# Tags\ngolang::tools,go::tools\n# Links\n# Backlinks",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Move below descendant",
+			args:            []string{"tag", "move", "foo", "foo::bar::baz"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("cannot be placed below itself"),
+			err:             libtags.InvalidTagParentError{},
+		},
+		{
+			name:            "Move below current parent",
+			args:            []string{"tag", "move", "foo::bar", "foo"},
+			outputValidator: testCommon.ValidatorEmpty,
+			err:             helper.IneffectiveOperationError{},
+		},
+		{
+			name:             "Move",
+			args:             []string{"tag", "move", "foo::bar", "programming"},
+			expectedTagPaths: []string{"foo", "programming::bar", "programming::bar::baz", "golang", "golang::tools", "go", "go::tools", "programming", "golang::generics"},
+			expectedContents: map[string]string{
+				"notes/foo.md": "# Tags\nprogramming::bar\n# Links\n# Backlinks",
+				"notes/bar.md": "# Tags\nprogramming::bar::baz,golang\n# Links\n# Backlinks",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:             "Move to root",
+			args:             []string{"tag", "move", "golang::tools"},
+			expectedTagPaths: []string{"foo", "foo::bar", "foo::bar::baz", "golang", "tools", "go", "go::tools", "programming", "golang::generics"},
+			expectedContents: map[string]string{
+				"notes/baz.md": "# Tags\ntools,go::tools\n# Links\n# Backlinks",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:            "Merge into descendant",
+			args:            []string{"tag", "merge", "foo", "foo::bar"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("cannot be placed below itself"),
+			err:             libtags.InvalidTagParentError{},
+		},
+		{
+			name:                   "Merge",
+			args:                   []string{"tag", "merge", "golang", "go"},
+			expectedTagPaths:       []string{"foo", "foo::bar", "foo::bar::baz", "go", "go::tools", "programming", "go::generics"},
+			expectedDocumentTagIDs: map[int64][]int64{1: {2}, 2: {3, 6}, 3: {7}},
+			expectedBookmarkTagIDs: []int64{6},
+			expectedContents: map[string]string{
+				"notes/foo.md": "# Tags\nfoo::bar\n# Links\n# Backlinks",
+				"notes/bar.md": "# Tags\nfoo::bar::baz,go\n# Links\n# Backlinks",
+				"notes/baz.md": "# Tags\ngo::tools\n# Links\n# Backlinks",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{3}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:             "Merge into parent",
+			args:             []string{"tag", "merge", "foo::bar", "foo"},
+			expectedTagPaths: []string{"foo", "foo::baz", "golang", "golang::tools", "go", "go::tools", "programming", "golang::generics"},
+			expectedContents: map[string]string{
+				"notes/foo.md": "# Tags\nfoo\n# Links\n# Backlinks",
+				"notes/bar.md": "# Tags\nfoo::baz,golang\n# Links\n# Backlinks",
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			for _, subcommand := range cli.TagCmd.Commands() {
+				subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+					tags := make([]*domain.Tag, 0, len(oldTags))
+					for _, tag := range oldTags {
+						tag := *tag
+						tags = append(tags, &tag)
+					}
+
+					err = cli.BNTPBackend.TagManager.Add(context.Background(), tags)
+					assert.NoError(t, err, test.name+", assert adding old tags")
+
+					documents := make([]*domain.Document, 0, len(oldDocuments))
+					for _, document := range oldDocuments {
+						document := *document
+						documents = append(documents, &document)
+					}
+
+					err = cli.BNTPBackend.DocumentManager.Add(context.Background(), documents)
+					assert.NoError(t, err, test.name+", assert adding old documents")
+
+					err = cli.BNTPBackend.BookmarkManager.Add(context.Background(), []*domain.Bookmark{{ID: 1, URL: "example.com", TagIDs: []int64{4}}})
+					assert.NoError(t, err, test.name+", assert adding old bookmarks")
+
+					for path, content := range oldContents {
+						err = afero.WriteFile(fs, path, []byte(content), 0o644)
+						assert.NoError(t, err, test.name+", assert writing old document contents")
+					}
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+
+			if test.expectedTagPaths != nil {
+				tags, err := cli.BNTPBackend.TagManager.GetAll(context.Background())
+				assert.NoError(t, err, test.name+", assert getting tags")
+
+				tagPaths := make([]string, 0, len(tags))
+				for _, tag := range tags {
+					tagPath, err := cli.BNTPBackend.TagManager.MarshalPath(context.Background(), tag, false)
+					assert.NoError(t, err, test.name+", assert marshalling tag path")
+
+					tagPaths = append(tagPaths, tagPath)
+
+					for _, subtagID := range tag.SubtagIDs {
+						subtags, err := cli.BNTPBackend.TagManager.GetFromIDs(context.Background(), []int64{subtagID})
+						assert.NoError(t, err, test.name+", assert getting subtag")
+						assert.Equal(t, tag.ID, subtags[0].ParentPathIDs[len(subtags[0].ParentPathIDs)-1], test.name+", assert subtag is child of tag")
+					}
+				}
+
+				assert.ElementsMatch(t, test.expectedTagPaths, tagPaths, test.name+", assert tag paths match")
+			}
+
+			for id, expectedTagIDs := range test.expectedDocumentTagIDs {
+				documents, err := cli.BNTPBackend.DocumentManager.GetFromIDs(context.Background(), []int64{id})
+				assert.NoError(t, err, test.name+", assert getting document")
+				assert.ElementsMatch(t, expectedTagIDs, documents[0].TagIDs, test.name+", assert document tags match")
+			}
+
+			if test.expectedBookmarkTagIDs != nil {
+				bookmarks, err := cli.BNTPBackend.BookmarkManager.GetFromIDs(context.Background(), []int64{1})
+				assert.NoError(t, err, test.name+", assert getting bookmark")
+				assert.ElementsMatch(t, test.expectedBookmarkTagIDs, bookmarks[0].TagIDs, test.name+", assert bookmark tags match")
+			}
+
+			for path, expectedContent := range test.expectedContents {
+				content, err := afero.ReadFile(fs, path)
+				assert.NoError(t, err, test.name+", assert reading document contents")
+				assert.Equal(t, expectedContent, string(content), test.name+", assert document contents match")
+			}
+		})
+	}
+}
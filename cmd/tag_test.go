@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
 	"github.com/JonasMuehlmann/bntp.go/cmd"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/bntp.go/model"
@@ -59,6 +60,30 @@ func TestCmdTagAdd(t *testing.T) {
 			outputValidator: testCommon.ValidatorEmpty,
 			errorValidator:  testCommon.ValidatorEmpty,
 		},
+		{
+			name: "Good paths",
+			args: []string{
+				"tag",
+				"add",
+				"--path-format",
+				"lang::go::generics",
+				"lang::rust",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Existing path",
+			args: []string{
+				"tag",
+				"add",
+				"--path-format",
+				"lang::go",
+				"lang",
+			},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
 		// FIX: This actually tests the manager, not the cli
 		{
 			name: "Add duplicate",
@@ -1355,3 +1380,81 @@ func TestCmdTagDoesExist(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdTagShort(t *testing.T) {
+	tests := []struct {
+		err             error
+		errorMatcher    testCommon.OutputValidator
+		name            string
+		args            []string
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name:            "No args",
+			args:            []string{"tag", "short"},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name:            "Ambiguous tag",
+			args:            []string{"tag", "short", "tools"},
+			err:             libtags.AmbiguousTagPathError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("ambiguous"),
+		},
+		{
+			name:            "Good args",
+			args:            []string{"tag", "short", "lang::go::generics", "go::tools", "editor::tools", "lang"},
+			outputValidator: testCommon.ValidatorEqual("generics\ngo::tools\neditor::tools\nlang\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			cli.TagShortCmd.PreRun = func(_ *cobra.Command, _ []string) {
+				for _, path := range []string{"lang::go::generics", "lang::go::tools", "editor::tools"} {
+					_, err = cli.BNTPBackend.TagManager.AddPath(context.Background(), path)
+					assert.NoError(t, err, test.name+", assert adding tag paths")
+				}
+			}
+
+			err = cli.Execute()
+
+			stdout := outputBuffer.String()
+			stderr := errorBuffer.String()
+
+			if test.outputValidator != nil {
+				test.outputValidator(t, stdout, test.name+", assert stdout matches")
+			}
+			if test.errorValidator != nil {
+				test.errorValidator(t, stderr, test.name+", assert stderr matches")
+			}
+
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err, test.name+", assert test error matches expected")
+			} else if test.errorMatcher != nil {
+				test.errorMatcher(t, err.Error(), test.name+", assert error string matches")
+			} else {
+				assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+			}
+		})
+	}
+}
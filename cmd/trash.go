@@ -0,0 +1,185 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/cobra"
+)
+
+// trashOperations holds the entity specific parts of the trash subcommands of an entity's command.
+type trashOperations[TEntity any, TFilter any] struct {
+	// entities names the entities in help texts, e.g. "bookmarks", description adds what is trashed with them.
+	entities    string
+	description string
+
+	predefinedFilters map[string]*TFilter
+	setDeletedAt      func(filter *TFilter, deletedAt optional.Optional[model.FilterOperation[optional.Optional[time.Time]]])
+
+	// getTrashedWhere returns the trashed entities matching filter.
+	getTrashedWhere func(ctx context.Context, filter *TFilter) ([]*TEntity, error)
+	restore         func(ctx context.Context, entities []*TEntity) error
+	restoreWhere    func(ctx context.Context, filter *TFilter) (int64, error)
+	purge           func(ctx context.Context, entities []*TEntity) error
+	purgeWhere      func(ctx context.Context, filter *TFilter) (int64, error)
+}
+
+// newTrashCommands creates the trash command of an entity's command and its list, restore and purge subcommands.
+func newTrashCommands[TEntity any, TFilter any](cli *Cli, operations trashOperations[TEntity, TFilter]) (trashCmd *cobra.Command, listCmd *cobra.Command, restoreCmd *cobra.Command, purgeCmd *cobra.Command) {
+	trashCmd = &cobra.Command{
+		Use:   "trash",
+		Short: "Manage removed " + operations.description,
+		Long:  `A longer description`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+			}
+
+			return nil
+		},
+	}
+
+	listCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List removed bntp " + operations.entities,
+		Long:  `A longer description`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filter, err := newTrashFilterFromFlags(cli, operations)
+			if err != nil {
+				return err
+			}
+
+			entities, err := operations.getTrashedWhere(context.Background(), filter)
+			if err != nil {
+				return err
+			}
+
+			output, err := cli.BNTPBackend.Marshallers[cli.OutFormat].Marshall(entities)
+			if err != nil {
+				return EntityMarshallingError{Inner: err}
+			}
+
+			fmt.Fprintln(cli.RootCmd.OutOrStdout(), output)
+
+			return nil
+		},
+	}
+
+	restoreCmd = &cobra.Command{
+		Use:   "restore [MODEL...]",
+		Short: "Restore removed " + operations.description,
+		Long:  `A longer description`,
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && cli.FilterRaw == "" && cli.OlderThan == 0 {
+				return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+			}
+
+			return runTrashOperation(cli, args, operations, operations.restore, operations.restoreWhere)
+		},
+	}
+
+	purgeCmd = &cobra.Command{
+		Use:   "purge [MODEL...]",
+		Short: "Permanently delete removed " + operations.description + ", the whole trash is purged if no " + operations.entities + " or filters are given",
+		Long:  `A longer description`,
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrashOperation(cli, args, operations, operations.purge, operations.purgeWhere)
+		},
+	}
+
+	trashCmd.AddCommand(listCmd)
+	trashCmd.AddCommand(restoreCmd)
+	trashCmd.AddCommand(purgeCmd)
+
+	for _, subcommand := range trashCmd.Commands() {
+		subcommand.PersistentFlags().StringVar(&cli.InFormat, "out-format", "json", "The serialization format to use for reading input")
+		subcommand.PersistentFlags().StringVar(&cli.OutFormat, "in-format", "json", "The serialization format to use for writing output")
+		subcommand.PersistentFlags().StringVar(&cli.FilterRaw, "filter", "", "The filter to use for processing entities")
+		subcommand.PersistentFlags().DurationVar(&cli.OlderThan, "older-than", 0, "Only process entities removed longer ago than this duration, e.g. 720h")
+	}
+
+	return
+}
+
+// runTrashOperation applies operation to the entities in args or operationWhere to the filter from the flags if args is empty.
+func runTrashOperation[TEntity any, TFilter any](cli *Cli, args []string, operations trashOperations[TEntity, TFilter], operation func(ctx context.Context, entities []*TEntity) error, operationWhere func(ctx context.Context, filter *TFilter) (int64, error)) error {
+	if len(args) > 0 && (cli.FilterRaw != "" || cli.OlderThan != 0) {
+		return ConflictingPositionalArgsAndFlagError{Flag: "filter"}
+	}
+
+	var numAffectedRecordsRaw int64
+
+	if len(args) > 0 {
+		entities, err := UnmarshalEntities[TEntity](cli, args, cli.InFormat)
+		if err != nil {
+			return err
+		}
+
+		err = operation(context.Background(), entities)
+		if err != nil {
+			return err
+		}
+
+		numAffectedRecordsRaw = int64(len(args))
+	} else {
+		filter, err := newTrashFilterFromFlags(cli, operations)
+		if err != nil {
+			return err
+		}
+
+		numAffectedRecordsRaw, err = operationWhere(context.Background(), filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	numAffectedRecords, err := cli.BNTPBackend.Marshallers[cli.InFormat].Marshall(NumAffectedRecords{numAffectedRecordsRaw})
+	if err != nil {
+		return EntityMarshallingError{Inner: err}
+	}
+
+	fmt.Fprintln(cli.RootCmd.OutOrStdout(), numAffectedRecords)
+
+	return nil
+}
+
+// newTrashFilterFromFlags creates a filter from the --filter and --older-than flags.
+func newTrashFilterFromFlags[TEntity any, TFilter any](cli *Cli, operations trashOperations[TEntity, TFilter]) (*TFilter, error) {
+	filter, err := NewFilterFromFlags(cli, operations.predefinedFilters)
+	if err != nil {
+		return nil, err
+	}
+
+	if olderThanFilter := NewOlderThanFilterFromFlags(cli); olderThanFilter.HasValue {
+		operations.setDeletedAt(filter, olderThanFilter)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,319 @@
+package cmd_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/drop-return-values.go"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+// trashTestEntity holds the entity specific parts of the trash command tests.
+type trashTestEntity struct {
+	command  string
+	trashCmd func(cli *cmd.Cli) *cobra.Command
+	// entity serializes the entity with id, whose unique field is name, filter serializes a filter matching that entity.
+	entity func(id int64, name string) string
+	filter func(name string) string
+	// add adds entities with the IDs 1 to len(names) and moves the ones with trashedIDs to the trash.
+	add func(ctx context.Context, cli *cmd.Cli, names []string, trashedIDs []int64) error
+	// noMatchErrorValidator matches stderr after a filtered operation, which matches nothing.
+	// Filtered operations look the entities up first, which logs an error if none match.
+	noMatchErrorValidator testCommon.OutputValidator
+}
+
+var trashTestEntities = []trashTestEntity{
+	{
+		command:  "bookmark",
+		trashCmd: func(cli *cmd.Cli) *cobra.Command { return cli.BookmarkTrashCmd },
+		entity: func(id int64, name string) string {
+			return string(drop.From2To1(json.Marshal(domain.Bookmark{ID: id, URL: name})))
+		},
+		filter: func(name string) string {
+			return string(drop.From2To1(json.Marshal(domain.BookmarkFilter{URL: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: name}})})))
+		},
+		add: func(ctx context.Context, cli *cmd.Cli, names []string, trashedIDs []int64) error {
+			bookmarks := []*domain.Bookmark{}
+			for i, name := range names {
+				bookmarks = append(bookmarks, &domain.Bookmark{ID: int64(i + 1), URL: name})
+			}
+
+			err := cli.BNTPBackend.BookmarkManager.Add(ctx, bookmarks)
+			if err != nil {
+				return err
+			}
+
+			trashedBookmarks := []*domain.Bookmark{}
+			for _, id := range trashedIDs {
+				trashedBookmarks = append(trashedBookmarks, bookmarks[id-1])
+			}
+
+			return cli.BNTPBackend.BookmarkManager.Delete(ctx, trashedBookmarks)
+		},
+		noMatchErrorValidator: testCommon.ValidatorContains("does not exist"),
+	},
+	{
+		command:  "document",
+		trashCmd: func(cli *cmd.Cli) *cobra.Command { return cli.DocumentTrashCmd },
+		entity: func(id int64, name string) string {
+			return string(drop.From2To1(json.Marshal(domain.Document{ID: id, Path: name})))
+		},
+		filter: func(name string) string {
+			return string(drop.From2To1(json.Marshal(domain.DocumentFilter{Path: optional.Make(model.FilterOperation[string]{Operator: model.FilterEqual, Operand: model.ScalarOperand[string]{Operand: name}})})))
+		},
+		add: func(ctx context.Context, cli *cmd.Cli, names []string, trashedIDs []int64) error {
+			documents := []*domain.Document{}
+			for i, name := range names {
+				documents = append(documents, &domain.Document{ID: int64(i + 1), Path: name})
+			}
+
+			err := cli.BNTPBackend.DocumentManager.Add(ctx, documents)
+			if err != nil {
+				return err
+			}
+
+			trashedDocuments := []*domain.Document{}
+			for _, id := range trashedIDs {
+				trashedDocuments = append(trashedDocuments, documents[id-1])
+			}
+
+			return cli.BNTPBackend.TrashDocuments(ctx, trashedDocuments)
+		},
+		noMatchErrorValidator: testCommon.ValidatorContains("does not exist"),
+	},
+}
+
+func TestCmdTrash(t *testing.T) {
+	tests := []struct {
+		err          error
+		errorMatcher testCommon.OutputValidator
+		name         string
+		oldNames     []string
+		trashedIDs   []int64
+		args         func(entity trashTestEntity) []string
+		// matchesNothing uses the noMatchErrorValidator of the entity instead of errorValidator.
+		matchesNothing  bool
+		outputValidator testCommon.OutputValidator
+		errorValidator  testCommon.OutputValidator
+	}{
+		{
+			name:       "List trashed entities",
+			oldNames:   []string{"foo", "bar"},
+			trashedIDs: []int64{1},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "list"}
+			},
+			outputValidator: testCommon.ValidatorContains("foo"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name: "Restore without args",
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "restore"}
+			},
+			err:             helper.IneffectiveOperationError{},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("no effect"),
+		},
+		{
+			name: "Restore filter and model",
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "restore", entity.entity(1, "foo"), "--filter", entity.filter("foo")}
+			},
+			err:             cmd.ConflictingPositionalArgsAndFlagError{"filter"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("together with"),
+		},
+		{
+			name:       "Restore models",
+			oldNames:   []string{"foo", "bar"},
+			trashedIDs: []int64{1, 2},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "restore", entity.entity(1, "foo")}
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:       "Restore filter",
+			oldNames:   []string{"foo", "bar"},
+			trashedIDs: []int64{1, 2},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "restore", "--filter", entity.filter("foo")}
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:       "Restore filter matching nothing",
+			oldNames:   []string{"foo", "bar"},
+			trashedIDs: []int64{1},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "restore", "--filter", entity.filter("bar")}
+			},
+			matchesNothing:  true,
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{0}))) + "\n"),
+		},
+		{
+			name:       "Purge whole trash",
+			oldNames:   []string{"foo", "bar", "baz"},
+			trashedIDs: []int64{1, 2},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "purge"}
+			},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+			errorValidator:  testCommon.ValidatorEmpty,
+		},
+		{
+			name:       "Purge recently trashed entities",
+			oldNames:   []string{"foo", "bar"},
+			trashedIDs: []int64{1, 2},
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "purge", "--older-than", "24h"}
+			},
+			matchesNothing:  true,
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{0}))) + "\n"),
+		},
+		{
+			name: "Purge filter and model",
+			args: func(entity trashTestEntity) []string {
+				return []string{entity.command, "trash", "purge", entity.entity(1, "foo"), "--older-than", "24h"}
+			},
+			err:             cmd.ConflictingPositionalArgsAndFlagError{"filter"},
+			outputValidator: testCommon.ValidatorEmpty,
+			errorValidator:  testCommon.ValidatorContains("together with"),
+		},
+	}
+
+	for _, entity := range trashTestEntities {
+		for _, test := range tests {
+			entity := entity
+			test := test
+			name := entity.command + ": " + test.name
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+				defer testCommon.HandlePanic(t, name)
+				db, err := testCommon.GetDB()
+				assert.NoError(t, err, name+", assert db creation")
+
+				outputBuffer := testCommon.NewBufferString("")
+				errorBuffer := testCommon.NewBufferString("")
+				fs := afero.NewMemMapFs()
+				cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+				assert.NoError(t, err, name+", assert cli creation")
+				cli.RootCmd.SetOut(outputBuffer)
+
+				cli.RootCmd.SetArgs(test.args(entity))
+
+				if test.oldNames != nil {
+					for _, subcommand := range entity.trashCmd(cli).Commands() {
+						subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+							err = entity.add(context.Background(), cli, test.oldNames, test.trashedIDs)
+							assert.NoError(t, err, name+", assert adding and trashing old entities")
+						}
+					}
+				}
+
+				err = cli.Execute()
+
+				stdout := outputBuffer.String()
+				stderr := errorBuffer.String()
+
+				errorValidator := test.errorValidator
+				if test.matchesNothing {
+					errorValidator = entity.noMatchErrorValidator
+				}
+
+				if test.outputValidator != nil {
+					test.outputValidator(t, stdout, name+", assert stdout matches")
+				}
+				if errorValidator != nil {
+					errorValidator(t, stderr, name+", assert stderr matches")
+				}
+
+				if test.err != nil {
+					assert.ErrorIs(t, err, test.err, name+", assert test error matches expected")
+				} else if test.errorMatcher != nil {
+					test.errorMatcher(t, err.Error(), name+", assert error string matches")
+				} else {
+					assert.NoError(t, err, name+", assert test does not error unexpectedly")
+				}
+			})
+		}
+	}
+}
+
+func TestCmdBookmarkTrashWhereHooks(t *testing.T) {
+	tests := []struct {
+		name            string
+		args            []string
+		hookPoint       bntp.HookPoint
+		expectedURLs    []string
+		outputValidator testCommon.OutputValidator
+	}{
+		{
+			name:            "Restore filter",
+			args:            []string{"bookmark", "trash", "restore", "--filter", trashTestEntities[0].filter("foo")},
+			hookPoint:       bntp.AfterUpdateHook,
+			expectedURLs:    []string{"foo"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{1}))) + "\n"),
+		},
+		{
+			name:            "Purge whole trash",
+			args:            []string{"bookmark", "trash", "purge"},
+			hookPoint:       bntp.BeforeDeleteHook,
+			expectedURLs:    []string{"foo", "bar"},
+			outputValidator: testCommon.ValidatorEqual(string(drop.From2To1(json.Marshal(cmd.NumAffectedRecords{2}))) + "\n"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			outputBuffer := testCommon.NewBufferString("")
+			errorBuffer := testCommon.NewBufferString("")
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(afero.NewMemMapFs()), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+			cli.RootCmd.SetOut(outputBuffer)
+
+			cli.RootCmd.SetArgs(test.args)
+
+			hookedURLs := []string{}
+
+			for _, subcommand := range cli.BookmarkTrashCmd.Commands() {
+				subcommand.PreRun = func(_ *cobra.Command, _ []string) {
+					err = trashTestEntities[0].add(context.Background(), cli, []string{"foo", "bar", "baz"}, []int64{1, 2})
+					assert.NoError(t, err, test.name+", assert adding and trashing old bookmarks")
+
+					err = cli.BNTPBackend.BookmarkManager.Hooks.AddHook(test.hookPoint, func(_ context.Context, bookmark *domain.Bookmark) error {
+						hookedURLs = append(hookedURLs, bookmark.URL)
+
+						return nil
+					})
+					assert.NoError(t, err, test.name+", assert adding hook")
+				}
+			}
+
+			err = cli.Execute()
+			assert.NoError(t, err, test.name+", assert test does not error unexpectedly")
+
+			test.outputValidator(t, outputBuffer.String(), test.name+", assert stdout matches")
+			assert.ElementsMatch(t, test.expectedURLs, hookedURLs, test.name+", assert hooks ran for the matching bookmarks")
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package cmd
+
+import (
+	"os"
+	"os/signal"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/spf13/cobra"
+)
+
+func WithWatchCommand() CliOption {
+	return func(cli *Cli) (err error) {
+		cli.WatchCmd = &cobra.Command{
+			Use:   "watch DIRECTORY...",
+			Short: "Keep the documents in directories in sync with the database",
+			Long: `Keep the documents in directories in sync with the database.
+New Markdown files are added as documents, renamed ones get their path updated and deleted ones are moved to the trash.
+The tags and links of created and edited documents are synced with their "# Tags" line and links.
+Changes made while not watching are applied on startup, renames are then treated as a deletion and a creation.
+Later changes are applied once the directories stayed unchanged for the debounce period.`,
+			Args: cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				watcher, err := backend.NewDocumentWatcher(cli.BNTPBackend, cli.Fs, cli.Logger, args)
+				if err != nil {
+					return err
+				}
+
+				watcher.Interval = cli.WatchInterval
+				watcher.Debounce = cli.WatchDebounce
+
+				ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+				defer stop()
+
+				cli.Logger.Infof("Watching %v", args)
+
+				return watcher.Run(ctx)
+			},
+		}
+
+		cli.WatchCmd.Flags().DurationVar(&cli.WatchInterval, "interval", backend.DefaultWatchInterval, "The time between two scans of the directories")
+		cli.WatchCmd.Flags().DurationVar(&cli.WatchDebounce, "debounce", backend.DefaultWatchDebounce, "The time the directories have to stay unchanged before changes are applied")
+
+		cli.RootCmd.AddCommand(cli.WatchCmd)
+
+		return
+	}
+}
@@ -0,0 +1,244 @@
+package cmd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
+	"github.com/JonasMuehlmann/bntp.go/cmd"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocumentWatcher(t *testing.T) {
+	tests := []struct {
+		name               string
+		oldDocuments       []*domain.Document
+		oldContents        map[string]string
+		changeFs           func(fs afero.Fs) error
+		expectedDocuments  []*domain.Document
+		expectedTrashedIDs []int64
+	}{
+		{
+			name:         "Create document",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md"}},
+			oldContents:  map[string]string{"notes/foo.md": "# Tags\n# Links\n# Backlinks\n"},
+			changeFs: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, "notes/bar.md", []byte("# Tags\nfoo::bar\n# Links\n# Backlinks\n\nSee [foo](foo.md)"), 0o644)
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md", BacklinkedDocumentsIDs: []int64{2}},
+				{ID: 2, Path: "notes/bar.md", TagIDs: []int64{2}, LinkedDocumentIDs: []int64{1}},
+			},
+		},
+		{
+			name:         "Edit document",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md", TagIDs: []int64{1}}, {ID: 2, Path: "notes/bar.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\nfoo\n# Links\n# Backlinks\n",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks\n",
+			},
+			changeFs: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, "notes/foo.md", []byte("# Tags\nfoo::bar,unknown\n# Links\n- (notes/bar.md)[notes/bar.md]\n# Backlinks\n"), 0o644)
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md", TagIDs: []int64{2}, LinkedDocumentIDs: []int64{2}},
+				{ID: 2, Path: "notes/bar.md", BacklinkedDocumentsIDs: []int64{1}},
+			},
+		},
+		{
+			name:         "Edit document without tags header",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md", TagIDs: []int64{1}}, {ID: 2, Path: "notes/bar.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\nfoo\n# Links\n# Backlinks\n",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks\n",
+			},
+			changeFs: func(fs afero.Fs) error {
+				return afero.WriteFile(fs, "notes/foo.md", []byte("# Links\n- (notes/bar.md)[notes/bar.md]\n# Backlinks\n"), 0o644)
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md", TagIDs: []int64{1}, LinkedDocumentIDs: []int64{2}},
+				{ID: 2, Path: "notes/bar.md", BacklinkedDocumentsIDs: []int64{1}},
+			},
+		},
+		{
+			name:         "Rename document",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md", TagIDs: []int64{1}}},
+			oldContents:  map[string]string{"notes/foo.md": "# Tags\nfoo\n# Links\n# Backlinks\n"},
+			changeFs: func(fs afero.Fs) error {
+				return fs.Rename("notes/foo.md", "notes/baz.md")
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/baz.md", TagIDs: []int64{1}},
+			},
+		},
+		{
+			name:         "Delete document",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md"}, {ID: 2, Path: "notes/bar.md"}},
+			oldContents: map[string]string{
+				"notes/foo.md": "# Tags\n# Links\n# Backlinks\n",
+				"notes/bar.md": "# Tags\n# Links\n# Backlinks\n\nbar",
+			},
+			changeFs: func(fs afero.Fs) error {
+				return fs.Remove("notes/foo.md")
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 2, Path: "notes/bar.md"},
+			},
+			expectedTrashedIDs: []int64{1},
+		},
+		{
+			name:         "Ignore other files",
+			oldDocuments: []*domain.Document{{ID: 1, Path: "notes/foo.md"}},
+			oldContents:  map[string]string{"notes/foo.md": "# Tags\n# Links\n# Backlinks\n"},
+			changeFs: func(fs afero.Fs) error {
+				err := afero.WriteFile(fs, "notes/foo.txt", []byte("foo"), 0o644)
+				if err != nil {
+					return err
+				}
+
+				return afero.WriteFile(fs, "notes/.bntp_trash/bar.md", []byte("bar"), 0o644)
+			},
+			expectedDocuments: []*domain.Document{
+				{ID: 1, Path: "notes/foo.md"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+			db, err := testCommon.GetDB()
+			assert.NoError(t, err, test.name+", assert db creation")
+
+			errorBuffer := testCommon.NewBufferString("")
+			fs := afero.NewMemMapFs()
+			cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+			assert.NoError(t, err, test.name+", assert cli creation")
+
+			ctx := context.Background()
+
+			err = cli.BNTPBackend.TagManager.Add(ctx, []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+			assert.NoError(t, err, test.name+", assert adding tags")
+
+			err = cli.BNTPBackend.DocumentManager.Add(ctx, test.oldDocuments)
+			assert.NoError(t, err, test.name+", assert adding old documents")
+
+			for path, content := range test.oldContents {
+				err = afero.WriteFile(fs, path, []byte(content), 0o644)
+				assert.NoError(t, err, test.name+", assert writing old document contents")
+			}
+
+			watcher, err := backend.NewDocumentWatcher(cli.BNTPBackend, fs, cli.Logger, []string{"notes"})
+			assert.NoError(t, err, test.name+", assert watcher creation")
+
+			start := time.Now()
+
+			err = watcher.Poll(ctx, start)
+			assert.NoError(t, err, test.name+", assert initial poll")
+
+			err = test.changeFs(fs)
+			assert.NoError(t, err, test.name+", assert changing files")
+
+			err = watcher.Poll(ctx, start.Add(watcher.Interval))
+			assert.NoError(t, err, test.name+", assert poll during debounce period")
+
+			documents, err := cli.BNTPBackend.DocumentManager.GetAll(ctx)
+			assert.NoError(t, err, test.name+", assert getting documents during debounce period")
+			assert.Len(t, documents, len(test.oldDocuments), test.name+", assert changes are debounced")
+
+			err = watcher.Poll(ctx, start.Add(watcher.Interval+watcher.Debounce))
+			assert.NoError(t, err, test.name+", assert poll after debounce period")
+
+			documents, err = cli.BNTPBackend.DocumentManager.GetAll(ctx)
+			assert.NoError(t, err, test.name+", assert getting documents")
+
+			for _, document := range documents {
+				document.CreatedAt = time.Time{}
+				document.UpdatedAt = time.Time{}
+				document.DeletedAt = optional.Optional[time.Time]{}
+			}
+
+			assert.ElementsMatch(t, test.expectedDocuments, documents, test.name+", assert documents match")
+
+			if test.expectedTrashedIDs != nil {
+				trashed, err := cli.BNTPBackend.DocumentManager.GetWhere(ctx, libdocuments.TrashedFilter(&domain.DocumentFilter{}))
+				assert.NoError(t, err, test.name+", assert getting trashed documents")
+
+				trashedIDs := []int64{}
+				for _, document := range trashed {
+					trashedIDs = append(trashedIDs, document.ID)
+				}
+
+				assert.ElementsMatch(t, test.expectedTrashedIDs, trashedIDs, test.name+", assert trashed documents match")
+			}
+		})
+	}
+}
+
+func TestDocumentWatcherInitialPoll(t *testing.T) {
+	db, err := testCommon.GetDB()
+	assert.NoError(t, err, "assert db creation")
+
+	errorBuffer := testCommon.NewBufferString("")
+	fs := afero.NewMemMapFs()
+	cli, err := cmd.NewCli(cmd.WithStdErrOverride(errorBuffer), cmd.WithDbOverride(db), cmd.WithFsOverride(fs), cmd.WithAll())
+	assert.NoError(t, err, "assert cli creation")
+
+	ctx := context.Background()
+
+	err = cli.BNTPBackend.TagManager.Add(ctx, []*domain.Tag{{ID: 1, Tag: "foo", SubtagIDs: []int64{2}}, {ID: 2, Tag: "bar", ParentPathIDs: []int64{1}}})
+	assert.NoError(t, err, "assert adding tags")
+
+	err = cli.BNTPBackend.DocumentManager.Add(ctx, []*domain.Document{
+		{ID: 1, Path: "notes/foo.md", TagIDs: []int64{1}},
+		{ID: 2, Path: "notes/gone.md"},
+		{ID: 3, Path: "other/bar.md"},
+	})
+	assert.NoError(t, err, "assert adding old documents")
+
+	err = afero.WriteFile(fs, "notes/foo.md", []byte("# Tags\nfoo::bar\n# Links\n# Backlinks\n"), 0o644)
+	assert.NoError(t, err, "assert writing edited document")
+
+	err = afero.WriteFile(fs, "notes/new.md", []byte("# Tags\n# Links\n# Backlinks\n\nSee [foo](foo.md)"), 0o644)
+	assert.NoError(t, err, "assert writing unknown document")
+
+	watcher, err := backend.NewDocumentWatcher(cli.BNTPBackend, fs, cli.Logger, []string{"notes"})
+	assert.NoError(t, err, "assert watcher creation")
+
+	err = watcher.Poll(ctx, time.Now())
+	assert.NoError(t, err, "assert initial poll")
+
+	documents, err := cli.BNTPBackend.DocumentManager.GetAll(ctx)
+	assert.NoError(t, err, "assert getting documents")
+
+	for _, document := range documents {
+		document.CreatedAt = time.Time{}
+		document.UpdatedAt = time.Time{}
+		document.DeletedAt = optional.Optional[time.Time]{}
+	}
+
+	expectedDocuments := []*domain.Document{
+		{ID: 1, Path: "notes/foo.md", TagIDs: []int64{2}, BacklinkedDocumentsIDs: []int64{4}},
+		{ID: 3, Path: "other/bar.md"},
+		{ID: 4, Path: "notes/new.md", LinkedDocumentIDs: []int64{1}},
+	}
+	assert.ElementsMatch(t, expectedDocuments, documents, "assert documents match")
+
+	trashed, err := cli.BNTPBackend.DocumentManager.GetWhere(ctx, libdocuments.TrashedFilter(&domain.DocumentFilter{}))
+	assert.NoError(t, err, "assert getting trashed documents")
+
+	trashedIDs := []int64{}
+	for _, document := range trashed {
+		trashedIDs = append(trashedIDs, document.ID)
+	}
+
+	assert.ElementsMatch(t, []int64{2}, trashedIDs, "assert trashed documents match")
+}
@@ -7,8 +7,11 @@ go run ./tools/schema_converter
 go run ./tools/generate_external_cli_documentation
 go run ./tools/generate_domain_models
 go run ./tools/generate_repository_interfaces
+go run ./tools/generate_plugin_repositories
 go run ./tools/generate_sql_repositories
 
+protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative bntp/server/bntppb/bntp.proto
+
 cp templates/*_test.go model/repository/sqlite3/
 # cp templates/*_test.go model/repository/mssql/
 # cp templates/*_test.go model/repository/mysql/
@@ -21,6 +21,11 @@
 package config
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
 	"github.com/go-playground/validator/v10"
 	"github.com/sirupsen/logrus"
 )
@@ -50,6 +55,13 @@ type Config struct {
 	FileLogLevel    string        `name:"file_log_level" mapstructure:"file_log_level" validate:"required,logrus_log_level"`
 	DB              DBConfig      `name:"db" mapstructure:"db" validate:"required"`
 	Backend         BackendConfig `name:"backend" mapstructure:"backend" validate:"required"`
+	// Plugins are loaded by their name, which is used to reference them in the rest of the config.
+	Plugins map[string]PluginConfig `name:"plugins" mapstructure:"plugins" validate:"dive"`
+}
+
+type PluginConfig struct {
+	Path string   `name:"path" mapstructure:"path" validate:"required,file"`
+	Args []string `name:"args" mapstructure:"args"`
 }
 
 type DBConfig struct {
@@ -69,26 +81,49 @@ type BackendConfig struct {
 //                        Repository configs                         //
 // ******************************************************************//
 
+// Repositories are provided by the plugin called Plugin if it is set.
+
 type BookmarkRepositoryConfig struct {
 	DB            DBConfig             `name:"db" mapstructure:"db" validate:"required"`
 	TagRepository TagsRepositoryConfig `name:"tag_repository" mapstructure:"tag_repository" validate:"required"`
+	Plugin        string               `name:"plugin" mapstructure:"plugin"`
 }
 
 type TagsRepositoryConfig struct {
-	DB DBConfig `name:"db" mapstructure:"db" validate:"required"`
+	DB     DBConfig `name:"db" mapstructure:"db" validate:"required"`
+	Plugin string   `name:"plugin" mapstructure:"plugin"`
 }
 
 type DocumentRepositoryConfig struct {
 	DB            DBConfig             `name:"db" mapstructure:"db" validate:"required"`
 	TagRepository TagsRepositoryConfig `name:"tag_repository" mapstructure:"tag_repository" validate:"required"`
+	Plugin        string               `name:"plugin" mapstructure:"plugin"`
 }
 
 type DocumentContentRepositoryConfig struct {
-	DB DBConfig `name:"db" mapstructure:"db" validate:"required"`
+	DB     DBConfig `name:"db" mapstructure:"db" validate:"required"`
+	Plugin string   `name:"plugin" mapstructure:"plugin"`
+	// TrashDir is the directory contents of trashed documents are moved to.
+	TrashDir string `name:"trash_dir" mapstructure:"trash_dir"`
 }
 
 type HooksConfig struct {
-	HookPoints map[string][]string `name:"hooks" mapstructure:"hooks" validate:"dive,keys,hook_point,endkeys,dive,hook"`
+	HookPoints map[string][]string `name:"hooks" mapstructure:"hooks" validate:"dive,keys,hook_point,endkeys"`
+	// Commands defines hooks running external programs, they can be attached to hook points by their name.
+	Commands map[string]CommandHookConfig `name:"commands" mapstructure:"commands" validate:"dive"`
+}
+
+type CommandHookConfig struct {
+	Command []string      `name:"command" mapstructure:"command" validate:"required,min=1"`
+	Timeout time.Duration `name:"timeout" mapstructure:"timeout" validate:"min=0"`
+	Format  string        `name:"format" mapstructure:"format" validate:"omitempty,oneof=json yaml"`
+}
+
+// CacheConfig configures the cache of a manager, MaxSize and TTL are not limited if they are 0.
+type CacheConfig struct {
+	Enabled bool          `name:"enabled" mapstructure:"enabled"`
+	MaxSize int           `name:"max_size" mapstructure:"max_size" validate:"min=0"`
+	TTL     time.Duration `name:"ttl" mapstructure:"ttl" validate:"min=0"`
 }
 
 // ******************************************************************//
@@ -98,16 +133,19 @@ type HooksConfig struct {
 type BookmarkManagerConfig struct {
 	Hooks              HooksConfig              `name:"hooks" mapstructure:"hooks"`
 	BookmarkRepository BookmarkRepositoryConfig `name:"bookmark_repository" mapstructure:"bookmark_repository" validate:"required,bookmark_repository"`
+	Cache              CacheConfig              `name:"cache" mapstructure:"cache"`
 }
 
 type TagsManagerConfig struct {
 	Hooks          HooksConfig          `name:"hooks" mapstructure:"hooks"`
 	TagsRepository TagsRepositoryConfig `name:"tags_repository" mapstructure:"tags_repository" validate:"required,tags_repository"`
+	Cache          CacheConfig          `name:"cache" mapstructure:"cache"`
 }
 
 type DocumentManagerConfig struct {
 	Hooks              HooksConfig              `name:"hooks" mapstructure:"hooks"`
 	DocumentRepository DocumentRepositoryConfig `name:"document_repository" mapstructure:"document_repository" validate:"required,document_repository"`
+	Cache              CacheConfig              `name:"cache" mapstructure:"cache"`
 }
 
 type DocumentContentManagerConfig struct {
@@ -171,10 +209,7 @@ func init() {
 		panic(err)
 	}
 
-	err = ConfigValidator.RegisterValidation(ValidatorHook, validate_hook)
-	if err != nil {
-		panic(err)
-	}
+	ConfigValidator.RegisterStructValidation(validate_hooks, HooksConfig{})
 }
 
 // ******************************************************************//
@@ -191,16 +226,28 @@ func validateDBDriver(field validator.FieldLevel) bool {
 	return field.Field().String() == "sqlite3"
 }
 
-func validate_hook(field validator.FieldLevel) bool {
-	// check if name in predefined or registered hooks
+// validate_hooks checks that attached hooks are either registered or defined as commands.
+func validate_hooks(structLevel validator.StructLevel) {
+	hooksConfig, ok := structLevel.Current().Interface().(HooksConfig)
+	if !ok {
+		return
+	}
+
+	for hookPoint, hookNames := range hooksConfig.HookPoints {
+		for i, hookName := range hookNames {
+			if _, ok := hooksConfig.Commands[hookName]; ok || backend.IsHookRegistered(hookName) {
+				continue
+			}
 
-	return false
+			structLevel.ReportError(hookName, fmt.Sprintf("HookPoints[%s][%d]", hookPoint, i), "HookPoints", ValidatorHook, "")
+		}
+	}
 }
 
 func validate_hook_point(field validator.FieldLevel) bool {
-	// Check if name in hook points enum
+	_, err := bntp.HookPointFromString(field.Field().String())
 
-	return false
+	return err == nil
 }
 
 // ********************    Manager validators    ********************//
@@ -11,4 +11,21 @@ const (
 	DB_DataSource   = DB + ".data_source"
 	DB_Args         = DB + ".args"
 	Backend         = "backend"
+	Plugins         = "plugins"
+
+	BookmarkManagerHooks        = Backend + ".bookmark_manager.hooks"
+	TagsManagerHooks            = Backend + ".tags_manager.hooks"
+	DocumentManagerHooks        = Backend + ".document_manager.hooks"
+	DocumentContentManagerHooks = Backend + ".document_content_manager.hooks"
+
+	BookmarkManagerCache = Backend + ".bookmark_manager.cache"
+	TagsManagerCache     = Backend + ".tags_manager.cache"
+	DocumentManagerCache = Backend + ".document_manager.cache"
+
+	BookmarkRepositoryPlugin        = Backend + ".bookmark_manager.bookmark_repository.plugin"
+	TagsRepositoryPlugin            = Backend + ".tags_manager.tags_repository.plugin"
+	DocumentRepositoryPlugin        = Backend + ".document_manager.document_repository.plugin"
+	DocumentContentRepositoryPlugin = Backend + ".document_content_manager.document_content_repository.plugin"
+
+	DocumentContentRepositoryTrashDir = Backend + ".document_content_manager.document_content_repository.trash_dir"
 )
@@ -30,14 +30,17 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/JonasMuehlmann/bntp.go/bntp"
 	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libbookmarks"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libdocuments"
 	"github.com/JonasMuehlmann/bntp.go/bntp/libtags"
+	"github.com/JonasMuehlmann/bntp.go/bntp/plugin"
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	"github.com/JonasMuehlmann/bntp.go/internal/marshallers"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
 	"github.com/JonasMuehlmann/bntp.go/model/repository"
 	"github.com/JonasMuehlmann/goaoi"
 	"github.com/JonasMuehlmann/goaoi/functional"
@@ -46,8 +49,9 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 	"github.com/stoewer/go-strcase"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
-	"github.com/JonasMuehlmann/bntp.go/model/domain"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
 
@@ -69,6 +73,7 @@ type ConfigManager struct {
 	FSOverride        afero.Fs
 	PendingLogMessage []message
 	Logger            *log.Logger
+	PluginHost        *plugin.Host
 
 	PassedConfigPath   string
 	ConfigDir          string
@@ -201,6 +206,17 @@ func NewConfigManager(stderr io.Writer, dbOverride *sql.DB, fsOverride afero.Fs)
 		return
 	}
 
+	//**********************    Load plugins    ************************//
+	// Plugins provide hooks, so they have to be loaded before validating
+	m.PluginHost = plugin.NewHost(stderr)
+
+	for name, pluginConfig := range config.Plugins {
+		loadErr := m.PluginHost.Load(name, pluginConfig.Path, pluginConfig.Args...)
+		if loadErr != nil {
+			m.addPendingLogMessage(log.ErrorLevel, "Error loading plugin %v: %v", name, loadErr)
+		}
+	}
+
 	var consoleLogLevel log.Level
 	var fileLogLevel log.Level
 
@@ -268,6 +284,12 @@ func (m *ConfigManager) GetDefaultSettings() map[string]any {
 		DB: m.GetDefaultDBConfig(),
 	}
 
+	defaultCache := CacheConfig{
+		Enabled: false,
+		MaxSize: 10000,
+		TTL:     time.Minute,
+	}
+
 	return map[string]any{
 		LogFile:         path.Join(m.CacheDir, "bntp.log"),
 		ConsoleLogLevel: log.ErrorLevel.String(),
@@ -279,19 +301,23 @@ func (m *ConfigManager) GetDefaultSettings() map[string]any {
 					DB:            m.GetDefaultDBConfig(),
 					TagRepository: defaultTagRepository,
 				},
+				Cache: defaultCache,
 			},
 			TagsManager: TagsManagerConfig{
 				TagsRepository: defaultTagRepository,
+				Cache:          defaultCache,
 			},
 			DocumentManager: DocumentManagerConfig{
 				DocumentRepository: DocumentRepositoryConfig{
 					DB:            m.GetDefaultDBConfig(),
 					TagRepository: defaultTagRepository,
 				},
+				Cache: defaultCache,
 			},
 			DocumentContentManager: DocumentContentManagerConfig{
 				DocumentContentRepository: DocumentContentRepositoryConfig{
-					DB: m.GetDefaultDBConfig(),
+					DB:       m.GetDefaultDBConfig(),
+					TrashDir: path.Join(m.ConfigDir, "trash"),
 				},
 			},
 		},
@@ -301,6 +327,10 @@ func (m *ConfigManager) GetDefaultSettings() map[string]any {
 // ********************    Repository builders    ********************//
 // TODO: Allow using non-sql repositories.
 func (m *ConfigManager) NewBookmarkRepositoryFromConfig(logger *log.Logger, repoDB *sql.DB, tagRepository repository.TagRepository) (repo repository.BookmarkRepository, err error) {
+	if pluginName := m.Viper.GetString(BookmarkRepositoryPlugin); pluginName != "" {
+		return m.PluginHost.BookmarkRepository(pluginName, tagRepository)
+	}
+
 	repo = new(sqlite3Repository.Sqlite3BookmarkRepository)
 
 	bookmarkRepositoryAbstract, err := repo.New(sqlite3Repository.Sqlite3BookmarkRepositoryConstructorArgs{Logger: logger, DB: repoDB, TagRepository: tagRepository})
@@ -314,6 +344,10 @@ func (m *ConfigManager) NewBookmarkRepositoryFromConfig(logger *log.Logger, repo
 }
 
 func (m *ConfigManager) NewTagsRepositoryFromConfig(logger *log.Logger, repoDB *sql.DB) (repo repository.TagRepository, err error) {
+	if pluginName := m.Viper.GetString(TagsRepositoryPlugin); pluginName != "" {
+		return m.PluginHost.TagRepository(pluginName)
+	}
+
 	repo = new(sqlite3Repository.Sqlite3TagRepository)
 
 	tagsRepositoryAbstract, err := repo.New(sqlite3Repository.Sqlite3TagRepositoryConstructorArgs{Logger: logger, DB: repoDB})
@@ -326,6 +360,10 @@ func (m *ConfigManager) NewTagsRepositoryFromConfig(logger *log.Logger, repoDB *
 	return
 }
 func (m *ConfigManager) NewDocumentRepositoryFromConfig(logger *log.Logger, repoDB *sql.DB, tagRepository repository.TagRepository) (repo repository.DocumentRepository, err error) {
+	if pluginName := m.Viper.GetString(DocumentRepositoryPlugin); pluginName != "" {
+		return m.PluginHost.DocumentRepository(pluginName, tagRepository)
+	}
+
 	repo = new(sqlite3Repository.Sqlite3DocumentRepository)
 
 	documentRepositoryAbstract, err := repo.New(sqlite3Repository.Sqlite3DocumentRepositoryConstructorArgs{Logger: logger, DB: repoDB, TagRepository: tagRepository})
@@ -340,9 +378,17 @@ func (m *ConfigManager) NewDocumentRepositoryFromConfig(logger *log.Logger, repo
 
 // TODO: Allow non-fs content repositories
 func (m *ConfigManager) NewDocumentContentRepositoryFromConfig(logger *log.Logger, fs afero.Fs) (repo repository.DocumentContentRepository, err error) {
+	if pluginName := m.Viper.GetString(DocumentContentRepositoryPlugin); pluginName != "" {
+		return m.PluginHost.DocumentContentRepository(pluginName)
+	}
+
 	repo = new(fsRepository.FSDocumentContentRepository)
 
-	documentContentRepositoryAbstract, err := repo.New(fsRepository.FSDocumentContentRepositoryConstructorArgs{Logger: logger, Fs: fs})
+	documentContentRepositoryAbstract, err := repo.New(fsRepository.FSDocumentContentRepositoryConstructorArgs{
+		Logger:   logger,
+		Fs:       fs,
+		TrashDir: m.Viper.GetString(DocumentContentRepositoryTrashDir),
+	})
 	if err != nil {
 		return
 	}
@@ -352,12 +398,37 @@ func (m *ConfigManager) NewDocumentContentRepositoryFromConfig(logger *log.Logge
 	return
 }
 
-// TODO: Implement proper  hook parsing
+func (m *ConfigManager) NewDocumentContentSearchRepositoryFromConfig(logger *log.Logger, repoDB *sql.DB) (repo repository.DocumentContentSearchRepository, err error) {
+	repo = new(sqlite3Repository.Sqlite3DocumentContentSearchRepository)
+
+	documentContentSearchRepositoryAbstract, err := repo.New(sqlite3Repository.Sqlite3DocumentContentSearchRepositoryConstructorArgs{Logger: logger, DB: repoDB})
+	if err != nil {
+		return
+	}
+
+	repo, _ = documentContentSearchRepositoryAbstract.(*sqlite3Repository.Sqlite3DocumentContentSearchRepository)
+
+	return
+}
+
 // ********************    Manager builders    ********************//
 func (m *ConfigManager) NewBookmarkManagerFromConfig(logger *log.Logger, repo repository.BookmarkRepository) (manager libbookmarks.BookmarkManager, err error) {
-	hooks := bntp.NewHooks[domain.Bookmark]()
+	hooksConfig, err := m.getHooksConfig(BookmarkManagerHooks)
+	if err != nil {
+		return
+	}
+
+	hooks, err := newHooksFromConfig(backend.BookmarkHookRegistry, hooksConfig)
+	if err != nil {
+		return
+	}
 
-	manager, err = libbookmarks.NewBookmarkManager(logger, hooks, repo)
+	cache, err := newManagerCacheFromConfig(m, BookmarkManagerCache, (*domain.Bookmark).GetID)
+	if err != nil {
+		return
+	}
+
+	manager, err = libbookmarks.NewBookmarkManager(logger, hooks, repo, cache)
 	if err != nil {
 		return
 	}
@@ -366,8 +437,22 @@ func (m *ConfigManager) NewBookmarkManagerFromConfig(logger *log.Logger, repo re
 }
 
 func (m *ConfigManager) NewTagsManagerFromConfig(logger *log.Logger, repo repository.TagRepository) (manager libtags.TagManager, err error) {
-	hooks := new(bntp.Hooks[domain.Tag])
-	manager, err = libtags.NewTagmanager(logger, hooks, repo)
+	hooksConfig, err := m.getHooksConfig(TagsManagerHooks)
+	if err != nil {
+		return
+	}
+
+	hooks, err := newHooksFromConfig(backend.TagHookRegistry, hooksConfig)
+	if err != nil {
+		return
+	}
+
+	cache, err := newManagerCacheFromConfig(m, TagsManagerCache, (*domain.Tag).GetID)
+	if err != nil {
+		return
+	}
+
+	manager, err = libtags.NewTagmanager(logger, hooks, repo, cache)
 	if err != nil {
 		return
 	}
@@ -375,8 +460,22 @@ func (m *ConfigManager) NewTagsManagerFromConfig(logger *log.Logger, repo reposi
 	return
 }
 func (m *ConfigManager) NewDocumentManagerFromConfig(logger *log.Logger, repo repository.DocumentRepository) (manager libdocuments.DocumentManager, err error) {
-	hooks := new(bntp.Hooks[domain.Document])
-	manager, err = libdocuments.NewDocumentManager(logger, hooks, repo)
+	hooksConfig, err := m.getHooksConfig(DocumentManagerHooks)
+	if err != nil {
+		return
+	}
+
+	hooks, err := newHooksFromConfig(backend.DocumentHookRegistry, hooksConfig)
+	if err != nil {
+		return
+	}
+
+	cache, err := newManagerCacheFromConfig(m, DocumentManagerCache, (*domain.Document).GetID)
+	if err != nil {
+		return
+	}
+
+	manager, err = libdocuments.NewDocumentManager(logger, hooks, repo, cache)
 	if err != nil {
 		return
 	}
@@ -384,9 +483,18 @@ func (m *ConfigManager) NewDocumentManagerFromConfig(logger *log.Logger, repo re
 	return
 }
 
-func (m *ConfigManager) NewDocumentContentManagerFromConfig(logger *log.Logger, repo repository.DocumentContentRepository) (manager libdocuments.DocumentContentManager, err error) {
-	hooks := new(bntp.Hooks[string])
-	manager, err = libdocuments.NewDocumentContentManager(logger, hooks, repo)
+func (m *ConfigManager) NewDocumentContentManagerFromConfig(logger *log.Logger, repo repository.DocumentContentRepository, searchRepo repository.DocumentContentSearchRepository) (manager libdocuments.DocumentContentManager, err error) {
+	hooksConfig, err := m.getHooksConfig(DocumentContentManagerHooks)
+	if err != nil {
+		return
+	}
+
+	hooks, err := newHooksFromConfig(backend.DocumentContentHookRegistry, hooksConfig)
+	if err != nil {
+		return
+	}
+
+	manager, err = libdocuments.NewDocumentContentManager(logger, hooks, repo, searchRepo)
 	if err != nil {
 		return
 	}
@@ -415,6 +523,7 @@ func (m *ConfigManager) NewBackendFromConfig() (newBackend *backend.Backend, err
 	var documentRepository repository.DocumentRepository
 	var bookmarkRepository repository.BookmarkRepository
 	var documentContentRepository repository.DocumentContentRepository
+	var documentContentSearchRepository repository.DocumentContentSearchRepository
 
 	//********************    Create repositories    *******************//
 	tagRepository, err = m.NewTagsRepositoryFromConfig(m.Logger, db)
@@ -435,6 +544,11 @@ func (m *ConfigManager) NewBackendFromConfig() (newBackend *backend.Backend, err
 		return
 	}
 
+	documentContentSearchRepository, err = m.NewDocumentContentSearchRepositoryFromConfig(m.Logger, db)
+	if err != nil {
+		return
+	}
+
 	//**********************    Create Managers    *********************//
 	newBackend.BookmarkManager, err = m.NewBookmarkManagerFromConfig(m.Logger, bookmarkRepository)
 	if err != nil {
@@ -451,7 +565,11 @@ func (m *ConfigManager) NewBackendFromConfig() (newBackend *backend.Backend, err
 		return
 	}
 
-	newBackend.DocumentContentManager, err = m.NewDocumentContentManagerFromConfig(m.Logger, documentContentRepository)
+	// Bookmarks and documents embed their tags
+	newBackend.TagManager.Cache.AddDependent(newBackend.BookmarkManager.Cache)
+	newBackend.TagManager.Cache.AddDependent(newBackend.DocumentManager.Cache)
+
+	newBackend.DocumentContentManager, err = m.NewDocumentContentManagerFromConfig(m.Logger, documentContentRepository, documentContentSearchRepository)
 	if err != nil {
 		return
 	}
@@ -510,6 +628,55 @@ func (m *ConfigManager) NewBackendFromConfig() (newBackend *backend.Backend, err
 //                            Private API                           //
 //******************************************************************//
 
+var commandHookMarshallers = map[string]marshallers.Marshaller{
+	"json": new(marshallers.JsonMarshaller),
+	"yaml": new(marshallers.YamlMarshaller),
+}
+
+// newHooksFromConfig attaches the hooks from registry and the command hooks defined in hooksConfig to their hook points.
+func newHooksFromConfig[TEntity any](registry *bntp.HookRegistry[TEntity], hooksConfig HooksConfig) (hooks *bntp.Hooks[TEntity], err error) {
+	registry = registry.Clone()
+
+	for name, commandHookConfig := range hooksConfig.Commands {
+		format := commandHookConfig.Format
+		if format == "" {
+			format = "json"
+		}
+
+		marshaller, ok := commandHookMarshallers[format]
+		if !ok {
+			err = fmt.Errorf("unsupported format %q for command hook %q", format, name)
+
+			return
+		}
+
+		err = registry.Register(name, bntp.NewCommandHook[TEntity](commandHookConfig.Command, commandHookConfig.Timeout, marshaller))
+		if err != nil {
+			return
+		}
+	}
+
+	return registry.NewHooks(hooksConfig.HookPoints)
+}
+
+// newManagerCacheFromConfig returns nil if the cache at key is disabled.
+func newManagerCacheFromConfig[TEntity any](m *ConfigManager, key string, getID func(*TEntity) int64) (cache *bntp.ManagerCache[TEntity], err error) {
+	var cacheConfig CacheConfig
+
+	err = m.Viper.UnmarshalKey(key, &cacheConfig)
+	if err != nil || !cacheConfig.Enabled {
+		return
+	}
+
+	return bntp.NewManagerCache(cacheConfig.MaxSize, cacheConfig.TTL, getID), nil
+}
+
+func (m *ConfigManager) getHooksConfig(key string) (hooksConfig HooksConfig, err error) {
+	err = m.Viper.UnmarshalKey(key, &hooksConfig)
+
+	return
+}
+
 func (m *ConfigManager) addPendingLogMessage(level log.Level, format string, values ...any) {
 	m.PendingLogMessage = append(m.PendingLogMessage, newMessage(level, fmt.Sprintf(format, values...)))
 }
@@ -582,6 +749,13 @@ func formatValidationError(e validator.FieldError) string {
 		message = "setting is required"
 	case "file":
 		message = fmt.Sprintf("value %q is not a path", e.Value())
+	case ValidatorHookPoint:
+		hookPointNames := maps.Keys(bntp.HookPointNames)
+		slices.Sort(hookPointNames)
+
+		message = fmt.Sprintf("value %q is not a hook point, allowed values are %v", e.Value(), hookPointNames)
+	case ValidatorHook:
+		message = fmt.Sprintf("value %q is not a registered hook", e.Value())
 	default:
 		message = e.Error()
 	}
@@ -0,0 +1,237 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/bntp"
+	"github.com/JonasMuehlmann/bntp.go/bntp/backend"
+	"github.com/JonasMuehlmann/bntp.go/internal/config"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookmarkManagerFromConfigHooks(t *testing.T) {
+	var calledHookPoints []string
+
+	err := backend.BookmarkHookRegistry.Register("config_test_before", func(_ context.Context, _ *domain.Bookmark) error {
+		calledHookPoints = append(calledHookPoints, "before")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = backend.BookmarkHookRegistry.Register("config_test_after", func(_ context.Context, _ *domain.Bookmark) error {
+		calledHookPoints = append(calledHookPoints, "after")
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		err        error
+		hookPoints map[string][]string
+		name       string
+		expected   []string
+	}{
+		{
+			name: "No hooks",
+		},
+		{
+			name:       "Hooks on add",
+			hookPoints: map[string][]string{"before_add": {"config_test_before"}, "after_add": {"config_test_after"}},
+			expected:   []string{"before", "after"},
+		},
+		{
+			name:       "Hook on unrelated hook point",
+			hookPoints: map[string][]string{"before_delete": {"config_test_before"}},
+		},
+		{
+			name:       "Unknown hook",
+			hookPoints: map[string][]string{"before_add": {"foo"}},
+			err:        bntp.UnknownHookError{},
+		},
+		{
+			name:       "Unknown hook point",
+			hookPoints: map[string][]string{"before_foo": {"config_test_before"}},
+			err:        bntp.BadHookPointError{},
+		},
+	}
+
+	for _, test := range tests {
+		calledHookPoints = nil
+
+		db, err := testCommon.GetDB()
+		require.NoError(t, err, test.name+", assert db creation")
+
+		m, err := config.NewConfigManager(testCommon.NewBufferString(""), db, afero.NewMemMapFs())
+		require.NoError(t, err, test.name+", assert config manager creation")
+
+		m.Viper.Set(config.BookmarkManagerHooks+".hooks", test.hookPoints)
+
+		tagRepository, err := m.NewTagsRepositoryFromConfig(m.Logger, db)
+		require.NoError(t, err, test.name+", assert tag repository creation")
+
+		bookmarkRepository, err := m.NewBookmarkRepositoryFromConfig(m.Logger, db, tagRepository)
+		require.NoError(t, err, test.name+", assert bookmark repository creation")
+
+		manager, err := m.NewBookmarkManagerFromConfig(m.Logger, bookmarkRepository)
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.name+", assert error matches")
+			db.Close()
+
+			continue
+		}
+
+		require.NoError(t, err, test.name+", assert manager creation")
+
+		err = manager.Add(context.Background(), []*domain.Bookmark{{URL: "foo", ID: 1}})
+		assert.NoError(t, err, test.name+", assert adding bookmark")
+
+		assert.Equal(t, test.expected, calledHookPoints, test.name+", assert called hooks")
+
+		db.Close()
+	}
+}
+
+func TestValidateHooksConfig(t *testing.T) {
+	err := backend.TagHookRegistry.Register("config_test_validate", func(_ context.Context, _ *domain.Tag) error { return nil })
+	require.NoError(t, err)
+
+	tests := []struct {
+		hooksConfig config.HooksConfig
+		name        string
+		isValid     bool
+	}{
+		{
+			name:    "Empty",
+			isValid: true,
+		},
+		{
+			name:        "Registered hook",
+			hooksConfig: config.HooksConfig{HookPoints: map[string][]string{"after_any": {"config_test_validate"}}},
+			isValid:     true,
+		},
+		{
+			name:        "Unregistered hook",
+			hooksConfig: config.HooksConfig{HookPoints: map[string][]string{"after_any": {"foo"}}},
+		},
+		{
+			name:        "Bad hook point",
+			hooksConfig: config.HooksConfig{HookPoints: map[string][]string{"AfterAnyHook": {"config_test_validate"}}},
+		},
+	}
+
+	for _, test := range tests {
+		err := config.ConfigValidator.Struct(test.hooksConfig)
+		if test.isValid {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Error(t, err, test.name)
+		}
+	}
+}
+
+func TestNewBookmarkManagerFromConfigCommandHooks(t *testing.T) {
+	tests := []struct {
+		err            error
+		hooksConfig    map[string]any
+		name           string
+		outputContains []string
+		numRecords     int64
+	}{
+		{
+			name: "After hook receives entity and hook point",
+			hooksConfig: map[string]any{
+				"hooks":    map[string][]string{"after_add": {"record"}},
+				"commands": map[string]any{"record": map[string]any{"command": []string{"sh", "-c", `cat > "$OUTPUT"; echo "$BNTP_HOOK_POINT" >> "$OUTPUT"`}}},
+			},
+			outputContains: []string{`"url":"foo"`, "after_add"},
+			numRecords:     1,
+		},
+		{
+			name: "Yaml format",
+			hooksConfig: map[string]any{
+				"hooks":    map[string][]string{"after_add": {"record"}},
+				"commands": map[string]any{"record": map[string]any{"command": []string{"sh", "-c", `cat > "$OUTPUT"`}, "format": "yaml"}},
+			},
+			outputContains: []string{"url: foo"},
+			numRecords:     1,
+		},
+		{
+			name: "Failing before hook aborts",
+			hooksConfig: map[string]any{
+				"hooks":    map[string][]string{"before_add": {"fail"}},
+				"commands": map[string]any{"fail": map[string]any{"command": []string{"sh", "-c", "echo nope >&2; exit 1"}}},
+			},
+			err:        bntp.HookExecutionError{},
+			numRecords: 0,
+		},
+		{
+			name: "Failing after hook does not abort",
+			hooksConfig: map[string]any{
+				"hooks":    map[string][]string{"after_add": {"fail"}},
+				"commands": map[string]any{"fail": map[string]any{"command": []string{"sh", "-c", "exit 1"}}},
+			},
+			numRecords: 1,
+		},
+		{
+			name: "Timeout",
+			hooksConfig: map[string]any{
+				"hooks":    map[string][]string{"before_add": {"sleep"}},
+				"commands": map[string]any{"sleep": map[string]any{"command": []string{"sleep", "5"}, "timeout": "50ms"}},
+			},
+			err:        context.DeadlineExceeded,
+			numRecords: 0,
+		},
+	}
+
+	for _, test := range tests {
+		outputPath := filepath.Join(t.TempDir(), "output")
+		t.Setenv("OUTPUT", outputPath)
+
+		db, err := testCommon.GetDB()
+		require.NoError(t, err, test.name+", assert db creation")
+
+		m, err := config.NewConfigManager(testCommon.NewBufferString(""), db, afero.NewMemMapFs())
+		require.NoError(t, err, test.name+", assert config manager creation")
+
+		m.Viper.Set(config.BookmarkManagerHooks, test.hooksConfig)
+
+		tagRepository, err := m.NewTagsRepositoryFromConfig(m.Logger, db)
+		require.NoError(t, err, test.name+", assert tag repository creation")
+
+		bookmarkRepository, err := m.NewBookmarkRepositoryFromConfig(m.Logger, db, tagRepository)
+		require.NoError(t, err, test.name+", assert bookmark repository creation")
+
+		manager, err := m.NewBookmarkManagerFromConfig(m.Logger, bookmarkRepository)
+		require.NoError(t, err, test.name+", assert manager creation")
+
+		err = manager.Add(context.Background(), []*domain.Bookmark{{URL: "foo", ID: 1}})
+		if test.err != nil {
+			assert.ErrorIs(t, err, test.err, test.name+", assert error matches")
+		} else {
+			assert.NoError(t, err, test.name+", assert adding bookmark")
+		}
+
+		numRecords, err := manager.CountAll(context.Background())
+		assert.NoError(t, err, test.name+", assert counting bookmarks")
+		assert.Equal(t, test.numRecords, numRecords, test.name+", assert number of bookmarks")
+
+		if len(test.outputContains) > 0 {
+			output, err := os.ReadFile(outputPath)
+			assert.NoError(t, err, test.name+", assert reading hook output")
+
+			for _, expected := range test.outputContains {
+				assert.Contains(t, string(output), expected, test.name+", assert hook output")
+			}
+		}
+
+		db.Close()
+	}
+}
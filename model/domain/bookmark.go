@@ -228,6 +228,11 @@ type BookmarkFilter struct {
 	IsRead optional.Optional[model.FilterOperation[bool]] `json:"isRead,omitempty" toml:"isRead,omitempty" yaml:"isRead,omitempty"`
 
 	BookmarkType optional.Optional[model.FilterOperation[optional.Optional[string]]] `json:"bookmarkType,omitempty" toml:"bookmarkType,omitempty" yaml:"bookmarkType,omitempty"`
+
+	// And, Or and Not compose whole filters, they are combined with the field filters by AND.
+	And []*BookmarkFilter `json:"and,omitempty" toml:"and,omitempty" yaml:"and,omitempty"`
+	Or  []*BookmarkFilter `json:"or,omitempty" toml:"or,omitempty" yaml:"or,omitempty"`
+	Not *BookmarkFilter   `json:"not,omitempty" toml:"not,omitempty" yaml:"not,omitempty"`
 }
 
 func (filter *BookmarkFilter) IsDefault() bool {
@@ -261,6 +266,9 @@ func (filter *BookmarkFilter) IsDefault() bool {
 	if filter.BookmarkType.HasValue {
 		return false
 	}
+	if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+		return false
+	}
 
 	return true
 }
@@ -313,11 +321,22 @@ func (updater *BookmarkUpdater) IsDefault() bool {
 	return true
 }
 
+// BookmarkSorter sorts by its keys in order, later keys break ties of earlier ones.
+type BookmarkSorter []model.SortKey[BookmarkField]
+
+// BookmarkMemberSelector selects the fields to load, an empty selector selects all fields.
+type BookmarkMemberSelector []BookmarkField
+
+// BookmarkGrouper groups bookmarks by one of their fields for aggregate queries.
+type BookmarkGrouper model.GroupKey[BookmarkField]
+
 const (
-	BookmarkFilterUntitled = "BookmarkFilterUntitled"
-	BookmarkFilterUntagged = "BookmarkFilterUntagged"
-	BookmarkFilterInboxed  = "BookmarkFilterInboxed"
-	BookmarkFilterDeleted  = "BookmarkFilterDeleted"
+	BookmarkFilterUntitled   = "BookmarkFilterUntitled"
+	BookmarkFilterUntagged   = "BookmarkFilterUntagged"
+	BookmarkFilterInboxed    = "BookmarkFilterInboxed"
+	BookmarkFilterDeleted    = "BookmarkFilterDeleted"
+	BookmarkFilterNotDeleted = "BookmarkFilterNotDeleted"
+	BookmarkFilterUnread     = "BookmarkFilterUnread"
 )
 
 var PredefinedBookmarkFilters = map[string]*BookmarkFilter{
@@ -327,14 +346,9 @@ var PredefinedBookmarkFilters = map[string]*BookmarkFilter{
 		},
 		Operator: model.FilterEqual,
 	})},
-	// FIX: This operating on int64s instead of the slice is nonsense, right?
 	BookmarkFilterUntagged: {TagIDs: optional.Make(model.FilterOperation[int64]{
-		Operand: model.ScalarOperand[int64]{
-			Operand: -1,
-		},
-		Operator: model.FilterEqual,
+		Operator: model.FilterEmpty,
 	})},
-	// FIX: This operating on int64s instead of the slice is nonsense, right?
 	BookmarkFilterInboxed: {
 		Title: optional.Make(model.FilterOperation[optional.Optional[string]]{
 			Operand: model.ScalarOperand[optional.Optional[string]]{
@@ -343,15 +357,24 @@ var PredefinedBookmarkFilters = map[string]*BookmarkFilter{
 			Operator: model.FilterEqual,
 		}),
 		TagIDs: optional.Make(model.FilterOperation[int64]{
-			Operand: model.ScalarOperand[int64]{
-				Operand: -1,
-			},
-			Operator: model.FilterEqual,
+			Operator: model.FilterEmpty,
 		})},
 	BookmarkFilterDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
 		Operand: model.ScalarOperand[optional.Optional[time.Time]]{
 			Operand: optional.Optional[time.Time]{},
 		},
+		Operator: model.FilterNEqual,
+	})},
+	BookmarkFilterNotDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
+		Operand: model.ScalarOperand[optional.Optional[time.Time]]{
+			Operand: optional.Optional[time.Time]{},
+		},
+		Operator: model.FilterEqual,
+	})},
+	BookmarkFilterUnread: {IsRead: optional.Make(model.FilterOperation[bool]{
+		Operand: model.ScalarOperand[bool]{
+			Operand: false,
+		},
 		Operator: model.FilterEqual,
 	})},
 }
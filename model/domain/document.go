@@ -99,6 +99,18 @@ func (t *Document) IsDefault() bool {
 
 type DocumentField string
 
+var DocumentFieldsList = []DocumentField{
+	DocumentField("CreatedAt"),
+	DocumentField("UpdatedAt"),
+	DocumentField("DeletedAt"),
+	DocumentField("Path"),
+	DocumentField("DocumentType"),
+	DocumentField("TagIDs"),
+	DocumentField("LinkedDocumentIDs"),
+	DocumentField("BacklinkedDocumentsIDs"),
+	DocumentField("ID"),
+}
+
 var DocumentFields = struct {
 	CreatedAt              DocumentField
 	UpdatedAt              DocumentField
@@ -187,6 +199,11 @@ type DocumentFilter struct {
 	LinkedDocumentIDs      optional.Optional[model.FilterOperation[int64]]                        `json:"linkedDocumentIDs,omitempty" toml:"linkedDocumentIDs,omitempty" yaml:"linkedDocumentIDs,omitempty"`
 	BacklinkedDocumentsIDs optional.Optional[model.FilterOperation[int64]]                        `json:"backlinkedDocumentsIDs,omitempty" toml:"backlinkedDocumentsIDs,omitempty" yaml:"backlinkedDocumentsIDs,omitempty"`
 	ID                     optional.Optional[model.FilterOperation[int64]]                        `json:"id,omitempty" toml:"id,omitempty" yaml:"id,omitempty"`
+
+	// And, Or and Not compose whole filters, they are combined with the field filters by AND.
+	And []*DocumentFilter `json:"and,omitempty" toml:"and,omitempty" yaml:"and,omitempty"`
+	Or  []*DocumentFilter `json:"or,omitempty" toml:"or,omitempty" yaml:"or,omitempty"`
+	Not *DocumentFilter   `json:"not,omitempty" toml:"not,omitempty" yaml:"not,omitempty"`
 }
 
 func (filter *DocumentFilter) IsDefault() bool {
@@ -217,6 +234,9 @@ func (filter *DocumentFilter) IsDefault() bool {
 	if filter.ID.HasValue {
 		return false
 	}
+	if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+		return false
+	}
 
 	return true
 }
@@ -265,20 +285,32 @@ func (updater *DocumentUpdater) IsDefault() bool {
 	return true
 }
 
+// DocumentSorter sorts by its keys in order, later keys break ties of earlier ones.
+type DocumentSorter []model.SortKey[DocumentField]
+
+// DocumentMemberSelector selects the fields to load, an empty selector selects all fields.
+type DocumentMemberSelector []DocumentField
+
+// DocumentGrouper groups documents by one of their fields for aggregate queries.
+type DocumentGrouper model.GroupKey[DocumentField]
+
 const (
-	DocumentFilterUntagged = "DocumentFilterUntagged"
-	DocumentFilterDeleted  = "DocumentFilterDeleted"
+	DocumentFilterUntagged   = "DocumentFilterUntagged"
+	DocumentFilterDeleted    = "DocumentFilterDeleted"
+	DocumentFilterNotDeleted = "DocumentFilterNotDeleted"
 )
 
-// FIX: This operating on int64s instead of the slice is nonsense, right?
 var PredefinedDocumentFilters = map[string]*DocumentFilter{
 	DocumentFilterUntagged: {TagIDs: optional.Make(model.FilterOperation[int64]{
-		Operand: model.ScalarOperand[int64]{
-			Operand: -1,
-		},
-		Operator: model.FilterEqual,
+		Operator: model.FilterEmpty,
 	})},
 	DocumentFilterDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
+		Operand: model.ScalarOperand[optional.Optional[time.Time]]{
+			Operand: optional.Optional[time.Time]{},
+		},
+		Operator: model.FilterNEqual,
+	})},
+	DocumentFilterNotDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
 		Operand: model.ScalarOperand[optional.Optional[time.Time]]{
 			Operand: optional.Optional[time.Time]{},
 		},
@@ -63,6 +63,13 @@ func (t *Tag) IsDefault() bool {
 
 type TagField string
 
+var TagFieldsList = []TagField{
+	TagField("ID"),
+	TagField("ParentPathIDs"),
+	TagField("Tag"),
+	TagField("SubtagIDs"),
+}
+
 var TagFields = struct {
 	ID            TagField
 	ParentPathIDs TagField
@@ -106,6 +113,11 @@ type TagFilter struct {
 	ParentPathIDs optional.Optional[model.FilterOperation[int64]]  `json:"parentPathIDs,omitempty" toml:"parentPathIDs,omitempty" yaml:"parentPathIDs,omitempty"`
 	Tag           optional.Optional[model.FilterOperation[string]] `json:"tag,omitempty" toml:"tag,omitempty" yaml:"tag,omitempty"`
 	SubtagIDs     optional.Optional[model.FilterOperation[int64]]  `json:"subtagIDs,omitempty" toml:"subtagIDs,omitempty" yaml:"subtagIDs,omitempty"`
+
+	// And, Or and Not compose whole filters, they are combined with the field filters by AND.
+	And []*TagFilter `json:"and,omitempty" toml:"and,omitempty" yaml:"and,omitempty"`
+	Or  []*TagFilter `json:"or,omitempty" toml:"or,omitempty" yaml:"or,omitempty"`
+	Not *TagFilter   `json:"not,omitempty" toml:"not,omitempty" yaml:"not,omitempty"`
 }
 
 func (filter *TagFilter) IsDefault() bool {
@@ -121,6 +133,9 @@ func (filter *TagFilter) IsDefault() bool {
 	if filter.SubtagIDs.HasValue {
 		return false
 	}
+	if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+		return false
+	}
 
 	return true
 }
@@ -149,24 +164,25 @@ func (updater *TagUpdater) IsDefault() bool {
 	return true
 }
 
+// TagSorter sorts by its keys in order, later keys break ties of earlier ones.
+type TagSorter []model.SortKey[TagField]
+
+// TagMemberSelector selects the fields to load, an empty selector selects all fields.
+type TagMemberSelector []TagField
+
+// TagGrouper groups tags by one of their fields for aggregate queries.
+type TagGrouper model.GroupKey[TagField]
+
 const (
 	TagFilterLeaf = "TagFilterLeaf"
 	TagFilterRoot = "TagFilterRoot"
 )
 
 var PredefinedTagFilters = map[string]*TagFilter{
-	// FIX: This operating on int64s instead of the slice is nonsense, right?
-	TagFilterLeaf: {ParentPathIDs: optional.Make(model.FilterOperation[int64]{
-		Operand: model.ScalarOperand[int64]{
-			Operand: -1,
-		},
-		Operator: model.FilterEqual,
+	TagFilterLeaf: {SubtagIDs: optional.Make(model.FilterOperation[int64]{
+		Operator: model.FilterEmpty,
 	})},
-	// FIX: This operating on int64s instead of the slice is nonsense, right?
-	TagFilterRoot: {SubtagIDs: optional.Make(model.FilterOperation[int64]{
-		Operand: model.ScalarOperand[int64]{
-			Operand: -1,
-		},
-		Operator: model.FilterEqual,
+	TagFilterRoot: {ParentPathIDs: optional.Make(model.FilterOperation[int64]{
+		Operator: model.FilterEmpty,
 	})},
 }
@@ -27,8 +27,8 @@ import (
 
 type FilterOperator int
 
-// TODO: Implement FilterContains and FilterNotContains
-// TODO: Implement FilterEmpty and FilterNotEmpty
+// FilterContains, FilterContainsAny, FilterNotContains, FilterEmpty, FilterNotEmpty
+// and their recursive variants operate on slice fields, the others on scalar fields.
 const (
 	FilterEqual FilterOperator = iota + 1
 	FilterNEqual
@@ -48,6 +48,25 @@ const (
 
 	FilterOr
 	FilterAnd
+
+	// FilterContains matches if all operands are members.
+	FilterContains
+	// FilterContainsAny matches if any operand is a member.
+	FilterContainsAny
+	// FilterNotContains matches if no operand is a member.
+	FilterNotContains
+
+	// FilterEmpty matches if there are no members, it takes no operand.
+	FilterEmpty
+	// FilterNotEmpty matches if there are any members, it takes no operand.
+	FilterNotEmpty
+
+	// FilterContainsRecursive is FilterContains, where members also match their ancestors.
+	FilterContainsRecursive
+	// FilterContainsAnyRecursive is FilterContainsAny, where members also match their ancestors.
+	FilterContainsAnyRecursive
+	// FilterNotContainsRecursive is FilterNotContains, where members also match their ancestors.
+	FilterNotContainsRecursive
 )
 
 func (o FilterOperator) String() string {
@@ -69,9 +88,9 @@ func (o FilterOperator) String() string {
 	case FilterNotIn:
 		return "FilterNotIn"
 	case FilterBetween:
-		return "FilterNotIn"
+		return "FilterBetween"
 	case FilterNotBetween:
-		return "FilterBetweenEqual"
+		return "FilterNotBetween"
 	case FilterLike:
 		return "FilterLike"
 	case FilterNotLike:
@@ -80,6 +99,22 @@ func (o FilterOperator) String() string {
 		return "FilterOr"
 	case FilterAnd:
 		return "FilterAnd"
+	case FilterContains:
+		return "FilterContains"
+	case FilterContainsAny:
+		return "FilterContainsAny"
+	case FilterNotContains:
+		return "FilterNotContains"
+	case FilterEmpty:
+		return "FilterEmpty"
+	case FilterNotEmpty:
+		return "FilterNotEmpty"
+	case FilterContainsRecursive:
+		return "FilterContainsRecursive"
+	case FilterContainsAnyRecursive:
+		return "FilterContainsAnyRecursive"
+	case FilterNotContainsRecursive:
+		return "FilterNotContainsRecursive"
 	default:
 		return ""
 	}
@@ -115,6 +150,22 @@ func FilterOperatorFromString(s string) FilterOperator {
 		return FilterOr
 	case "FilterAnd":
 		return FilterAnd
+	case "FilterContains":
+		return FilterContains
+	case "FilterContainsAny":
+		return FilterContainsAny
+	case "FilterNotContains":
+		return FilterNotContains
+	case "FilterEmpty":
+		return FilterEmpty
+	case "FilterNotEmpty":
+		return FilterNotEmpty
+	case "FilterContainsRecursive":
+		return FilterContainsRecursive
+	case "FilterContainsAnyRecursive":
+		return FilterContainsAnyRecursive
+	case "FilterNotContainsRecursive":
+		return FilterNotContainsRecursive
 	default:
 		return 0
 	}
@@ -178,6 +229,12 @@ func (s *FilterOperation[T]) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return err
 	}
+
+	// Operators like FilterEmpty take no operand
+	if operandRaw, ok := tmpOperation["operand"]; !ok || string(operandRaw) == "null" {
+		return nil
+	}
+
 	err = json.Unmarshal(tmpOperation["operand"], &tmpOperand)
 	if err != nil {
 		return err
@@ -234,6 +291,9 @@ type FilterOperation[T any] struct {
 
 func ConvertFilter[TOut any, TIn any](from FilterOperation[TIn], operandConverter func(fromOperator TIn) (TOut, error)) (FilterOperation[TOut], error) {
 	switch t := any(from.Operand).(type) {
+	//*************************    No Operand    ************************//
+	case nil:
+		return FilterOperation[TOut]{Operator: from.Operator}, nil
 	//***********************    ScalarOperand    **********************//
 	case ScalarOperand[TIn]:
 		operandValue, err := operandConverter(t.Operand)
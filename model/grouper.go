@@ -0,0 +1,101 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type TimeBucket int
+
+const (
+	BucketDay TimeBucket = iota + 1
+	BucketWeek
+	BucketMonth
+	BucketYear
+)
+
+func (b TimeBucket) String() string {
+	switch b {
+	case BucketDay:
+		return "BucketDay"
+	case BucketWeek:
+		return "BucketWeek"
+	case BucketMonth:
+		return "BucketMonth"
+	case BucketYear:
+		return "BucketYear"
+	default:
+		return ""
+	}
+}
+
+func TimeBucketFromString(s string) TimeBucket {
+	switch s {
+	case "BucketDay":
+		return BucketDay
+	case "BucketWeek":
+		return BucketWeek
+	case "BucketMonth":
+		return BucketMonth
+	case "BucketYear":
+		return BucketYear
+	default:
+		return 0
+	}
+}
+
+func (b TimeBucket) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(b.String())
+	buffer.WriteString(`"`)
+
+	return buffer.Bytes(), nil
+}
+
+func (b *TimeBucket) UnmarshalJSON(data []byte) error {
+	var j string
+
+	err := json.Unmarshal(data, &j)
+	if err != nil {
+		return err
+	}
+
+	*b = TimeBucketFromString(j)
+
+	return nil
+}
+
+// GroupKey groups entities by one of their fields.
+// Timestamps are grouped into buckets, an unset bucket groups them by day.
+type GroupKey[TField ~string] struct {
+	Field  TField     `json:"field" toml:"field" yaml:"field"`
+	Bucket TimeBucket `json:"bucket,omitempty" toml:"bucket,omitempty" yaml:"bucket,omitempty"`
+}
+
+// Group holds the number of entities sharing a value of a GroupKey.
+// The key of a timestamp bucket is the bucket's first day formatted as YYYY-MM-DD,
+// entities without a value are grouped under the empty key.
+type Group struct {
+	Key   string `json:"key" toml:"key" yaml:"key"`
+	Count int64  `json:"count" toml:"count" yaml:"count"`
+}
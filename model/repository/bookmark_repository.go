@@ -25,6 +25,7 @@ package repository
 import (
 	"context"
 
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 )
 
@@ -40,11 +41,18 @@ type BookmarkRepository interface {
 	DeleteWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (numAffectedRecords int64, err error)
 	CountWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (numRecords int64, err error)
 	CountAll(ctx context.Context) (numRecords int64, err error)
+	CountGroupedWhere(ctx context.Context, domainFilter *domain.BookmarkFilter, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error)
+	CountGroupedAll(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error)
 	DoesExist(ctx context.Context, domainModel *domain.Bookmark) (doesExist bool, err error)
 	DoesExistWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (doesExist bool, err error)
 	GetWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (records []*domain.Bookmark, err error)
 	GetFirstWhere(ctx context.Context, domainFilter *domain.BookmarkFilter) (record *domain.Bookmark, err error)
 	GetAll(ctx context.Context) (records []*domain.Bookmark, err error)
+	GetWhereSorted(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error)
+	GetAllSorted(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error)
+	GetWhereSelected(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error)
+	GetAllSelected(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error)
+	GetFirstWhereSelected(ctx context.Context, domainFilter *domain.BookmarkFilter, domainSelector domain.BookmarkMemberSelector) (record *domain.Bookmark, err error)
 	GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Bookmark, err error)
 
 	AddType(ctx context.Context, types []string) error
@@ -59,4 +67,7 @@ type BookmarkRepository interface {
 
 	BookmarkDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.BookmarkFilter) (repositoryFilter any, err error)
 	BookmarkDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.BookmarkUpdater) (repositoryUpdater any, err error)
+	BookmarkDomainToRepositorySorter(ctx context.Context, domainSorter domain.BookmarkSorter) (repositorySorter any, err error)
+	BookmarkDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.BookmarkMemberSelector) (repositorySelector any, err error)
+	BookmarkDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (repositoryGrouper any, err error)
 }
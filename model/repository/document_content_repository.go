@@ -39,6 +39,12 @@ type DocumentContentRepository interface {
 	Move(ctx context.Context, pathChanges []tuple.T2[string, string]) error
 	Delete(ctx context.Context, paths []string) error
 	Get(ctx context.Context, paths []string) (contents []string, err error)
+
+	// Trash moves contents into a trash area, from which they can be restored or purged.
+	Trash(ctx context.Context, paths []string) error
+	Restore(ctx context.Context, paths []string) error
+	Purge(ctx context.Context, paths []string) error
+
 	// GetAll(context.Context) (records []DocumentContent, err error)
 	// DoesExist(ctx context.Context, path string) (doesExist bool, err error)
 	// CountAll(ctx context.Context) (numRecords int64, err error)
@@ -0,0 +1,47 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"context"
+
+	"github.com/barweiss/go-tuple"
+)
+
+type DocumentContentSearchResult struct {
+	Path    string  `json:"path" toml:"path" yaml:"path"`
+	Snippet string  `json:"snippet" toml:"snippet" yaml:"snippet"`
+	Rank    float64 `json:"rank" toml:"rank" yaml:"rank"`
+}
+
+// DocumentContentSearchRepository is a full text index over document contents.
+type DocumentContentSearchRepository interface {
+	New(args any) (DocumentContentSearchRepository, error)
+
+	// Index adds the contents to the index, replacing previously indexed contents of the same paths.
+	Index(ctx context.Context, pathContents []tuple.T2[string, string]) error
+	Move(ctx context.Context, pathChanges []tuple.T2[string, string]) error
+	Delete(ctx context.Context, paths []string) error
+	DeleteAll(ctx context.Context) error
+	// Search returns the documents matching query, best matches first.
+	// If paths is not nil, only documents with one of the given paths are considered.
+	Search(ctx context.Context, query string, paths []string) (results []DocumentContentSearchResult, err error)
+}
@@ -25,6 +25,7 @@ package repository
 import (
 	"context"
 
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 )
 
@@ -40,11 +41,18 @@ type DocumentRepository interface {
 	DeleteWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (numAffectedRecords int64, err error)
 	CountWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (numRecords int64, err error)
 	CountAll(ctx context.Context) (numRecords int64, err error)
+	CountGroupedWhere(ctx context.Context, domainFilter *domain.DocumentFilter, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error)
+	CountGroupedAll(ctx context.Context, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error)
 	DoesExist(ctx context.Context, domainModel *domain.Document) (doesExist bool, err error)
 	DoesExistWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (doesExist bool, err error)
 	GetWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (records []*domain.Document, err error)
 	GetFirstWhere(ctx context.Context, domainFilter *domain.DocumentFilter) (record *domain.Document, err error)
 	GetAll(ctx context.Context) (records []*domain.Document, err error)
+	GetWhereSorted(ctx context.Context, domainFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error)
+	GetAllSorted(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error)
+	GetWhereSelected(ctx context.Context, domainFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error)
+	GetAllSelected(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error)
+	GetFirstWhereSelected(ctx context.Context, domainFilter *domain.DocumentFilter, domainSelector domain.DocumentMemberSelector) (record *domain.Document, err error)
 	GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Document, err error)
 
 	AddType(ctx context.Context, types []string) error
@@ -59,4 +67,7 @@ type DocumentRepository interface {
 
 	DocumentDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.DocumentFilter) (repositoryFilter any, err error)
 	DocumentDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.DocumentUpdater) (repositoryUpdater any, err error)
+	DocumentDomainToRepositorySorter(ctx context.Context, domainSorter domain.DocumentSorter) (repositorySorter any, err error)
+	DocumentDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.DocumentMemberSelector) (repositorySelector any, err error)
+	DocumentDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.DocumentGrouper) (repositoryGrouper any, err error)
 }
@@ -24,7 +24,7 @@ import (
 	"context"
 	"fmt"
 	"io/fs"
-	"os"
+	"path/filepath"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
 	commonRepo "github.com/JonasMuehlmann/bntp.go/model/repository"
@@ -34,14 +34,20 @@ import (
 	"github.com/spf13/afero"
 )
 
+// DefaultTrashDir is used as the trash area if no other one is configured.
+const DefaultTrashDir = ".bntp_trash"
+
 type FSDocumentContentRepositoryConstructorArgs struct {
 	Fs     afero.Fs
 	Logger *log.Logger
+	// TrashDir is the directory trashed contents are moved to, it defaults to DefaultTrashDir.
+	TrashDir string
 }
 
 type FSDocumentContentRepository struct {
-	Logger *log.Logger
-	fs     afero.Fs
+	Logger   *log.Logger
+	fs       afero.Fs
+	trashDir string
 }
 
 func (repo *FSDocumentContentRepository) New(args any) (commonRepo.DocumentContentRepository, error) {
@@ -52,6 +58,11 @@ func (repo *FSDocumentContentRepository) New(args any) (commonRepo.DocumentConte
 
 	repo.fs = constructorArgs.Fs
 	repo.Logger = constructorArgs.Logger
+	repo.trashDir = constructorArgs.TrashDir
+
+	if repo.trashDir == "" {
+		repo.trashDir = DefaultTrashDir
+	}
 
 	return repo, nil
 }
@@ -72,7 +83,7 @@ func (repo *FSDocumentContentRepository) Add(ctx context.Context, pathContents [
 	}
 
 	transformer := func(pathContent tuple.T2[string, string]) error {
-		doesExist, err := afero.Exists(repo.fs, pathContent.V1)
+		doesExist, err := repo.exists(ctx, pathContent.V1)
 		if err != nil {
 			return err
 		}
@@ -81,17 +92,7 @@ func (repo *FSDocumentContentRepository) Add(ctx context.Context, pathContents [
 			return helper.DuplicateInsertionError{Inner: fs.ErrExist}
 		}
 
-		file, err := repo.fs.Create(pathContent.V1)
-		if err != nil {
-			return err
-		}
-
-		_, err = file.WriteString(pathContent.V2)
-		if err != nil {
-			return err
-		}
-
-		return file.Close()
+		return repo.write(ctx, pathContent.V1, pathContent.V2)
 	}
 
 	return goaoi.ForeachSlice(pathContents, transformer)
@@ -113,17 +114,16 @@ func (repo *FSDocumentContentRepository) Update(ctx context.Context, pathContent
 	}
 
 	transformer := func(pathChange tuple.T2[string, string]) error {
-		file, err := repo.fs.OpenFile(pathChange.V1, os.O_WRONLY, 0o644)
+		doesExist, err := repo.exists(ctx, pathChange.V1)
 		if err != nil {
 			return err
 		}
 
-		_, err = file.WriteString(pathChange.V2)
-		if err != nil {
-			return err
+		if !doesExist {
+			return &fs.PathError{Op: "open", Path: pathChange.V1, Err: fs.ErrNotExist}
 		}
 
-		return file.Close()
+		return repo.write(ctx, pathChange.V1, pathChange.V2)
 	}
 
 	return goaoi.ForeachSlice(pathContents, transformer)
@@ -146,7 +146,7 @@ func (repo *FSDocumentContentRepository) Move(ctx context.Context, pathChanges [
 
 	transformer := func(pathChange tuple.T2[string, string]) error {
 
-		doesExist, err := afero.Exists(repo.fs, pathChange.V2)
+		doesExist, err := repo.exists(ctx, pathChange.V2)
 		if err != nil {
 			return err
 		}
@@ -155,7 +155,7 @@ func (repo *FSDocumentContentRepository) Move(ctx context.Context, pathChanges [
 			return helper.DuplicateInsertionError{Inner: fs.ErrExist}
 		}
 
-		return repo.fs.Rename(pathChange.V1, pathChange.V2)
+		return repo.move(ctx, pathChange.V1, pathChange.V2)
 	}
 
 	return goaoi.ForeachSlice(pathChanges, transformer)
@@ -168,7 +168,77 @@ func (repo *FSDocumentContentRepository) Delete(ctx context.Context, paths []str
 		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
 	}
 
-	return goaoi.ForeachSlice(paths, repo.fs.Remove)
+	return goaoi.ForeachSlice(paths, func(path string) error { return repo.remove(ctx, path) })
+}
+
+// Trash moves the contents at paths into the trash area, replacing contents trashed at the same paths before.
+// Paths without content are skipped, not every document has one.
+func (repo *FSDocumentContentRepository) Trash(ctx context.Context, paths []string) error {
+	if len(paths) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	transformer := func(path string) error {
+		doesExist, err := repo.exists(ctx, path)
+		if err != nil || !doesExist {
+			return err
+		}
+
+		return repo.move(ctx, path, repo.trashPath(path))
+	}
+
+	return goaoi.ForeachSlice(paths, transformer)
+}
+
+// Restore moves the trashed contents of paths back, paths without trashed content are skipped.
+func (repo *FSDocumentContentRepository) Restore(ctx context.Context, paths []string) error {
+	if len(paths) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	transformer := func(path string) error {
+		isTrashed, err := repo.exists(ctx, repo.trashPath(path))
+		if err != nil || !isTrashed {
+			return err
+		}
+
+		doesExist, err := repo.exists(ctx, path)
+		if err != nil {
+			return err
+		}
+
+		if doesExist {
+			return helper.DuplicateInsertionError{Inner: fs.ErrExist}
+		}
+
+		return repo.move(ctx, repo.trashPath(path), path)
+	}
+
+	return goaoi.ForeachSlice(paths, transformer)
+}
+
+// Purge deletes the trashed contents of paths, paths without trashed content are skipped.
+func (repo *FSDocumentContentRepository) Purge(ctx context.Context, paths []string) error {
+	if len(paths) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	transformer := func(path string) error {
+		isTrashed, err := repo.exists(ctx, repo.trashPath(path))
+		if err != nil || !isTrashed {
+			return err
+		}
+
+		return repo.remove(ctx, repo.trashPath(path))
+	}
+
+	return goaoi.ForeachSlice(paths, transformer)
 }
 
 func (repo *FSDocumentContentRepository) Get(ctx context.Context, paths []string) (contents []string, err error) {
@@ -179,6 +249,10 @@ func (repo *FSDocumentContentRepository) Get(ctx context.Context, paths []string
 	}
 
 	transformer := func(path string) (content string, err error) {
+		if stage, ok := repo.stage(ctx); ok {
+			return stage.read(path)
+		}
+
 		contentRaw, err := afero.ReadFile(repo.fs, path)
 		if err == nil {
 			content = string(contentRaw)
@@ -189,3 +263,84 @@ func (repo *FSDocumentContentRepository) Get(ctx context.Context, paths []string
 
 	return goaoi.TransformCopySlice(paths, transformer)
 }
+
+// stage returns the staged changes of the unit of work in ctx if there is one.
+func (repo *FSDocumentContentRepository) stage(ctx context.Context) (*stagedFiles, bool) {
+	unitOfWork, ok := commonRepo.UnitOfWorkFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	resource, err := unitOfWork.Join(repo.fs, func() (commonRepo.UnitOfWorkResource, error) { return newStagedFiles(repo.fs), nil })
+	if err != nil {
+		// The unit of work is completed, operations are not staged anymore
+		return nil, false
+	}
+
+	return resource.(*stagedFiles), true
+}
+
+func (repo *FSDocumentContentRepository) exists(ctx context.Context, path string) (bool, error) {
+	if stage, ok := repo.stage(ctx); ok {
+		return stage.exists(path)
+	}
+
+	return afero.Exists(repo.fs, path)
+}
+
+func (repo *FSDocumentContentRepository) write(ctx context.Context, path string, content string) error {
+	if stage, ok := repo.stage(ctx); ok {
+		stage.write(path, content)
+
+		return nil
+	}
+
+	return afero.WriteFile(repo.fs, path, []byte(content), 0o644)
+}
+
+func (repo *FSDocumentContentRepository) move(ctx context.Context, oldPath string, newPath string) error {
+	stage, ok := repo.stage(ctx)
+	if !ok {
+		err := repo.fs.MkdirAll(filepath.Dir(newPath), 0o755)
+		if err != nil {
+			return err
+		}
+
+		return repo.fs.Rename(oldPath, newPath)
+	}
+
+	content, err := stage.read(oldPath)
+	if err != nil {
+		return err
+	}
+
+	stage.remove(oldPath)
+	stage.write(newPath, content)
+
+	return nil
+}
+
+// trashPath returns the path in the trash area the content at path is moved to.
+func (repo *FSDocumentContentRepository) trashPath(path string) string {
+	return filepath.Join(repo.trashDir, path[len(filepath.VolumeName(path)):])
+}
+
+func (repo *FSDocumentContentRepository) remove(ctx context.Context, path string) error {
+	stage, ok := repo.stage(ctx)
+	if !ok {
+		return repo.fs.Remove(path)
+	}
+
+	doesExist, err := stage.exists(path)
+	if err != nil {
+		return err
+	}
+
+	if !doesExist {
+		return &fs.PathError{Op: "remove", Path: path, Err: fs.ErrNotExist}
+	}
+
+	stage.remove(path)
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/JonasMuehlmann/optional.go"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/afero"
+)
+
+// stagedFiles holds the file changes of a unit of work, which are only applied to fs on commit.
+// Reads through it see the staged changes.
+type stagedFiles struct {
+	fs    afero.Fs
+	mutex sync.Mutex
+	// Files without a content are removed.
+	contents map[string]optional.Optional[string]
+	paths    []string
+	// The files' state before the commit, used to revert it.
+	backups map[string]optional.Optional[string]
+}
+
+func newStagedFiles(fs afero.Fs) *stagedFiles {
+	return &stagedFiles{fs: fs, contents: make(map[string]optional.Optional[string])}
+}
+
+func (stage *stagedFiles) exists(path string) (bool, error) {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	if content, ok := stage.contents[path]; ok {
+		return content.HasValue, nil
+	}
+
+	return afero.Exists(stage.fs, path)
+}
+
+func (stage *stagedFiles) read(path string) (string, error) {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	if content, ok := stage.contents[path]; ok {
+		if !content.HasValue {
+			return "", &fs.PathError{Op: "open", Path: path, Err: fs.ErrNotExist}
+		}
+
+		return content.Wrappee, nil
+	}
+
+	content, err := afero.ReadFile(stage.fs, path)
+
+	return string(content), err
+}
+
+func (stage *stagedFiles) write(path string, content string) {
+	stage.set(path, optional.Make(content))
+}
+
+func (stage *stagedFiles) remove(path string) {
+	stage.set(path, optional.Optional[string]{})
+}
+
+func (stage *stagedFiles) set(path string, content optional.Optional[string]) {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	if _, ok := stage.contents[path]; !ok {
+		stage.paths = append(stage.paths, path)
+	}
+
+	stage.contents[path] = content
+}
+
+// Commit applies the staged changes, if this fails, the already applied ones are reverted.
+func (stage *stagedFiles) Commit() error {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	stage.backups = make(map[string]optional.Optional[string], len(stage.paths))
+
+	for _, path := range stage.paths {
+		backup, err := afero.ReadFile(stage.fs, path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return multierror.Append(err, stage.revert())
+		}
+
+		if err == nil {
+			stage.backups[path] = optional.Make(string(backup))
+		} else {
+			stage.backups[path] = optional.Optional[string]{}
+		}
+
+		err = stage.apply(path, stage.contents[path])
+		if err != nil {
+			return multierror.Append(err, stage.revert())
+		}
+	}
+
+	return nil
+}
+
+// Rollback discards the staged changes.
+func (stage *stagedFiles) Rollback() error {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	stage.contents = make(map[string]optional.Optional[string])
+	stage.paths = nil
+
+	return nil
+}
+
+// Revert restores the files changed by Commit.
+func (stage *stagedFiles) Revert() error {
+	stage.mutex.Lock()
+	defer stage.mutex.Unlock()
+
+	return stage.revert()
+}
+
+func (stage *stagedFiles) revert() (err error) {
+	for i := len(stage.paths) - 1; i >= 0; i-- {
+		backup, ok := stage.backups[stage.paths[i]]
+		if !ok {
+			continue
+		}
+
+		applyErr := stage.apply(stage.paths[i], backup)
+		if applyErr != nil {
+			err = multierror.Append(err, applyErr)
+		}
+	}
+
+	return
+}
+
+func (stage *stagedFiles) apply(path string, content optional.Optional[string]) error {
+	if content.HasValue {
+		err := stage.fs.MkdirAll(filepath.Dir(path), 0o755)
+		if err != nil {
+			return err
+		}
+
+		return afero.WriteFile(stage.fs, path, []byte(content.Wrappee), 0o644)
+	}
+
+	err := stage.fs.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/optional.go"
 	"github.com/volatiletech/null/v8"
 )
@@ -159,3 +160,169 @@ func (err ReferenceToNonExistentDependencyError) As(target any) bool {
 	}
 
 }
+
+//******************************************************************//
+//                       UnsortableFieldError                       //
+//******************************************************************//
+
+type UnsortableFieldError struct {
+	Field string
+}
+
+func (err UnsortableFieldError) Error() string {
+	return fmt.Sprintf("Can not sort by field %v", err.Field)
+}
+
+func (err UnsortableFieldError) Is(other error) bool {
+	switch other.(type) {
+	case UnsortableFieldError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnsortableFieldError) As(target any) bool {
+	switch target.(type) {
+	case UnsortableFieldError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                        InvalidLimiterError                       //
+//******************************************************************//
+
+type InvalidLimiterError struct {
+	Limiter model.Limiter
+}
+
+func (err InvalidLimiterError) Error() string {
+	return fmt.Sprintf("Limit and offset must not be negative, got limit %v and offset %v", err.Limiter.Limit, err.Limiter.Offset)
+}
+
+func (err InvalidLimiterError) Is(other error) bool {
+	switch other.(type) {
+	case InvalidLimiterError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err InvalidLimiterError) As(target any) bool {
+	switch target.(type) {
+	case InvalidLimiterError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                      UnselectableFieldError                      //
+//******************************************************************//
+
+type UnselectableFieldError struct {
+	Field string
+}
+
+func (err UnselectableFieldError) Error() string {
+	return fmt.Sprintf("Can not select field %v", err.Field)
+}
+
+func (err UnselectableFieldError) Is(other error) bool {
+	switch other.(type) {
+	case UnselectableFieldError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnselectableFieldError) As(target any) bool {
+	switch target.(type) {
+	case UnselectableFieldError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                       UngroupableFieldError                      //
+//******************************************************************//
+
+type UngroupableFieldError struct {
+	Field  string
+	Bucket model.TimeBucket
+}
+
+func (err UngroupableFieldError) Error() string {
+	if err.Bucket != 0 {
+		return fmt.Sprintf("Can not group field %v by %v", err.Field, err.Bucket)
+	}
+
+	return fmt.Sprintf("Can not group by field %v", err.Field)
+}
+
+func (err UngroupableFieldError) Is(other error) bool {
+	switch other.(type) {
+	case UngroupableFieldError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UngroupableFieldError) As(target any) bool {
+	switch target.(type) {
+	case UngroupableFieldError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
+
+//******************************************************************//
+//                  UnsupportedFilterOperationError                 //
+//******************************************************************//
+
+type UnsupportedFilterOperationError struct {
+	Field    string
+	Operator model.FilterOperator
+	Reason   string
+}
+
+func (err UnsupportedFilterOperationError) Error() string {
+	if err.Operator.String() == "" {
+		return fmt.Sprintf("Can not filter field %v with unknown operator %d", err.Field, err.Operator)
+	}
+
+	return fmt.Sprintf("Can not filter field %v with operator %v: %v", err.Field, err.Operator, err.Reason)
+}
+
+func (err UnsupportedFilterOperationError) Is(other error) bool {
+	switch other.(type) {
+	case UnsupportedFilterOperationError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnsupportedFilterOperationError) As(target any) bool {
+	switch target.(type) {
+	case UnsupportedFilterOperationError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+		return true
+	default:
+		return false
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
@@ -102,6 +103,10 @@ type DocumentFilter struct {
 	Tags                 optional.Optional[model.FilterOperation[*Tag]]
 	SourceDocuments      optional.Optional[model.FilterOperation[*Document]]
 	DestinationDocuments optional.Optional[model.FilterOperation[*Document]]
+
+	And []*DocumentFilter
+	Or  []*DocumentFilter
+	Not *DocumentFilter
 }
 
 type DocumentUpdater struct {
@@ -252,7 +257,56 @@ func TryUnwrapOptionalDocument(o any) any {
 	}
 }
 
-func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperation model.FilterOperation[T]) queryModSliceDocument {
+// TryUnwrapModelIDDocument returns the ID of related models and the input otherwise.
+func TryUnwrapModelIDDocument(o any) any {
+	switch t := o.(type) {
+	case *Tag:
+		return t.ID
+	case *Document:
+		return t.ID
+	default:
+		return o
+	}
+}
+
+// DocumentSetFilter holds the SQL conditions needed to filter a slice field by its members.
+// Member and RecursiveMember bind a single member, Empty binds nothing.
+// RecursiveMember also matches descendants of the member and is only set for hierarchical members.
+type DocumentSetFilter struct {
+	Member          string
+	RecursiveMember string
+	Empty           string
+}
+
+// documentTagPathContains matches tags whose path contains the bound tag ID.
+var documentTagPathContains = "';' + " + TagTableColumns.Path + " + ';' LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'"
+
+var documentSetFilters = map[DocumentField]DocumentSetFilter{
+	"Tags": {
+		Member:          DocumentTableColumns.ID + " IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + " WHERE " + TableNames.DocumentContexts + ".tag_id = ?)",
+		RecursiveMember: DocumentTableColumns.ID + " IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + " INNER JOIN " + TableNames.Tags + " ON " + TagTableColumns.ID + " = " + TableNames.DocumentContexts + ".tag_id WHERE " + documentTagPathContains + ")",
+		Empty:           DocumentTableColumns.ID + " NOT IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + ")",
+	},
+	"DestinationDocuments": {
+		Member: DocumentTableColumns.ID + " IN (SELECT " + TableNames.Links + ".source_id FROM " + TableNames.Links + " WHERE " + TableNames.Links + ".destination_id = ?)",
+		Empty:  DocumentTableColumns.ID + " NOT IN (SELECT " + TableNames.Links + ".source_id FROM " + TableNames.Links + ")",
+	},
+	"SourceDocuments": {
+		Member: DocumentTableColumns.ID + " IN (SELECT " + TableNames.Links + ".destination_id FROM " + TableNames.Links + " WHERE " + TableNames.Links + ".source_id = ?)",
+		Empty:  DocumentTableColumns.ID + " NOT IN (SELECT " + TableNames.Links + ".destination_id FROM " + TableNames.Links + ")",
+	},
+}
+
+func getSetFilterDocument(filterField DocumentField, filterOperator model.FilterOperator) (DocumentSetFilter, error) {
+	setFilter, ok := documentSetFilters[filterField]
+	if !ok {
+		return setFilter, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on slice fields"}
+	}
+
+	return setFilter, nil
+}
+
+func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperation model.FilterOperation[T]) (queryModSliceDocument, error) {
 	var newQueryMod queryModSliceDocument
 
 	filterOperator := filterOperation.Operator
@@ -261,7 +315,7 @@ func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperati
 	case model.FilterEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalDocument(filterOperand.Operand) {
@@ -272,7 +326,7 @@ func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperati
 	case model.FilterNEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalDocument(filterOperand.Operand) {
@@ -283,35 +337,35 @@ func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperati
 	case model.FilterGreaterThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" > ?", TryUnwrapOptionalDocument(filterOperand.Operand)))
 	case model.FilterGreaterThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" >= ?", TryUnwrapOptionalDocument(filterOperand.Operand)))
 	case model.FilterLessThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" < ?", TryUnwrapOptionalDocument(filterOperand.Operand)))
 	case model.FilterLessThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" <= ?", TryUnwrapOptionalDocument(filterOperand.Operand)))
 	case model.FilterIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -320,7 +374,7 @@ func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperati
 	case model.FilterNotIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterNotIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -329,82 +383,281 @@ func buildQueryModFilterDocument[T any](filterField DocumentField, filterOperati
 	case model.FilterBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterNotBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNotBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" LIKE ?", filterOperand.Operand))
 	case model.FilterNotLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT LIKE ?", filterOperand.Operand))
 	case model.FilterOr:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterOr operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
 		}
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterDocument(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(buildQueryModFilterDocument(filterField, filterOperand.RHS))))
+
+		lhsQueryMods, err := buildQueryModFilterDocument(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
+		}
+
+		rhsQueryMods, err := buildQueryModFilterDocument(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(rhsQueryMods)))
 	case model.FilterAnd:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterAnd operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+		}
+
+
+		lhsQueryMods, err := buildQueryModFilterDocument(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
+		}
+
+		rhsQueryMods, err := buildQueryModFilterDocument(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Expr(rhsQueryMods))
+	case model.FilterContains, model.FilterContainsAny, model.FilterNotContains,
+		model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+		setFilter, err := getSetFilterDocument(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		memberCondition := setFilter.Member
+
+		switch filterOperator {
+		case model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+			if setFilter.RecursiveMember == "" {
+				return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on hierarchical slice fields"}
+			}
+
+			memberCondition = setFilter.RecursiveMember
+		}
+
+		var members []any
+
+		switch filterOperand := filterOperation.Operand.(type) {
+		case model.ScalarOperand[T]:
+			members = append(members, TryUnwrapModelIDDocument(filterOperand.Operand))
+		case model.ListOperand[T]:
+			members, _ = goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return TryUnwrapModelIDDocument(a) })
+		default:
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar or list operand"}
+		}
+
+		var anyMemberQueryMod queryModSliceDocument
+
+		for _, member := range members {
+			switch {
+			case filterOperator == model.FilterContains, filterOperator == model.FilterContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where(memberCondition, member))
+			case filterOperator == model.FilterNotContains, filterOperator == model.FilterNotContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where("NOT ("+memberCondition+")", member))
+			case len(anyMemberQueryMod) == 0:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Where(memberCondition, member))
+			default:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Or(memberCondition, member))
+			}
+		}
+
+		if len(anyMemberQueryMod) > 0 {
+			newQueryMod = append(newQueryMod, qm.Expr(anyMemberQueryMod...))
+		}
+	case model.FilterEmpty:
+		setFilter, err := getSetFilterDocument(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Where(setFilter.Empty))
+	case model.FilterNotEmpty:
+		setFilter, err := getSetFilterDocument(filterField, filterOperator)
+		if err != nil {
+			return nil, err
 		}
 
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterDocument(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterDocument(filterField, filterOperand.RHS)))
+		newQueryMod = append(newQueryMod, qm.Where("NOT ("+setFilter.Empty+")"))
 	default:
-		panic("Unhandled FilterOperator")
+		return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "unknown operator"}
 	}
 
-	return newQueryMod
+	return newQueryMod, nil
 }
 
-func buildQueryModListFromFilterDocument(filter *DocumentFilter) queryModSliceDocument {
+func buildQueryModListFromFilterDocument(filter *DocumentFilter) (queryModSliceDocument, error) {
 	queryModList := make(queryModSliceDocument, 0, 6)
 
 	if filter.CreatedAt.HasValue {
-		newQueryMod := buildQueryModFilterDocument("CreatedAt", filter.CreatedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("CreatedAt", filter.CreatedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.UpdatedAt.HasValue {
-		newQueryMod := buildQueryModFilterDocument("UpdatedAt", filter.UpdatedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("UpdatedAt", filter.UpdatedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.DeletedAt.HasValue {
-		newQueryMod := buildQueryModFilterDocument("DeletedAt", filter.DeletedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("DeletedAt", filter.DeletedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.Path.HasValue {
-		newQueryMod := buildQueryModFilterDocument("Path", filter.Path.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("Path", filter.Path.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.DocumentTypeID.HasValue {
-		newQueryMod := buildQueryModFilterDocument("DocumentTypeID", filter.DocumentTypeID.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("DocumentTypeID", filter.DocumentTypeID.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.ID.HasValue {
-		newQueryMod := buildQueryModFilterDocument("ID", filter.ID.Wrappee)
+		newQueryMod, err := buildQueryModFilterDocument("ID", filter.ID.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, newQueryMod...)
+	}
+
+	if filter.Tags.HasValue {
+		newQueryMod, err := buildQueryModFilterDocument("Tags", filter.Tags.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, newQueryMod...)
+	}
+	if filter.SourceDocuments.HasValue {
+		newQueryMod, err := buildQueryModFilterDocument("SourceDocuments", filter.SourceDocuments.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, newQueryMod...)
+	}
+	if filter.DestinationDocuments.HasValue {
+		newQueryMod, err := buildQueryModFilterDocument("DestinationDocuments", filter.DestinationDocuments.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 
-	return queryModList
+	//*********************    Composed Filters    *********************//
+	for _, subfilter := range filter.And {
+		subfilterQueryMods, err := buildQueryModListFromFilterDocument(subfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subfilterQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(subfilterQueryMods...))
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		anyQueryMods := make(queryModSliceDocument, 0, len(filter.Or))
+
+		for _, subfilter := range filter.Or {
+			subfilterQueryMods, err := buildQueryModListFromFilterDocument(subfilter)
+			if err != nil {
+				return nil, err
+			}
+
+			// An empty subfilter matches everything, so does the whole disjunction
+			if len(subfilterQueryMods) == 0 {
+				anyQueryMods = nil
+
+				break
+			}
+
+			if len(anyQueryMods) == 0 {
+				anyQueryMods = append(anyQueryMods, qm.Expr(subfilterQueryMods...))
+			} else {
+				anyQueryMods = append(anyQueryMods, qm.Or2(qm.Expr(subfilterQueryMods...)))
+			}
+		}
+
+		if len(anyQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(anyQueryMods...))
+		}
+	}
+
+	if filter.Not != nil {
+		notQueryMods, err := buildQueryModListFromFilterDocument(filter.Not)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, buildQueryModNotDocument(notQueryMods))
+	}
+
+	return queryModList, nil
+}
+
+// buildQueryModNotDocument negates queryMods by excluding the IDs they select in a subquery,
+// query mods can not be wrapped in a NOT directly.
+func buildQueryModNotDocument(queryMods queryModSliceDocument) qm.QueryMod {
+	// Placeholders are numbered once the outer query is built
+	subqueryDialect := dialect
+	subqueryDialect.UseIndexPlaceholders = false
+
+	subquery := &queries.Query{}
+	queries.SetDialect(subquery, &subqueryDialect)
+	queries.SetFrom(subquery, TableNames.Documents)
+	queries.SetSelect(subquery, []string{DocumentTableColumns.ID})
+	qm.Apply(subquery, queryMods...)
+
+	subquerySQL, args := queries.BuildQuery(subquery)
+
+	return qm.Where(DocumentTableColumns.ID+" NOT IN ("+strings.TrimSuffix(subquerySQL, ";")+")", args...)
 }
 
 type MssqlDocumentRepositoryConstructorArgs struct {
@@ -434,6 +687,11 @@ func (repo *MssqlDocumentRepository) New(args any) (newRepo repoCommon.DocumentR
 	return
 }
 
+// executor returns the transaction of the unit of work in ctx if there is one.
+func (repo *MssqlDocumentRepository) executor(ctx context.Context) boil.ContextExecutor {
+	return repoCommon.Executor(ctx, repo.db)
+}
+
 //******************************************************************//
 //                              Methods                             //
 //******************************************************************//
@@ -458,7 +716,7 @@ func (repo *MssqlDocumentRepository) Add(ctx context.Context, domainModels []*do
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -479,7 +737,7 @@ func (repo *MssqlDocumentRepository) Add(ctx context.Context, domainModels []*do
 		return err
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -505,7 +763,7 @@ func (repo *MssqlDocumentRepository) AddMinimal(ctx context.Context, domainModel
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -520,7 +778,7 @@ func (repo *MssqlDocumentRepository) AddMinimal(ctx context.Context, domainModel
 		return
 	}
 
-	for _, repositoryModel := range repositoryModels {
+	for i, repositoryModel := range repositoryModels {
 		repoModel, ok := repositoryModel.(*Document)
 		if !ok {
 			err = fmt.Errorf("expected type *Document but got %T", repoModel)
@@ -538,10 +796,13 @@ func (repo *MssqlDocumentRepository) AddMinimal(ctx context.Context, domainModel
 
 			return
 		}
+
+		// Inserting fills in generated IDs, which later operations on the domain models depend on
+		domainModels[i].ID = repoModel.ID
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -569,7 +830,7 @@ func (repo *MssqlDocumentRepository) ReplaceTx(ctx context.Context, domainModels
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -631,7 +892,7 @@ func (repo *MssqlDocumentRepository) ReplaceTx(ctx context.Context, domainModels
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -665,7 +926,7 @@ func (repo *MssqlDocumentRepository) Replace(ctx context.Context, domainModels [
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -719,7 +980,7 @@ func (repo *MssqlDocumentRepository) Replace(ctx context.Context, domainModels [
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -751,7 +1012,7 @@ func (repo *MssqlDocumentRepository) Upsert(ctx context.Context, domainModels []
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -784,7 +1045,7 @@ func (repo *MssqlDocumentRepository) Upsert(ctx context.Context, domainModels []
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -838,7 +1099,7 @@ func (repo *MssqlDocumentRepository) Update(ctx context.Context, domainModels []
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -891,7 +1152,7 @@ func (repo *MssqlDocumentRepository) Update(ctx context.Context, domainModels []
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -954,9 +1215,14 @@ func (repo *MssqlDocumentRepository) UpdateWhere(ctx context.Context, domainColu
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	modelsToUpdate, err = Documents(queryFilters...).All(ctx, repo.db)
+	modelsToUpdate, err = Documents(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		return
 	}
@@ -971,7 +1237,7 @@ func (repo *MssqlDocumentRepository) UpdateWhere(ctx context.Context, domainColu
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -998,7 +1264,7 @@ func (repo *MssqlDocumentRepository) UpdateWhere(ctx context.Context, domainColu
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	numAffectedRecords = int64(len(modelsToUpdate))
 
@@ -1032,7 +1298,7 @@ func (repo *MssqlDocumentRepository) Delete(ctx context.Context, domainModels []
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -1070,7 +1336,7 @@ func (repo *MssqlDocumentRepository) Delete(ctx context.Context, domainModels []
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1100,18 +1366,23 @@ func (repo *MssqlDocumentRepository) DeleteWhere(ctx context.Context, domainColu
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
 
 	numAffectedRecords, err = Documents(queryFilters...).DeleteAll(ctx, tx)
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1141,13 +1412,113 @@ func (repo *MssqlDocumentRepository) CountWhere(ctx context.Context, domainColum
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	return Documents(queryFilters...).Count(ctx, repo.db)
+	return Documents(queryFilters...).Count(ctx, repo.executor(ctx))
 }
 
 func (repo *MssqlDocumentRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
-	return Documents().Count(ctx, repo.db)
+	return Documents().Count(ctx, repo.executor(ctx))
+}
+
+func (repo *MssqlDocumentRepository) CountGroupedWhere(ctx context.Context, domainColumnFilter *domain.DocumentFilter, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.DocumentDomainToRepositoryFilter(ctx, domainColumnFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*DocumentFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *DocumentFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	return repo.countGrouped(ctx, queryFilters, domainGrouper)
+}
+
+func (repo *MssqlDocumentRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	return repo.countGrouped(ctx, queryModSliceDocument{}, domainGrouper)
+}
+
+func (repo *MssqlDocumentRepository) countGrouped(ctx context.Context, queryMods queryModSliceDocument, domainGrouper *domain.DocumentGrouper) (groups []*model.Group, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryGrouper any
+	repositoryGrouper, err = repo.DocumentDomainToRepositoryGrouper(ctx, domainGrouper)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	grouping, ok := repositoryGrouper.(*DocumentGrouping)
+	if !ok {
+		err = fmt.Errorf("expected type *DocumentGrouping but got %T", repositoryGrouper)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if grouping.Join != "" {
+		queryMods = append(queryMods, qm.LeftOuterJoin(grouping.Join))
+	}
+
+	queryMods = append(queryMods,
+		qm.Select(grouping.Key+" AS group_key", "COUNT(DISTINCT "+DocumentTableColumns.ID+") AS group_count"),
+		qm.GroupBy(grouping.GroupBy),
+		qm.OrderBy("group_key"),
+	)
+
+	var rows []struct {
+		Key   null.String `boil:"group_key"`
+		Count int64       `boil:"group_count"`
+	}
+
+	err = Documents(queryMods...).Bind(ctx, repo.executor(ctx), &rows)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	groups = make([]*model.Group, 0, len(rows))
+
+	for _, row := range rows {
+		groups = append(groups, &model.Group{Key: row.Key.String, Count: row.Count})
+	}
+
+	return
 }
 
 func (repo *MssqlDocumentRepository) DoesExist(ctx context.Context, domainModel *domain.Document) (doesExist bool, err error) {
@@ -1175,7 +1546,7 @@ func (repo *MssqlDocumentRepository) DoesExist(ctx context.Context, domainModel
 		return
 	}
 
-	return DocumentExists(ctx, repo.db, repoModel.ID)
+	return DocumentExists(ctx, repo.executor(ctx), repoModel.ID)
 }
 
 func (repo *MssqlDocumentRepository) DoesExistWhere(ctx context.Context, domainColumnFilter *domain.DocumentFilter) (doesExist bool, err error) {
@@ -1203,9 +1574,14 @@ func (repo *MssqlDocumentRepository) DoesExistWhere(ctx context.Context, domainC
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	return Documents(queryFilters...).Exists(ctx, repo.db)
+	return Documents(queryFilters...).Exists(ctx, repo.executor(ctx))
 }
 
 func (repo *MssqlDocumentRepository) GetWhere(ctx context.Context, domainColumnFilter *domain.DocumentFilter) (records []*domain.Document, err error) {
@@ -1233,10 +1609,15 @@ func (repo *MssqlDocumentRepository) GetWhere(ctx context.Context, domainColumnF
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryModels DocumentSlice
-	repositoryModels, err = Documents(queryFilters...).All(ctx, repo.db)
+	repositoryModels, err = Documents(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1251,7 +1632,7 @@ func (repo *MssqlDocumentRepository) GetWhere(ctx context.Context, domainColumnF
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1267,7 +1648,7 @@ func (repo *MssqlDocumentRepository) GetWhere(ctx context.Context, domainColumnF
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1292,6 +1673,10 @@ func (repo *MssqlDocumentRepository) GetWhere(ctx context.Context, domainColumnF
 }
 
 func (repo *MssqlDocumentRepository) GetFirstWhere(ctx context.Context, domainColumnFilter *domain.DocumentFilter) (record *domain.Document, err error) {
+	return repo.GetFirstWhereSelected(ctx, domainColumnFilter, nil)
+}
+
+func (repo *MssqlDocumentRepository) GetFirstWhereSelected(ctx context.Context, domainColumnFilter *domain.DocumentFilter, domainSelector domain.DocumentMemberSelector) (record *domain.Document, err error) {
 	if domainColumnFilter == nil {
 		err = helper.NilInputError{}
 		repo.Logger.Error(err)
@@ -1316,10 +1701,36 @@ func (repo *MssqlDocumentRepository) GetFirstWhere(ctx context.Context, domainCo
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositorySelector any
+	repositorySelector, err = repo.DocumentDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*DocumentSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *DocumentSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryFilters = append(queryFilters, qm.Select(selection.Columns...))
+	}
 
 	var repositoryModel *Document
-	repositoryModel, err = Documents(queryFilters...).One(ctx, repo.db)
+	repositoryModel, err = Documents(queryFilters...).One(ctx, repo.executor(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1330,21 +1741,22 @@ func (repo *MssqlDocumentRepository) GetFirstWhere(ctx context.Context, domainCo
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repo.LoadEntityRelations(ctx, tx, repositoryModel)
+	err = repo.loadSelectedEntityRelations(ctx, tx, repositoryModel, selection)
 	if err != nil {
 		repo.Logger.Error(err)
+		repoCommon.RollbackTx(ctx, tx)
 
 		return
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1358,7 +1770,7 @@ func (repo *MssqlDocumentRepository) GetFirstWhere(ctx context.Context, domainCo
 
 func (repo *MssqlDocumentRepository) GetAll(ctx context.Context) (records []*domain.Document, err error) {
 	var repositoryModels DocumentSlice
-	repositoryModels, err = Documents().All(ctx, repo.db)
+	repositoryModels, err = Documents().All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1372,7 +1784,7 @@ func (repo *MssqlDocumentRepository) GetAll(ctx context.Context) (records []*dom
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1388,7 +1800,173 @@ func (repo *MssqlDocumentRepository) GetAll(ctx context.Context) (records []*dom
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	records = make([]*domain.Document, 0, len(repositoryModels))
+
+	var domainModel *domain.Document
+	for _, repoModel := range repositoryModels {
+		domainModel, err = repo.DocumentRepositoryToDomainModel(ctx, repoModel)
+		if err != nil {
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		records = append(records, domainModel)
+	}
+
+	return
+}
+
+func (repo *MssqlDocumentRepository) GetWhereSorted(ctx context.Context, domainColumnFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	return repo.GetWhereSelected(ctx, domainColumnFilter, domainSorter, limiter, nil)
+}
+
+func (repo *MssqlDocumentRepository) GetAllSorted(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter) (records []*domain.Document, err error) {
+	return repo.GetAllSelected(ctx, domainSorter, limiter, nil)
+}
+
+func (repo *MssqlDocumentRepository) GetWhereSelected(ctx context.Context, domainColumnFilter *domain.DocumentFilter, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.DocumentDomainToRepositoryFilter(ctx, domainColumnFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*DocumentFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *DocumentFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	return repo.getSelected(ctx, queryFilters, domainSorter, limiter, domainSelector)
+}
+
+func (repo *MssqlDocumentRepository) GetAllSelected(ctx context.Context, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	return repo.getSelected(ctx, queryModSliceDocument{}, domainSorter, limiter, domainSelector)
+}
+
+func (repo *MssqlDocumentRepository) getSelected(ctx context.Context, queryMods queryModSliceDocument, domainSorter domain.DocumentSorter, limiter *model.Limiter, domainSelector domain.DocumentMemberSelector) (records []*domain.Document, err error) {
+	var repositorySelector any
+	repositorySelector, err = repo.DocumentDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*DocumentSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *DocumentSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryMods = append(queryMods, qm.Select(selection.Columns...))
+	}
+
+	var repositorySorter any
+	repositorySorter, err = repo.DocumentDomainToRepositorySorter(ctx, domainSorter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	orderByClauses, ok := repositorySorter.([]string)
+	if !ok {
+		err = fmt.Errorf("expected type []string but got %T", repositorySorter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryMods = append(queryMods, qm.OrderBy(strings.Join(orderByClauses, ", ")))
+
+	if limiter != nil {
+		if limiter.Limit < 0 || limiter.Offset < 0 {
+			err = repoCommon.InvalidLimiterError{Limiter: *limiter}
+
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		if limiter.Limit > 0 {
+			queryMods = append(queryMods, qm.Limit(int(limiter.Limit)))
+		} else if limiter.Offset > 0 {
+			// Most DBMS' do not support an offset without a limit
+			queryMods = append(queryMods, qm.Limit(math.MaxInt32))
+		}
+
+		if limiter.Offset > 0 {
+			queryMods = append(queryMods, qm.Offset(int(limiter.Offset)))
+		}
+	}
+
+	var repositoryModels DocumentSlice
+	repositoryModels, err = Documents(queryMods...).All(ctx, repo.executor(ctx))
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if len(repositoryModels) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	for _, repoModel := range repositoryModels {
+		err = repo.loadSelectedEntityRelations(ctx, tx, repoModel, selection)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+	}
+
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1432,10 +2010,15 @@ func (repo *MssqlDocumentRepository) GetFromIDs(ctx context.Context, IDs []int64
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterDocument(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterDocument(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryModels DocumentSlice
-	repositoryModels, err = Documents(queryFilters...).All(ctx, repo.db)
+	repositoryModels, err = Documents(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1450,7 +2033,7 @@ func (repo *MssqlDocumentRepository) GetFromIDs(ctx context.Context, IDs []int64
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1466,7 +2049,7 @@ func (repo *MssqlDocumentRepository) GetFromIDs(ctx context.Context, IDs []int64
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1504,7 +2087,7 @@ func (repo *MssqlDocumentRepository) AddType(ctx context.Context, types []string
 	for _, type_ := range types {
 		repositoryModel := DocumentType{DocumentType: type_}
 
-		err = repositoryModel.Insert(ctx, repo.db, boil.Infer())
+		err = repositoryModel.Insert(ctx, repo.executor(ctx), boil.Infer())
 		if err != nil {
 			if strings.Contains(err.Error(), "UNIQUE") {
 				err = helper.DuplicateInsertionError{Inner: err}
@@ -1532,7 +2115,7 @@ func (repo *MssqlDocumentRepository) DeleteType(ctx context.Context, types []str
 
 	var numAffectedRecords int64
 
-	numAffectedRecords, err = DocumentTypes(DocumentTypeWhere.DocumentType.IN(types)).DeleteAll(ctx, repo.db)
+	numAffectedRecords, err = DocumentTypes(DocumentTypeWhere.DocumentType.IN(types)).DeleteAll(ctx, repo.executor(ctx))
 	if numAffectedRecords == 0 {
 		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
 
@@ -1546,7 +2129,7 @@ func (repo *MssqlDocumentRepository) DeleteType(ctx context.Context, types []str
 
 func (repo *MssqlDocumentRepository) UpdateType(ctx context.Context, oldType string, newType string) (err error) {
 	var repositoryModel *DocumentType
-	repositoryModel, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(oldType)).One(ctx, repo.db)
+	repositoryModel, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(oldType)).One(ctx, repo.executor(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1560,7 +2143,7 @@ func (repo *MssqlDocumentRepository) UpdateType(ctx context.Context, oldType str
 	repositoryModel.DocumentType = newType
 
 	var numAffectedRecords int64
-	numAffectedRecords, err = repositoryModel.Update(ctx, repo.db, boil.Infer())
+	numAffectedRecords, err = repositoryModel.Update(ctx, repo.executor(ctx), boil.Infer())
 	if err != nil {
 		if strings.Contains(err.Error(), "UNIQUE") {
 			err = helper.DuplicateInsertionError{Inner: err}
@@ -1573,7 +2156,7 @@ func (repo *MssqlDocumentRepository) UpdateType(ctx context.Context, oldType str
 
 	if numAffectedRecords == 0 {
 		var doesExist bool
-		doesExist, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(oldType)).Exists(ctx, repo.db)
+		doesExist, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(oldType)).Exists(ctx, repo.executor(ctx))
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -1594,7 +2177,7 @@ func (repo *MssqlDocumentRepository) UpdateType(ctx context.Context, oldType str
 
 func (repo *MssqlDocumentRepository) GetAllTypes(ctx context.Context) (records []string, err error) {
 	var repositoryModels []*DocumentType
-	repositoryModels, err = DocumentTypes().All(ctx, repo.db)
+	repositoryModels, err = DocumentTypes().All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1688,7 +2271,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryModel(ctx context
 	var repositoryTag *Tag
 
 	for _, modelTagID := range domainModel.TagIDs {
-		repositoryTag, err = Tags(TagWhere.ID.EQ(modelTagID)).One(ctx, repo.db)
+		repositoryTag, err = Tags(TagWhere.ID.EQ(modelTagID)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1703,7 +2286,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryModel(ctx context
 
 	if domainModel.DocumentType.HasValue {
 		repositoryModelConcrete.R.DocumentType = &DocumentType{DocumentType: domainModel.DocumentType.Wrappee}
-		repositoryDocumentType, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(domainModel.DocumentType.Wrappee)).One(ctx, repo.db)
+		repositoryDocumentType, err = DocumentTypes(DocumentTypeWhere.DocumentType.EQ(domainModel.DocumentType.Wrappee)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1722,7 +2305,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryModel(ctx context
 	var repositoryDocumentRaw any
 
 	for _, link := range domainModel.LinkedDocumentIDs {
-		repositoryDocumentRaw, err = Documents(DocumentWhere.ID.EQ(link)).One(ctx, repo.db)
+		repositoryDocumentRaw, err = Documents(DocumentWhere.ID.EQ(link)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1735,7 +2318,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryModel(ctx context
 	}
 
 	for _, backlink := range domainModel.BacklinkedDocumentsIDs {
-		repositoryDocumentRaw, err = Documents(DocumentWhere.ID.EQ(backlink)).One(ctx, repo.db)
+		repositoryDocumentRaw, err = Documents(DocumentWhere.ID.EQ(backlink)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2019,7 +2602,8 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 	if domainFilter.TagIDs.HasValue {
 		var convertedFilter model.FilterOperation[*Tag]
 
-		converter := func(tagID int64) (*Tag, error) { return Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db) }
+		// Only the IDs are needed to filter through the join tables
+		converter := func(tagID int64) (*Tag, error) { return &Tag{ID: tagID}, nil }
 		convertedFilter, err = model.ConvertFilter[*Tag, int64](domainFilter.TagIDs.Wrappee, converter)
 		if err != nil {
 			repo.Logger.Error(err)
@@ -2039,7 +2623,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 				return null.NewInt64(-1, false), nil
 			}
 
-			bookmarkType, err := DocumentTypes(DocumentTypeWhere.DocumentType.EQ(type_.Wrappee)).One(ctx, repo.db)
+			bookmarkType, err := DocumentTypes(DocumentTypeWhere.DocumentType.EQ(type_.Wrappee)).One(ctx, repo.executor(ctx))
 
 			return null.NewInt64(bookmarkType.ID, true), err
 		})
@@ -2056,9 +2640,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 	if domainFilter.LinkedDocumentIDs.HasValue {
 		var convertedFilter model.FilterOperation[*Document]
 
-		converter := func(documentID int64) (*Document, error) {
-			return Documents(DocumentWhere.ID.EQ(documentID)).One(ctx, repo.db)
-		}
+		converter := func(documentID int64) (*Document, error) { return &Document{ID: documentID}, nil }
 		convertedFilter, err = model.ConvertFilter[*Document, int64](domainFilter.LinkedDocumentIDs.Wrappee, converter)
 		if err != nil {
 			repo.Logger.Error(err)
@@ -2066,14 +2648,12 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 			return
 		}
 
-		repositoryFilterConcrete.SourceDocuments.Set(convertedFilter)
+		repositoryFilterConcrete.DestinationDocuments.Set(convertedFilter)
 	}
 	if domainFilter.BacklinkedDocumentsIDs.HasValue {
 		var convertedFilter model.FilterOperation[*Document]
 
-		converter := func(documentID int64) (*Document, error) {
-			return Documents(DocumentWhere.ID.EQ(documentID)).One(ctx, repo.db)
-		}
+		converter := func(documentID int64) (*Document, error) { return &Document{ID: documentID}, nil }
 		convertedFilter, err = model.ConvertFilter[*Document, int64](domainFilter.BacklinkedDocumentsIDs.Wrappee, converter)
 		if err != nil {
 			repo.Logger.Error(err)
@@ -2081,7 +2661,12 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 			return
 		}
 
-		repositoryFilterConcrete.DestinationDocuments.Set(convertedFilter)
+		repositoryFilterConcrete.SourceDocuments.Set(convertedFilter)
+	}
+
+	err = repo.DocumentDomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+	if err != nil {
+		return
 	}
 
 	repositoryFilter = repositoryFilterConcrete
@@ -2089,6 +2674,55 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryFilter(ctx contex
 	return
 }
 
+// DocumentDomainToRepositoryComposedFilters converts the And, Or and Not subfilters of domainFilter into repositoryFilter.
+func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryComposedFilters(ctx context.Context, domainFilter *domain.DocumentFilter, repositoryFilter *DocumentFilter) (err error) {
+	convertSubfilter := func(domainSubfilter *domain.DocumentFilter) (*DocumentFilter, error) {
+		if domainSubfilter == nil {
+			err := helper.NilInputError{}
+			repo.Logger.Error(err)
+
+			return nil, err
+		}
+
+		repositorySubfilter, err := repo.DocumentDomainToRepositoryFilter(ctx, domainSubfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		return repositorySubfilter.(*DocumentFilter), nil
+	}
+
+	repositoryFilter.And = make([]*DocumentFilter, 0, len(domainFilter.And))
+	for _, domainSubfilter := range domainFilter.And {
+		var repositorySubfilter *DocumentFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.And = append(repositoryFilter.And, repositorySubfilter)
+	}
+
+	repositoryFilter.Or = make([]*DocumentFilter, 0, len(domainFilter.Or))
+	for _, domainSubfilter := range domainFilter.Or {
+		var repositorySubfilter *DocumentFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.Or = append(repositoryFilter.Or, repositorySubfilter)
+	}
+
+	if domainFilter.Not != nil {
+		repositoryFilter.Not, err = convertSubfilter(domainFilter.Not)
+	}
+
+	return
+}
+
 //******************************************************************//
 //                         Updater Converter                        //
 //******************************************************************//
@@ -2131,7 +2765,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryUpdater(ctx conte
 			return
 		}
 
-		repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedTime})
+		repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedTime})
 
 	}
 
@@ -2140,7 +2774,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryUpdater(ctx conte
 		convertedUpdater := make(TagSlice, 0, len(domainUpdater.TagIDs.Wrappee.Operand))
 
 		for _, tag := range domainUpdater.TagIDs.Wrappee.Operand {
-			rawTag, err = Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.db)
+			rawTag, err = Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				repo.Logger.Error(err)
 
@@ -2158,7 +2792,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryUpdater(ctx conte
 		convertedUpdater := make(DocumentSlice, 0, len(domainUpdater.LinkedDocumentIDs.Wrappee.Operand))
 
 		for _, document := range domainUpdater.LinkedDocumentIDs.Wrappee.Operand {
-			convertedDocumentRaw, err = Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.db)
+			convertedDocumentRaw, err = Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				repo.Logger.Error(err)
 
@@ -2176,7 +2810,7 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryUpdater(ctx conte
 		convertedUpdater := make(DocumentSlice, 0, len(domainUpdater.BacklinkedDocumentsIDs.Wrappee.Operand))
 
 		for _, document := range domainUpdater.BacklinkedDocumentsIDs.Wrappee.Operand {
-			convertedDocumentRaw, err = Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.db)
+			convertedDocumentRaw, err = Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				repo.Logger.Error(err)
 
@@ -2198,6 +2832,189 @@ func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryUpdater(ctx conte
 	return
 }
 
+//******************************************************************//
+//                         Sorter Converter                         //
+//******************************************************************//
+
+var documentSortExpressions = map[domain.DocumentField]string{
+	"CreatedAt":    DocumentTableColumns.CreatedAt,
+	"UpdatedAt":    DocumentTableColumns.UpdatedAt,
+	"DeletedAt":    DocumentTableColumns.DeletedAt,
+	"Path":         DocumentTableColumns.Path,
+	"ID":           DocumentTableColumns.ID,
+	"DocumentType": "(SELECT " + DocumentTypeTableColumns.DocumentType + " FROM " + TableNames.DocumentTypes + " WHERE " + DocumentTypeTableColumns.ID + " = " + DocumentTableColumns.DocumentTypeID + ")",
+}
+
+func (repo *MssqlDocumentRepository) DocumentDomainToRepositorySorter(ctx context.Context, domainSorter domain.DocumentSorter) (repositorySorter any, err error) {
+	orderByClauses := make([]string, 0, len(domainSorter)+1)
+	isSortedByID := false
+
+	for _, sortKey := range domainSorter {
+		expression, ok := documentSortExpressions[sortKey.Field]
+		if !ok {
+			err = repoCommon.UnsortableFieldError{Field: string(sortKey.Field)}
+
+			return
+		}
+
+		isSortedByID = isSortedByID || sortKey.Field == "ID"
+
+		if sortKey.Direction == model.SortDescending {
+			orderByClauses = append(orderByClauses, expression+" DESC")
+		} else {
+			orderByClauses = append(orderByClauses, expression+" ASC")
+		}
+	}
+
+	// Breaking ties by the primary key gives a total order, which is needed for stable pagination
+	if !isSortedByID {
+		orderByClauses = append(orderByClauses, DocumentTableColumns.ID+" ASC")
+	}
+
+	repositorySorter = orderByClauses
+
+	return
+}
+
+//******************************************************************//
+//                     Member Selector Converter                    //
+//******************************************************************//
+
+// DocumentSelection holds the columns and relations to load for a domain.DocumentMemberSelector.
+type DocumentSelection struct {
+	Columns   []string
+	Relations []string
+}
+
+var documentMemberSelections = map[domain.DocumentField]DocumentSelection{
+	"CreatedAt":              {Columns: []string{DocumentTableColumns.CreatedAt}},
+	"UpdatedAt":              {Columns: []string{DocumentTableColumns.UpdatedAt}},
+	"DeletedAt":              {Columns: []string{DocumentTableColumns.DeletedAt}},
+	"Path":                   {Columns: []string{DocumentTableColumns.Path}},
+	"ID":                     {Columns: []string{DocumentTableColumns.ID}},
+	"TagIDs":                 {Relations: []string{DocumentRels.Tags}},
+	"LinkedDocumentIDs":      {Relations: []string{DocumentRels.DestinationDocuments}},
+	"BacklinkedDocumentsIDs": {Relations: []string{DocumentRels.SourceDocuments}},
+	"DocumentType":           {Columns: []string{DocumentTableColumns.DocumentTypeID}, Relations: []string{DocumentRels.DocumentType}},
+}
+
+func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.DocumentMemberSelector) (repositorySelector any, err error) {
+	if len(domainSelector) == 0 {
+		repositorySelector = (*DocumentSelection)(nil)
+
+		return
+	}
+
+	// The primary key is always needed to load relations
+	selection := &DocumentSelection{Columns: []string{DocumentTableColumns.ID}}
+	isSelected := map[string]bool{DocumentTableColumns.ID: true}
+
+	for _, field := range domainSelector {
+		fieldSelection, ok := documentMemberSelections[field]
+		if !ok {
+			err = repoCommon.UnselectableFieldError{Field: string(field)}
+
+			return
+		}
+
+		for _, column := range fieldSelection.Columns {
+			if !isSelected[column] {
+				isSelected[column] = true
+				selection.Columns = append(selection.Columns, column)
+			}
+		}
+
+		for _, relation := range fieldSelection.Relations {
+			if !isSelected[relation] {
+				isSelected[relation] = true
+				selection.Relations = append(selection.Relations, relation)
+			}
+		}
+	}
+
+	repositorySelector = selection
+
+	return
+}
+
+//******************************************************************//
+//                         Grouper Converter                        //
+//******************************************************************//
+
+// DocumentGrouping holds the SQL expressions needed to group by a domain.DocumentGrouper.
+// Key is selected as the groups' key and must be functionally dependent on GroupBy,
+// Join is left joined to the documents if set.
+type DocumentGrouping struct {
+	GroupBy string
+	Key     string
+	Join    string
+}
+
+var documentGroupings = map[domain.DocumentField]DocumentGrouping{
+	"TagIDs":       {GroupBy: TableNames.DocumentContexts + ".tag_id", Join: TableNames.DocumentContexts + " ON " + TableNames.DocumentContexts + ".document_id = " + DocumentTableColumns.ID},
+	"DocumentType": {GroupBy: DocumentTableColumns.DocumentTypeID, Key: "(SELECT " + DocumentTypeTableColumns.DocumentType + " FROM " + TableNames.DocumentTypes + " WHERE " + DocumentTypeTableColumns.ID + " = " + DocumentTableColumns.DocumentTypeID + ")"},
+}
+
+var documentTimestampColumns = map[domain.DocumentField]string{
+	"CreatedAt": DocumentTableColumns.CreatedAt,
+	"UpdatedAt": DocumentTableColumns.UpdatedAt,
+	"DeletedAt": DocumentTableColumns.DeletedAt,
+}
+
+func documentTimeBucketExpression(column string, bucket model.TimeBucket) (expression string, ok bool) {
+	switch bucket {
+	case 0, model.BucketDay:
+		return "CONVERT(varchar(10), " + column + ", 23)", true
+	case model.BucketWeek:
+		// Day 0 is a monday
+		return "CONVERT(varchar(10), DATEADD(day, DATEDIFF(day, 0, " + column + ") / 7 * 7, 0), 23)", true
+	case model.BucketMonth:
+		return "CONVERT(varchar(10), DATEADD(month, DATEDIFF(month, 0, " + column + "), 0), 23)", true
+	case model.BucketYear:
+		return "CONVERT(varchar(10), DATEADD(year, DATEDIFF(year, 0, " + column + "), 0), 23)", true
+	default:
+		return "", false
+	}
+}
+
+func (repo *MssqlDocumentRepository) DocumentDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.DocumentGrouper) (repositoryGrouper any, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+
+		return
+	}
+
+	grouping := new(DocumentGrouping)
+
+	if column, isTimestamp := documentTimestampColumns[domainGrouper.Field]; isTimestamp {
+		var ok bool
+
+		grouping.GroupBy, ok = documentTimeBucketExpression(column, domainGrouper.Bucket)
+		if !ok {
+			err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+			return
+		}
+	} else {
+		fieldGrouping, ok := documentGroupings[domainGrouper.Field]
+		if !ok || domainGrouper.Bucket != 0 {
+			err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+			return
+		}
+
+		*grouping = fieldGrouping
+	}
+
+	if grouping.Key == "" {
+		grouping.Key = grouping.GroupBy
+	}
+
+	repositoryGrouper = grouping
+
+	return
+}
+
 func (repo *MssqlDocumentRepository) UpdateRelatedEntities(ctx context.Context, tx *sql.Tx, repositoryModel *Document) error {
 	var err error
 
@@ -2245,29 +3062,60 @@ func (repo *MssqlDocumentRepository) LoadEntityRelations(ctx context.Context, tx
 		repoModel.R = repoModel.R.NewStruct()
 	}
 
-	err = repoModel.L.LoadDestinationDocuments(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadDestinationDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repoModel.L.LoadSourceDocuments(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadSourceDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repoModel.L.LoadTags(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repoModel.L.LoadDocumentType(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadDocumentType(ctx, repo.executor(ctx), true, repoModel, nil)
 
 	return
 
 }
+
+// loadSelectedEntityRelations loads only the relations of selection, all of them if selection is nil.
+func (repo *MssqlDocumentRepository) loadSelectedEntityRelations(ctx context.Context, tx *sql.Tx, repoModel *Document, selection *DocumentSelection) (err error) {
+	if selection == nil {
+		return repo.LoadEntityRelations(ctx, tx, repoModel)
+	}
+
+	if repoModel.R == nil {
+		repoModel.R = repoModel.R.NewStruct()
+	}
+
+	for _, relation := range selection.Relations {
+		switch relation {
+		case DocumentRels.Tags:
+			err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
+		case DocumentRels.DestinationDocuments:
+			err = repoModel.L.LoadDestinationDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
+		case DocumentRels.SourceDocuments:
+			err = repoModel.L.LoadSourceDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
+		case DocumentRels.DocumentType:
+			err = repoModel.L.LoadDocumentType(ctx, repo.executor(ctx), true, repoModel, nil)
+		}
+		if err != nil {
+			repo.Logger.Error(err)
+
+			return
+		}
+	}
+
+	return
+}
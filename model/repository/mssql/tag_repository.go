@@ -28,6 +28,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
@@ -92,6 +93,10 @@ type TagFilter struct {
 	Children  optional.Optional[model.FilterOperation[string]]
 	ParentTag optional.Optional[model.FilterOperation[null.Int64]]
 	ID        optional.Optional[model.FilterOperation[int64]]
+
+	And []*TagFilter
+	Or  []*TagFilter
+	Not *TagFilter
 }
 
 type TagUpdater struct {
@@ -232,7 +237,49 @@ func TryUnwrapOptionalTag(o any) any {
 	}
 }
 
-func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.FilterOperation[T]) queryModSliceTag {
+// TryUnwrapModelIDTag returns the ID of related models and the input otherwise.
+func TryUnwrapModelIDTag(o any) any {
+	switch t := o.(type) {
+	case *Tag:
+		return t.ID
+	case *Document:
+		return t.ID
+	default:
+		return o
+	}
+}
+
+// TagSetFilter holds the SQL conditions needed to filter a slice field by its members.
+// Member and RecursiveMember bind a single member, Empty binds nothing.
+// RecursiveMember also matches descendants of the member and is only set for hierarchical members.
+type TagSetFilter struct {
+	Member          string
+	RecursiveMember string
+	Empty           string
+}
+
+// Paths and children are stored as ";" separated IDs, paths end with the tag itself
+var tagSetFilters = map[TagField]TagSetFilter{
+	"Path": {
+		Member: "';' + " + TagTableColumns.Path + " LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'",
+		Empty:  TagTableColumns.ParentTag + " IS NULL",
+	},
+	"Children": {
+		Member: "';' + " + TagTableColumns.Children + " + ';' LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'",
+		Empty:  TagTableColumns.Children + " = ''",
+	},
+}
+
+func getSetFilterTag(filterField TagField, filterOperator model.FilterOperator) (TagSetFilter, error) {
+	setFilter, ok := tagSetFilters[filterField]
+	if !ok {
+		return setFilter, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on slice fields"}
+	}
+
+	return setFilter, nil
+}
+
+func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.FilterOperation[T]) (queryModSliceTag, error) {
 	var newQueryMod queryModSliceTag
 
 	filterOperator := filterOperation.Operator
@@ -241,7 +288,7 @@ func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.F
 	case model.FilterEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalTag(filterOperand.Operand) {
@@ -252,7 +299,7 @@ func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.F
 	case model.FilterNEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalTag(filterOperand.Operand) {
@@ -263,35 +310,35 @@ func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.F
 	case model.FilterGreaterThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" > ?", TryUnwrapOptionalTag(filterOperand.Operand)))
 	case model.FilterGreaterThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" >= ?", TryUnwrapOptionalTag(filterOperand.Operand)))
 	case model.FilterLessThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" < ?", TryUnwrapOptionalTag(filterOperand.Operand)))
 	case model.FilterLessThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" <= ?", TryUnwrapOptionalTag(filterOperand.Operand)))
 	case model.FilterIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -300,7 +347,7 @@ func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.F
 	case model.FilterNotIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterNotIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -309,78 +356,248 @@ func buildQueryModFilterTag[T any](filterField TagField, filterOperation model.F
 	case model.FilterBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterNotBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNotBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" LIKE ?", filterOperand.Operand))
 	case model.FilterNotLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT LIKE ?", filterOperand.Operand))
 	case model.FilterOr:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterOr operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+		}
+
+		lhsQueryMods, err := buildQueryModFilterTag(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
 		}
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterTag(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(buildQueryModFilterTag(filterField, filterOperand.RHS))))
+
+		rhsQueryMods, err := buildQueryModFilterTag(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(rhsQueryMods)))
 	case model.FilterAnd:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterAnd operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+		}
+
+
+		lhsQueryMods, err := buildQueryModFilterTag(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
+		}
+
+		rhsQueryMods, err := buildQueryModFilterTag(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
 		}
 
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterTag(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterTag(filterField, filterOperand.RHS)))
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Expr(rhsQueryMods))
+	case model.FilterContains, model.FilterContainsAny, model.FilterNotContains,
+		model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+		setFilter, err := getSetFilterTag(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		memberCondition := setFilter.Member
+
+		switch filterOperator {
+		case model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+			if setFilter.RecursiveMember == "" {
+				return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on hierarchical slice fields"}
+			}
+
+			memberCondition = setFilter.RecursiveMember
+		}
+
+		var members []any
+
+		switch filterOperand := filterOperation.Operand.(type) {
+		case model.ScalarOperand[T]:
+			members = append(members, TryUnwrapModelIDTag(filterOperand.Operand))
+		case model.ListOperand[T]:
+			members, _ = goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return TryUnwrapModelIDTag(a) })
+		default:
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar or list operand"}
+		}
+
+		var anyMemberQueryMod queryModSliceTag
+
+		for _, member := range members {
+			switch {
+			case filterOperator == model.FilterContains, filterOperator == model.FilterContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where(memberCondition, member))
+			case filterOperator == model.FilterNotContains, filterOperator == model.FilterNotContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where("NOT ("+memberCondition+")", member))
+			case len(anyMemberQueryMod) == 0:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Where(memberCondition, member))
+			default:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Or(memberCondition, member))
+			}
+		}
+
+		if len(anyMemberQueryMod) > 0 {
+			newQueryMod = append(newQueryMod, qm.Expr(anyMemberQueryMod...))
+		}
+	case model.FilterEmpty:
+		setFilter, err := getSetFilterTag(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Where(setFilter.Empty))
+	case model.FilterNotEmpty:
+		setFilter, err := getSetFilterTag(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Where("NOT ("+setFilter.Empty+")"))
 	default:
-		panic("Unhandled FilterOperator")
+		return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "unknown operator"}
 	}
 
-	return newQueryMod
+	return newQueryMod, nil
 }
 
-func buildQueryModListFromFilterTag(filter *TagFilter) queryModSliceTag {
+func buildQueryModListFromFilterTag(filter *TagFilter) (queryModSliceTag, error) {
 	queryModList := make(queryModSliceTag, 0, 5)
 
 	if filter.Tag.HasValue {
-		newQueryMod := buildQueryModFilterTag("Tag", filter.Tag.Wrappee)
+		newQueryMod, err := buildQueryModFilterTag("Tag", filter.Tag.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.Path.HasValue {
-		newQueryMod := buildQueryModFilterTag("Path", filter.Path.Wrappee)
+		newQueryMod, err := buildQueryModFilterTag("Path", filter.Path.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.Children.HasValue {
-		newQueryMod := buildQueryModFilterTag("Children", filter.Children.Wrappee)
+		newQueryMod, err := buildQueryModFilterTag("Children", filter.Children.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.ParentTag.HasValue {
-		newQueryMod := buildQueryModFilterTag("ParentTag", filter.ParentTag.Wrappee)
+		newQueryMod, err := buildQueryModFilterTag("ParentTag", filter.ParentTag.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.ID.HasValue {
-		newQueryMod := buildQueryModFilterTag("ID", filter.ID.Wrappee)
+		newQueryMod, err := buildQueryModFilterTag("ID", filter.ID.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 
-	return queryModList
+	//*********************    Composed Filters    *********************//
+	for _, subfilter := range filter.And {
+		subfilterQueryMods, err := buildQueryModListFromFilterTag(subfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subfilterQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(subfilterQueryMods...))
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		anyQueryMods := make(queryModSliceTag, 0, len(filter.Or))
+
+		for _, subfilter := range filter.Or {
+			subfilterQueryMods, err := buildQueryModListFromFilterTag(subfilter)
+			if err != nil {
+				return nil, err
+			}
+
+			// An empty subfilter matches everything, so does the whole disjunction
+			if len(subfilterQueryMods) == 0 {
+				anyQueryMods = nil
+
+				break
+			}
+
+			if len(anyQueryMods) == 0 {
+				anyQueryMods = append(anyQueryMods, qm.Expr(subfilterQueryMods...))
+			} else {
+				anyQueryMods = append(anyQueryMods, qm.Or2(qm.Expr(subfilterQueryMods...)))
+			}
+		}
+
+		if len(anyQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(anyQueryMods...))
+		}
+	}
+
+	if filter.Not != nil {
+		notQueryMods, err := buildQueryModListFromFilterTag(filter.Not)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, buildQueryModNotTag(notQueryMods))
+	}
+
+	return queryModList, nil
+}
+
+// buildQueryModNotTag negates queryMods by excluding the IDs they select in a subquery,
+// query mods can not be wrapped in a NOT directly.
+func buildQueryModNotTag(queryMods queryModSliceTag) qm.QueryMod {
+	// Placeholders are numbered once the outer query is built
+	subqueryDialect := dialect
+	subqueryDialect.UseIndexPlaceholders = false
+
+	subquery := &queries.Query{}
+	queries.SetDialect(subquery, &subqueryDialect)
+	queries.SetFrom(subquery, TableNames.Tags)
+	queries.SetSelect(subquery, []string{TagTableColumns.ID})
+	qm.Apply(subquery, queryMods...)
+
+	subquerySQL, args := queries.BuildQuery(subquery)
+
+	return qm.Where(TagTableColumns.ID+" NOT IN ("+strings.TrimSuffix(subquerySQL, ";")+")", args...)
 }
 
 type MssqlTagRepositoryConstructorArgs struct {
@@ -406,6 +623,11 @@ func (repo *MssqlTagRepository) New(args any) (newRepo repoCommon.TagRepository,
 	return
 }
 
+// executor returns the transaction of the unit of work in ctx if there is one.
+func (repo *MssqlTagRepository) executor(ctx context.Context) boil.ContextExecutor {
+	return repoCommon.Executor(ctx, repo.db)
+}
+
 //******************************************************************//
 //                              Methods                             //
 //******************************************************************//
@@ -430,7 +652,7 @@ func (repo *MssqlTagRepository) Add(ctx context.Context, domainModels []*domain.
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -451,7 +673,7 @@ func (repo *MssqlTagRepository) Add(ctx context.Context, domainModels []*domain.
 		return err
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -477,7 +699,7 @@ func (repo *MssqlTagRepository) AddMinimal(ctx context.Context, domainModels []*
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -492,7 +714,7 @@ func (repo *MssqlTagRepository) AddMinimal(ctx context.Context, domainModels []*
 		return
 	}
 
-	for _, repositoryModel := range repositoryModels {
+	for i, repositoryModel := range repositoryModels {
 		repoModel, ok := repositoryModel.(*Tag)
 		if !ok {
 			err = fmt.Errorf("expected type *Tag but got %T", repoModel)
@@ -510,10 +732,13 @@ func (repo *MssqlTagRepository) AddMinimal(ctx context.Context, domainModels []*
 
 			return
 		}
+
+		// Inserting fills in generated IDs, which later operations on the domain models depend on
+		domainModels[i].ID = repoModel.ID
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -541,7 +766,7 @@ func (repo *MssqlTagRepository) ReplaceTx(ctx context.Context, domainModels []*d
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -603,7 +828,7 @@ func (repo *MssqlTagRepository) ReplaceTx(ctx context.Context, domainModels []*d
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -637,7 +862,7 @@ func (repo *MssqlTagRepository) Replace(ctx context.Context, domainModels []*dom
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -691,7 +916,7 @@ func (repo *MssqlTagRepository) Replace(ctx context.Context, domainModels []*dom
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -723,7 +948,7 @@ func (repo *MssqlTagRepository) Upsert(ctx context.Context, domainModels []*doma
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -756,7 +981,7 @@ func (repo *MssqlTagRepository) Upsert(ctx context.Context, domainModels []*doma
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -810,7 +1035,7 @@ func (repo *MssqlTagRepository) Update(ctx context.Context, domainModels []*doma
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -863,7 +1088,7 @@ func (repo *MssqlTagRepository) Update(ctx context.Context, domainModels []*doma
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -926,9 +1151,14 @@ func (repo *MssqlTagRepository) UpdateWhere(ctx context.Context, domainColumnFil
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
 
-	modelsToUpdate, err = Tags(queryFilters...).All(ctx, repo.db)
+		return
+	}
+
+	modelsToUpdate, err = Tags(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		return
 	}
@@ -943,7 +1173,7 @@ func (repo *MssqlTagRepository) UpdateWhere(ctx context.Context, domainColumnFil
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -970,7 +1200,7 @@ func (repo *MssqlTagRepository) UpdateWhere(ctx context.Context, domainColumnFil
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	numAffectedRecords = int64(len(modelsToUpdate))
 
@@ -1004,7 +1234,7 @@ func (repo *MssqlTagRepository) Delete(ctx context.Context, domainModels []*doma
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -1042,7 +1272,7 @@ func (repo *MssqlTagRepository) Delete(ctx context.Context, domainModels []*doma
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1072,18 +1302,23 @@ func (repo *MssqlTagRepository) DeleteWhere(ctx context.Context, domainColumnFil
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
 
 	numAffectedRecords, err = Tags(queryFilters...).DeleteAll(ctx, tx)
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1113,13 +1348,113 @@ func (repo *MssqlTagRepository) CountWhere(ctx context.Context, domainColumnFilt
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
 
-	return Tags(queryFilters...).Count(ctx, repo.db)
+		return
+	}
+
+	return Tags(queryFilters...).Count(ctx, repo.executor(ctx))
 }
 
 func (repo *MssqlTagRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
-	return Tags().Count(ctx, repo.db)
+	return Tags().Count(ctx, repo.executor(ctx))
+}
+
+func (repo *MssqlTagRepository) CountGroupedWhere(ctx context.Context, domainColumnFilter *domain.TagFilter, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.TagDomainToRepositoryFilter(ctx, domainColumnFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*TagFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *TagFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	return repo.countGrouped(ctx, queryFilters, domainGrouper)
+}
+
+func (repo *MssqlTagRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	return repo.countGrouped(ctx, queryModSliceTag{}, domainGrouper)
+}
+
+func (repo *MssqlTagRepository) countGrouped(ctx context.Context, queryMods queryModSliceTag, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryGrouper any
+	repositoryGrouper, err = repo.TagDomainToRepositoryGrouper(ctx, domainGrouper)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	grouping, ok := repositoryGrouper.(*TagGrouping)
+	if !ok {
+		err = fmt.Errorf("expected type *TagGrouping but got %T", repositoryGrouper)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if grouping.Join != "" {
+		queryMods = append(queryMods, qm.LeftOuterJoin(grouping.Join))
+	}
+
+	queryMods = append(queryMods,
+		qm.Select(grouping.Key+" AS group_key", "COUNT(DISTINCT "+TagTableColumns.ID+") AS group_count"),
+		qm.GroupBy(grouping.GroupBy),
+		qm.OrderBy("group_key"),
+	)
+
+	var rows []struct {
+		Key   null.String `boil:"group_key"`
+		Count int64       `boil:"group_count"`
+	}
+
+	err = Tags(queryMods...).Bind(ctx, repo.executor(ctx), &rows)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	groups = make([]*model.Group, 0, len(rows))
+
+	for _, row := range rows {
+		groups = append(groups, &model.Group{Key: row.Key.String, Count: row.Count})
+	}
+
+	return
 }
 
 func (repo *MssqlTagRepository) DoesExist(ctx context.Context, domainModel *domain.Tag) (doesExist bool, err error) {
@@ -1147,7 +1482,7 @@ func (repo *MssqlTagRepository) DoesExist(ctx context.Context, domainModel *doma
 		return
 	}
 
-	return TagExists(ctx, repo.db, repoModel.ID)
+	return TagExists(ctx, repo.executor(ctx), repoModel.ID)
 }
 
 func (repo *MssqlTagRepository) DoesExistWhere(ctx context.Context, domainColumnFilter *domain.TagFilter) (doesExist bool, err error) {
@@ -1175,9 +1510,14 @@ func (repo *MssqlTagRepository) DoesExistWhere(ctx context.Context, domainColumn
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
 
-	return Tags(queryFilters...).Exists(ctx, repo.db)
+		return
+	}
+
+	return Tags(queryFilters...).Exists(ctx, repo.executor(ctx))
 }
 
 func (repo *MssqlTagRepository) GetWhere(ctx context.Context, domainColumnFilter *domain.TagFilter) (records []*domain.Tag, err error) {
@@ -1205,10 +1545,15 @@ func (repo *MssqlTagRepository) GetWhere(ctx context.Context, domainColumnFilter
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryModels TagSlice
-	repositoryModels, err = Tags(queryFilters...).All(ctx, repo.db)
+	repositoryModels, err = Tags(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1223,7 +1568,7 @@ func (repo *MssqlTagRepository) GetWhere(ctx context.Context, domainColumnFilter
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1239,7 +1584,7 @@ func (repo *MssqlTagRepository) GetWhere(ctx context.Context, domainColumnFilter
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1264,6 +1609,10 @@ func (repo *MssqlTagRepository) GetWhere(ctx context.Context, domainColumnFilter
 }
 
 func (repo *MssqlTagRepository) GetFirstWhere(ctx context.Context, domainColumnFilter *domain.TagFilter) (record *domain.Tag, err error) {
+	return repo.GetFirstWhereSelected(ctx, domainColumnFilter, nil)
+}
+
+func (repo *MssqlTagRepository) GetFirstWhereSelected(ctx context.Context, domainColumnFilter *domain.TagFilter, domainSelector domain.TagMemberSelector) (record *domain.Tag, err error) {
 	if domainColumnFilter == nil {
 		err = helper.NilInputError{}
 		repo.Logger.Error(err)
@@ -1288,10 +1637,36 @@ func (repo *MssqlTagRepository) GetFirstWhere(ctx context.Context, domainColumnF
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositorySelector any
+	repositorySelector, err = repo.TagDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*TagSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *TagSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryFilters = append(queryFilters, qm.Select(selection.Columns...))
+	}
 
 	var repositoryModel *Tag
-	repositoryModel, err = Tags(queryFilters...).One(ctx, repo.db)
+	repositoryModel, err = Tags(queryFilters...).One(ctx, repo.executor(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1302,21 +1677,22 @@ func (repo *MssqlTagRepository) GetFirstWhere(ctx context.Context, domainColumnF
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repo.LoadEntityRelations(ctx, tx, repositoryModel)
+	err = repo.loadSelectedEntityRelations(ctx, tx, repositoryModel, selection)
 	if err != nil {
 		repo.Logger.Error(err)
+		repoCommon.RollbackTx(ctx, tx)
 
 		return
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1330,7 +1706,7 @@ func (repo *MssqlTagRepository) GetFirstWhere(ctx context.Context, domainColumnF
 
 func (repo *MssqlTagRepository) GetAll(ctx context.Context) (records []*domain.Tag, err error) {
 	var repositoryModels TagSlice
-	repositoryModels, err = Tags().All(ctx, repo.db)
+	repositoryModels, err = Tags().All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1344,7 +1720,7 @@ func (repo *MssqlTagRepository) GetAll(ctx context.Context) (records []*domain.T
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1360,7 +1736,173 @@ func (repo *MssqlTagRepository) GetAll(ctx context.Context) (records []*domain.T
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	records = make([]*domain.Tag, 0, len(repositoryModels))
+
+	var domainModel *domain.Tag
+	for _, repoModel := range repositoryModels {
+		domainModel, err = repo.TagRepositoryToDomainModel(ctx, repoModel)
+		if err != nil {
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		records = append(records, domainModel)
+	}
+
+	return
+}
+
+func (repo *MssqlTagRepository) GetWhereSorted(ctx context.Context, domainColumnFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	return repo.GetWhereSelected(ctx, domainColumnFilter, domainSorter, limiter, nil)
+}
+
+func (repo *MssqlTagRepository) GetAllSorted(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error) {
+	return repo.GetAllSelected(ctx, domainSorter, limiter, nil)
+}
+
+func (repo *MssqlTagRepository) GetWhereSelected(ctx context.Context, domainColumnFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.TagDomainToRepositoryFilter(ctx, domainColumnFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*TagFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *TagFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	return repo.getSelected(ctx, queryFilters, domainSorter, limiter, domainSelector)
+}
+
+func (repo *MssqlTagRepository) GetAllSelected(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	return repo.getSelected(ctx, queryModSliceTag{}, domainSorter, limiter, domainSelector)
+}
+
+func (repo *MssqlTagRepository) getSelected(ctx context.Context, queryMods queryModSliceTag, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error) {
+	var repositorySelector any
+	repositorySelector, err = repo.TagDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*TagSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *TagSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryMods = append(queryMods, qm.Select(selection.Columns...))
+	}
+
+	var repositorySorter any
+	repositorySorter, err = repo.TagDomainToRepositorySorter(ctx, domainSorter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	orderByClauses, ok := repositorySorter.([]string)
+	if !ok {
+		err = fmt.Errorf("expected type []string but got %T", repositorySorter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryMods = append(queryMods, qm.OrderBy(strings.Join(orderByClauses, ", ")))
+
+	if limiter != nil {
+		if limiter.Limit < 0 || limiter.Offset < 0 {
+			err = repoCommon.InvalidLimiterError{Limiter: *limiter}
+
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		if limiter.Limit > 0 {
+			queryMods = append(queryMods, qm.Limit(int(limiter.Limit)))
+		} else if limiter.Offset > 0 {
+			// Most DBMS' do not support an offset without a limit
+			queryMods = append(queryMods, qm.Limit(math.MaxInt32))
+		}
+
+		if limiter.Offset > 0 {
+			queryMods = append(queryMods, qm.Offset(int(limiter.Offset)))
+		}
+	}
+
+	var repositoryModels TagSlice
+	repositoryModels, err = Tags(queryMods...).All(ctx, repo.executor(ctx))
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if len(repositoryModels) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	for _, repoModel := range repositoryModels {
+		err = repo.loadSelectedEntityRelations(ctx, tx, repoModel, selection)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+	}
+
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1404,10 +1946,15 @@ func (repo *MssqlTagRepository) GetFromIDs(ctx context.Context, IDs []int64) (re
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterTag(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterTag(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryModels TagSlice
-	repositoryModels, err = Tags(queryFilters...).All(ctx, repo.db)
+	repositoryModels, err = Tags(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1422,7 +1969,7 @@ func (repo *MssqlTagRepository) GetFromIDs(ctx context.Context, IDs []int64) (re
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1438,7 +1985,7 @@ func (repo *MssqlTagRepository) GetFromIDs(ctx context.Context, IDs []int64) (re
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1520,7 +2067,7 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryModel(ctx context.Context,
 		var repositoryParentTag *Tag
 
 		domainParentTagID := domainModel.ParentPathIDs[len(domainModel.ParentPathIDs)-1]
-		repositoryParentTag, err = Tags(TagWhere.ID.EQ(domainParentTagID)).One(ctx, repo.db)
+		repositoryParentTag, err = Tags(TagWhere.ID.EQ(domainParentTagID)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1534,7 +2081,7 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryModel(ctx context.Context,
 	if len(domainModel.ParentPathIDs) > 0 {
 		var repositoryParentTag *Tag
 		for _, tagID := range domainModel.ParentPathIDs[:len(domainModel.ParentPathIDs)] {
-			repositoryParentTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)
+			repositoryParentTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1551,7 +2098,7 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryModel(ctx context.Context,
 	if len(domainModel.SubtagIDs) > 0 {
 		var repositoryChildTag *Tag
 		for _, tagID := range domainModel.SubtagIDs[:len(domainModel.SubtagIDs)-1] {
-			repositoryChildTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)
+			repositoryChildTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1561,7 +2108,7 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryModel(ctx context.Context,
 			repositoryModelConcrete.Children += strconv.FormatInt(repositoryChildTag.ID, 10) + ";"
 		}
 		lastChildID := domainModel.SubtagIDs[len(domainModel.SubtagIDs)-1]
-		repositoryChildTag, err = Tags(TagWhere.ID.EQ(lastChildID)).One(ctx, repo.db)
+		repositoryChildTag, err = Tags(TagWhere.ID.EQ(lastChildID)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1803,11 +2350,65 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryFilter(ctx context.Context,
 		repositoryFilterConcrete.Children.Set(convertedFilter)
 	}
 
+	err = repo.TagDomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+	if err != nil {
+		return
+	}
+
 	repositoryFilter = repositoryFilterConcrete
 
 	return
 }
 
+// TagDomainToRepositoryComposedFilters converts the And, Or and Not subfilters of domainFilter into repositoryFilter.
+func (repo *MssqlTagRepository) TagDomainToRepositoryComposedFilters(ctx context.Context, domainFilter *domain.TagFilter, repositoryFilter *TagFilter) (err error) {
+	convertSubfilter := func(domainSubfilter *domain.TagFilter) (*TagFilter, error) {
+		if domainSubfilter == nil {
+			err := helper.NilInputError{}
+			repo.Logger.Error(err)
+
+			return nil, err
+		}
+
+		repositorySubfilter, err := repo.TagDomainToRepositoryFilter(ctx, domainSubfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		return repositorySubfilter.(*TagFilter), nil
+	}
+
+	repositoryFilter.And = make([]*TagFilter, 0, len(domainFilter.And))
+	for _, domainSubfilter := range domainFilter.And {
+		var repositorySubfilter *TagFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.And = append(repositoryFilter.And, repositorySubfilter)
+	}
+
+	repositoryFilter.Or = make([]*TagFilter, 0, len(domainFilter.Or))
+	for _, domainSubfilter := range domainFilter.Or {
+		var repositorySubfilter *TagFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.Or = append(repositoryFilter.Or, repositorySubfilter)
+	}
+
+	if domainFilter.Not != nil {
+		repositoryFilter.Not, err = convertSubfilter(domainFilter.Not)
+	}
+
+	return
+}
+
 //******************************************************************//
 //                         Updater Converter                        //
 //******************************************************************//
@@ -1856,6 +2457,147 @@ func (repo *MssqlTagRepository) TagDomainToRepositoryUpdater(ctx context.Context
 	return
 }
 
+//******************************************************************//
+//                         Sorter Converter                         //
+//******************************************************************//
+
+var tagSortExpressions = map[domain.TagField]string{
+	"Tag": TagTableColumns.Tag,
+	"ID":  TagTableColumns.ID,
+}
+
+func (repo *MssqlTagRepository) TagDomainToRepositorySorter(ctx context.Context, domainSorter domain.TagSorter) (repositorySorter any, err error) {
+	orderByClauses := make([]string, 0, len(domainSorter)+1)
+	isSortedByID := false
+
+	for _, sortKey := range domainSorter {
+		expression, ok := tagSortExpressions[sortKey.Field]
+		if !ok {
+			err = repoCommon.UnsortableFieldError{Field: string(sortKey.Field)}
+
+			return
+		}
+
+		isSortedByID = isSortedByID || sortKey.Field == "ID"
+
+		if sortKey.Direction == model.SortDescending {
+			orderByClauses = append(orderByClauses, expression+" DESC")
+		} else {
+			orderByClauses = append(orderByClauses, expression+" ASC")
+		}
+	}
+
+	// Breaking ties by the primary key gives a total order, which is needed for stable pagination
+	if !isSortedByID {
+		orderByClauses = append(orderByClauses, TagTableColumns.ID+" ASC")
+	}
+
+	repositorySorter = orderByClauses
+
+	return
+}
+
+//******************************************************************//
+//                     Member Selector Converter                    //
+//******************************************************************//
+
+// TagSelection holds the columns and relations to load for a domain.TagMemberSelector.
+type TagSelection struct {
+	Columns   []string
+	Relations []string
+}
+
+var tagMemberSelections = map[domain.TagField]TagSelection{
+	"Tag":           {Columns: []string{TagTableColumns.Tag}},
+	"ID":            {Columns: []string{TagTableColumns.ID}},
+	"ParentPathIDs": {Columns: []string{TagTableColumns.Path}},
+	"SubtagIDs":     {Columns: []string{TagTableColumns.Children}},
+}
+
+func (repo *MssqlTagRepository) TagDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.TagMemberSelector) (repositorySelector any, err error) {
+	if len(domainSelector) == 0 {
+		repositorySelector = (*TagSelection)(nil)
+
+		return
+	}
+
+	// The primary key is always needed to load relations
+	selection := &TagSelection{Columns: []string{TagTableColumns.ID}}
+	isSelected := map[string]bool{TagTableColumns.ID: true}
+
+	for _, field := range domainSelector {
+		fieldSelection, ok := tagMemberSelections[field]
+		if !ok {
+			err = repoCommon.UnselectableFieldError{Field: string(field)}
+
+			return
+		}
+
+		for _, column := range fieldSelection.Columns {
+			if !isSelected[column] {
+				isSelected[column] = true
+				selection.Columns = append(selection.Columns, column)
+			}
+		}
+
+		for _, relation := range fieldSelection.Relations {
+			if !isSelected[relation] {
+				isSelected[relation] = true
+				selection.Relations = append(selection.Relations, relation)
+			}
+		}
+	}
+
+	repositorySelector = selection
+
+	return
+}
+
+//******************************************************************//
+//                         Grouper Converter                        //
+//******************************************************************//
+
+// TagGrouping holds the SQL expressions needed to group by a domain.TagGrouper.
+// Key is selected as the groups' key and must be functionally dependent on GroupBy,
+// Join is left joined to the tags if set.
+type TagGrouping struct {
+	GroupBy string
+	Key     string
+	Join    string
+}
+
+// Tags are grouped by their direct parent, not their whole path
+var tagGroupings = map[domain.TagField]TagGrouping{
+	"ParentPathIDs": {GroupBy: TagTableColumns.ParentTag},
+}
+
+func (repo *MssqlTagRepository) TagDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.TagGrouper) (repositoryGrouper any, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+
+		return
+	}
+
+	grouping := new(TagGrouping)
+
+	fieldGrouping, ok := tagGroupings[domainGrouper.Field]
+	if !ok || domainGrouper.Bucket != 0 {
+		err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+		return
+	}
+
+	*grouping = fieldGrouping
+
+	if grouping.Key == "" {
+		grouping.Key = grouping.GroupBy
+	}
+
+	repositoryGrouper = grouping
+
+	return
+}
+
 func (repo *MssqlTagRepository) UpdateRelatedEntities(ctx context.Context, tx *sql.Tx, repositoryModel *Tag) error {
 	var err error
 
@@ -1884,15 +2626,30 @@ func (repo *MssqlTagRepository) LoadEntityRelations(ctx context.Context, tx *sql
 		repoModel.R = repoModel.R.NewStruct()
 	}
 
-	err = repoModel.L.LoadParentTagTag(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadParentTagTag(ctx, repo.executor(ctx), true, repoModel, nil)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repoModel.L.LoadParentTagTags(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadParentTagTags(ctx, repo.executor(ctx), true, repoModel, nil)
 
 	return
 
 }
+
+// loadSelectedEntityRelations loads only the relations of selection, all of them if selection is nil.
+func (repo *MssqlTagRepository) loadSelectedEntityRelations(ctx context.Context, tx *sql.Tx, repoModel *Tag, selection *TagSelection) (err error) {
+	if selection == nil {
+		return repo.LoadEntityRelations(ctx, tx, repoModel)
+	}
+
+	if repoModel.R == nil {
+		repoModel.R = repoModel.R.NewStruct()
+	}
+
+	// None of the tag's relations are part of the domain model
+
+	return
+}
@@ -28,6 +28,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/JonasMuehlmann/bntp.go/internal/helper"
@@ -110,6 +111,10 @@ type BookmarkFilter struct {
 	IsRead         optional.Optional[model.FilterOperation[int64]]
 
 	Tags optional.Optional[model.FilterOperation[*Tag]]
+
+	And []*BookmarkFilter
+	Or  []*BookmarkFilter
+	Not *BookmarkFilter
 }
 
 type BookmarkUpdater struct {
@@ -279,7 +284,48 @@ func TryUnwrapOptionalBookmark(o any) any {
 	}
 }
 
-func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperation model.FilterOperation[T]) queryModSliceBookmark {
+// TryUnwrapModelIDBookmark returns the ID of related models and the input otherwise.
+func TryUnwrapModelIDBookmark(o any) any {
+	switch t := o.(type) {
+	case *Tag:
+		return t.ID
+	case *Document:
+		return t.ID
+	default:
+		return o
+	}
+}
+
+// BookmarkSetFilter holds the SQL conditions needed to filter a slice field by its members.
+// Member and RecursiveMember bind a single member, Empty binds nothing.
+// RecursiveMember also matches descendants of the member and is only set for hierarchical members.
+type BookmarkSetFilter struct {
+	Member          string
+	RecursiveMember string
+	Empty           string
+}
+
+// bookmarkTagPathContains matches tags whose path contains the bound tag ID.
+var bookmarkTagPathContains = "';' || " + TagTableColumns.Path + " || ';' LIKE '%;' || CAST(? AS VARCHAR(20)) || ';%'"
+
+var bookmarkSetFilters = map[BookmarkField]BookmarkSetFilter{
+	"Tags": {
+		Member:          BookmarkTableColumns.ID + " IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + " WHERE " + TableNames.BookmarkContexts + ".tag_id = ?)",
+		RecursiveMember: BookmarkTableColumns.ID + " IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + " INNER JOIN " + TableNames.Tags + " ON " + TagTableColumns.ID + " = " + TableNames.BookmarkContexts + ".tag_id WHERE " + bookmarkTagPathContains + ")",
+		Empty:           BookmarkTableColumns.ID + " NOT IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + ")",
+	},
+}
+
+func getSetFilterBookmark(filterField BookmarkField, filterOperator model.FilterOperator) (BookmarkSetFilter, error) {
+	setFilter, ok := bookmarkSetFilters[filterField]
+	if !ok {
+		return setFilter, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on slice fields"}
+	}
+
+	return setFilter, nil
+}
+
+func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperation model.FilterOperation[T]) (queryModSliceBookmark, error) {
 	var newQueryMod queryModSliceBookmark
 
 	filterOperator := filterOperation.Operator
@@ -288,7 +334,7 @@ func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperati
 	case model.FilterEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalBookmark(filterOperand.Operand) {
@@ -299,7 +345,7 @@ func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperati
 	case model.FilterNEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		if IsUnsetOptionalBookmark(filterOperand.Operand) {
@@ -310,35 +356,35 @@ func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperati
 	case model.FilterGreaterThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" > ?", TryUnwrapOptionalBookmark(filterOperand.Operand)))
 	case model.FilterGreaterThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterGreaterThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" >= ?", TryUnwrapOptionalBookmark(filterOperand.Operand)))
 	case model.FilterLessThan:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThan operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" < ?", TryUnwrapOptionalBookmark(filterOperand.Operand)))
 	case model.FilterLessThanEqual:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLessThanEqual operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" <= ?", TryUnwrapOptionalBookmark(filterOperand.Operand)))
 	case model.FilterIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -347,7 +393,7 @@ func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperati
 	case model.FilterNotIn:
 		filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
 		if !ok {
-			panic("expected a list operand for FilterNotIn operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
 		}
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -356,94 +402,289 @@ func buildQueryModFilterBookmark[T any](filterField BookmarkField, filterOperati
 	case model.FilterBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterNotBetween:
 		filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterNotBetween operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
 	case model.FilterLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" LIKE ?", filterOperand.Operand))
 	case model.FilterNotLike:
 		filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterLike operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
 		}
 
 		newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT LIKE ?", filterOperand.Operand))
 	case model.FilterOr:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterOr operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+		}
+
+		lhsQueryMods, err := buildQueryModFilterBookmark(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
 		}
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterBookmark(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(buildQueryModFilterBookmark(filterField, filterOperand.RHS))))
+
+		rhsQueryMods, err := buildQueryModFilterBookmark(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(rhsQueryMods)))
 	case model.FilterAnd:
 		filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
 		if !ok {
-			panic("expected a scalar operand for FilterAnd operator")
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+		}
+
+
+		lhsQueryMods, err := buildQueryModFilterBookmark(filterField, filterOperand.LHS)
+		if err != nil {
+			return nil, err
+		}
+
+		rhsQueryMods, err := buildQueryModFilterBookmark(filterField, filterOperand.RHS)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+		newQueryMod = append(newQueryMod, qm.Expr(rhsQueryMods))
+	case model.FilterContains, model.FilterContainsAny, model.FilterNotContains,
+		model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+		setFilter, err := getSetFilterBookmark(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		memberCondition := setFilter.Member
+
+		switch filterOperator {
+		case model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+			if setFilter.RecursiveMember == "" {
+				return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on hierarchical slice fields"}
+			}
+
+			memberCondition = setFilter.RecursiveMember
+		}
+
+		var members []any
+
+		switch filterOperand := filterOperation.Operand.(type) {
+		case model.ScalarOperand[T]:
+			members = append(members, TryUnwrapModelIDBookmark(filterOperand.Operand))
+		case model.ListOperand[T]:
+			members, _ = goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return TryUnwrapModelIDBookmark(a) })
+		default:
+			return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar or list operand"}
+		}
+
+		var anyMemberQueryMod queryModSliceBookmark
+
+		for _, member := range members {
+			switch {
+			case filterOperator == model.FilterContains, filterOperator == model.FilterContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where(memberCondition, member))
+			case filterOperator == model.FilterNotContains, filterOperator == model.FilterNotContainsRecursive:
+				newQueryMod = append(newQueryMod, qm.Where("NOT ("+memberCondition+")", member))
+			case len(anyMemberQueryMod) == 0:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Where(memberCondition, member))
+			default:
+				anyMemberQueryMod = append(anyMemberQueryMod, qm.Or(memberCondition, member))
+			}
+		}
+
+		if len(anyMemberQueryMod) > 0 {
+			newQueryMod = append(newQueryMod, qm.Expr(anyMemberQueryMod...))
+		}
+	case model.FilterEmpty:
+		setFilter, err := getSetFilterBookmark(filterField, filterOperator)
+		if err != nil {
+			return nil, err
+		}
+
+		newQueryMod = append(newQueryMod, qm.Where(setFilter.Empty))
+	case model.FilterNotEmpty:
+		setFilter, err := getSetFilterBookmark(filterField, filterOperator)
+		if err != nil {
+			return nil, err
 		}
 
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterBookmark(filterField, filterOperand.LHS)))
-		newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilterBookmark(filterField, filterOperand.RHS)))
+		newQueryMod = append(newQueryMod, qm.Where("NOT ("+setFilter.Empty+")"))
 	default:
-		panic("Unhandled FilterOperator")
+		return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "unknown operator"}
 	}
 
-	return newQueryMod
+	return newQueryMod, nil
 }
 
-func buildQueryModListFromFilterBookmark(filter *BookmarkFilter) queryModSliceBookmark {
+func buildQueryModListFromFilterBookmark(filter *BookmarkFilter) (queryModSliceBookmark, error) {
 	queryModList := make(queryModSliceBookmark, 0, 9)
 
 	if filter.CreatedAt.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("CreatedAt", filter.CreatedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("CreatedAt", filter.CreatedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.UpdatedAt.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("UpdatedAt", filter.UpdatedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("UpdatedAt", filter.UpdatedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.URL.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("URL", filter.URL.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("URL", filter.URL.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.Title.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("Title", filter.Title.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("Title", filter.Title.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.DeletedAt.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("DeletedAt", filter.DeletedAt.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("DeletedAt", filter.DeletedAt.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.BookmarkTypeID.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("BookmarkTypeID", filter.BookmarkTypeID.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("BookmarkTypeID", filter.BookmarkTypeID.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.IsCollection.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("IsCollection", filter.IsCollection.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("IsCollection", filter.IsCollection.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.ID.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("ID", filter.ID.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("ID", filter.ID.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 	if filter.IsRead.HasValue {
-		newQueryMod := buildQueryModFilterBookmark("IsRead", filter.IsRead.Wrappee)
+		newQueryMod, err := buildQueryModFilterBookmark("IsRead", filter.IsRead.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
 		queryModList = append(queryModList, newQueryMod...)
 	}
 
-	return queryModList
+	if filter.Tags.HasValue {
+		newQueryMod, err := buildQueryModFilterBookmark("Tags", filter.Tags.Wrappee)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, newQueryMod...)
+	}
+
+	//*********************    Composed Filters    *********************//
+	for _, subfilter := range filter.And {
+		subfilterQueryMods, err := buildQueryModListFromFilterBookmark(subfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(subfilterQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(subfilterQueryMods...))
+		}
+	}
+
+	if len(filter.Or) > 0 {
+		anyQueryMods := make(queryModSliceBookmark, 0, len(filter.Or))
+
+		for _, subfilter := range filter.Or {
+			subfilterQueryMods, err := buildQueryModListFromFilterBookmark(subfilter)
+			if err != nil {
+				return nil, err
+			}
+
+			// An empty subfilter matches everything, so does the whole disjunction
+			if len(subfilterQueryMods) == 0 {
+				anyQueryMods = nil
+
+				break
+			}
+
+			if len(anyQueryMods) == 0 {
+				anyQueryMods = append(anyQueryMods, qm.Expr(subfilterQueryMods...))
+			} else {
+				anyQueryMods = append(anyQueryMods, qm.Or2(qm.Expr(subfilterQueryMods...)))
+			}
+		}
+
+		if len(anyQueryMods) > 0 {
+			queryModList = append(queryModList, qm.Expr(anyQueryMods...))
+		}
+	}
+
+	if filter.Not != nil {
+		notQueryMods, err := buildQueryModListFromFilterBookmark(filter.Not)
+		if err != nil {
+			return nil, err
+		}
+
+		queryModList = append(queryModList, buildQueryModNotBookmark(notQueryMods))
+	}
+
+	return queryModList, nil
+}
+
+// buildQueryModNotBookmark negates queryMods by excluding the IDs they select in a subquery,
+// query mods can not be wrapped in a NOT directly.
+func buildQueryModNotBookmark(queryMods queryModSliceBookmark) qm.QueryMod {
+	// Placeholders are numbered once the outer query is built
+	subqueryDialect := dialect
+	subqueryDialect.UseIndexPlaceholders = false
+
+	subquery := &queries.Query{}
+	queries.SetDialect(subquery, &subqueryDialect)
+	queries.SetFrom(subquery, TableNames.Bookmarks)
+	queries.SetSelect(subquery, []string{BookmarkTableColumns.ID})
+	qm.Apply(subquery, queryMods...)
+
+	subquerySQL, args := queries.BuildQuery(subquery)
+
+	return qm.Where(BookmarkTableColumns.ID+" NOT IN ("+strings.TrimSuffix(subquerySQL, ";")+")", args...)
 }
 
 type Sqlite3BookmarkRepositoryConstructorArgs struct {
@@ -473,6 +714,11 @@ func (repo *Sqlite3BookmarkRepository) New(args any) (newRepo repoCommon.Bookmar
 	return
 }
 
+// executor returns the transaction of the unit of work in ctx if there is one.
+func (repo *Sqlite3BookmarkRepository) executor(ctx context.Context) boil.ContextExecutor {
+	return repoCommon.Executor(ctx, repo.db)
+}
+
 //******************************************************************//
 //                              Methods                             //
 //******************************************************************//
@@ -497,7 +743,7 @@ func (repo *Sqlite3BookmarkRepository) Add(ctx context.Context, domainModels []*
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -518,7 +764,7 @@ func (repo *Sqlite3BookmarkRepository) Add(ctx context.Context, domainModels []*
 		return err
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -544,7 +790,7 @@ func (repo *Sqlite3BookmarkRepository) AddMinimal(ctx context.Context, domainMod
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -559,7 +805,7 @@ func (repo *Sqlite3BookmarkRepository) AddMinimal(ctx context.Context, domainMod
 		return
 	}
 
-	for _, repositoryModel := range repositoryModels {
+	for i, repositoryModel := range repositoryModels {
 		repoModel, ok := repositoryModel.(*Bookmark)
 		if !ok {
 			err = fmt.Errorf("expected type *Bookmark but got %T", repoModel)
@@ -577,10 +823,13 @@ func (repo *Sqlite3BookmarkRepository) AddMinimal(ctx context.Context, domainMod
 
 			return
 		}
+
+		// Inserting fills in generated IDs, which later operations on the domain models depend on
+		domainModels[i].ID = repoModel.ID
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -608,7 +857,7 @@ func (repo *Sqlite3BookmarkRepository) ReplaceTx(ctx context.Context, domainMode
 
 	var commitHere bool
 	if tx == nil {
-		tx, err = repo.db.BeginTx(ctx, nil)
+		tx, err = repoCommon.BeginTx(ctx, repo.db)
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -670,7 +919,7 @@ func (repo *Sqlite3BookmarkRepository) ReplaceTx(ctx context.Context, domainMode
 	}
 
 	if commitHere {
-		tx.Commit()
+		repoCommon.CommitTx(ctx, tx)
 	}
 
 	return
@@ -704,7 +953,7 @@ func (repo *Sqlite3BookmarkRepository) Replace(ctx context.Context, domainModels
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -758,7 +1007,7 @@ func (repo *Sqlite3BookmarkRepository) Replace(ctx context.Context, domainModels
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -790,7 +1039,7 @@ func (repo *Sqlite3BookmarkRepository) Upsert(ctx context.Context, domainModels
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -823,7 +1072,7 @@ func (repo *Sqlite3BookmarkRepository) Upsert(ctx context.Context, domainModels
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -877,7 +1126,7 @@ func (repo *Sqlite3BookmarkRepository) Update(ctx context.Context, domainModels
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -930,7 +1179,7 @@ func (repo *Sqlite3BookmarkRepository) Update(ctx context.Context, domainModels
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -993,9 +1242,14 @@ func (repo *Sqlite3BookmarkRepository) UpdateWhere(ctx context.Context, domainCo
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	modelsToUpdate, err = Bookmarks(queryFilters...).All(ctx, repo.db)
+	modelsToUpdate, err = Bookmarks(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		return
 	}
@@ -1010,7 +1264,7 @@ func (repo *Sqlite3BookmarkRepository) UpdateWhere(ctx context.Context, domainCo
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -1037,7 +1291,7 @@ func (repo *Sqlite3BookmarkRepository) UpdateWhere(ctx context.Context, domainCo
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	numAffectedRecords = int64(len(modelsToUpdate))
 
@@ -1071,7 +1325,7 @@ func (repo *Sqlite3BookmarkRepository) Delete(ctx context.Context, domainModels
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -1109,7 +1363,7 @@ func (repo *Sqlite3BookmarkRepository) Delete(ctx context.Context, domainModels
 		}
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1139,18 +1393,23 @@ func (repo *Sqlite3BookmarkRepository) DeleteWhere(ctx context.Context, domainCo
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
 
 	numAffectedRecords, err = Bookmarks(queryFilters...).DeleteAll(ctx, tx)
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
 	return
 }
@@ -1180,13 +1439,113 @@ func (repo *Sqlite3BookmarkRepository) CountWhere(ctx context.Context, domainCol
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	return Bookmarks(queryFilters...).Count(ctx, repo.db)
+	return Bookmarks(queryFilters...).Count(ctx, repo.executor(ctx))
 }
 
 func (repo *Sqlite3BookmarkRepository) CountAll(ctx context.Context) (numRecords int64, err error) {
-	return Bookmarks().Count(ctx, repo.db)
+	return Bookmarks().Count(ctx, repo.executor(ctx))
+}
+
+func (repo *Sqlite3BookmarkRepository) CountGroupedWhere(ctx context.Context, domainColumnFilter *domain.BookmarkFilter, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.BookmarkDomainToRepositoryFilter(ctx, domainColumnFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*BookmarkFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *BookmarkFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	return repo.countGrouped(ctx, queryFilters, domainGrouper)
+}
+
+func (repo *Sqlite3BookmarkRepository) CountGroupedAll(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	return repo.countGrouped(ctx, queryModSliceBookmark{}, domainGrouper)
+}
+
+func (repo *Sqlite3BookmarkRepository) countGrouped(ctx context.Context, queryMods queryModSliceBookmark, domainGrouper *domain.BookmarkGrouper) (groups []*model.Group, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryGrouper any
+	repositoryGrouper, err = repo.BookmarkDomainToRepositoryGrouper(ctx, domainGrouper)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	grouping, ok := repositoryGrouper.(*BookmarkGrouping)
+	if !ok {
+		err = fmt.Errorf("expected type *BookmarkGrouping but got %T", repositoryGrouper)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if grouping.Join != "" {
+		queryMods = append(queryMods, qm.LeftOuterJoin(grouping.Join))
+	}
+
+	queryMods = append(queryMods,
+		qm.Select(grouping.Key+" AS group_key", "COUNT(DISTINCT "+BookmarkTableColumns.ID+") AS group_count"),
+		qm.GroupBy(grouping.GroupBy),
+		qm.OrderBy("group_key"),
+	)
+
+	var rows []struct {
+		Key   null.String `boil:"group_key"`
+		Count int64       `boil:"group_count"`
+	}
+
+	err = Bookmarks(queryMods...).Bind(ctx, repo.executor(ctx), &rows)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	groups = make([]*model.Group, 0, len(rows))
+
+	for _, row := range rows {
+		groups = append(groups, &model.Group{Key: row.Key.String, Count: row.Count})
+	}
+
+	return
 }
 
 func (repo *Sqlite3BookmarkRepository) DoesExist(ctx context.Context, domainModel *domain.Bookmark) (doesExist bool, err error) {
@@ -1214,7 +1573,7 @@ func (repo *Sqlite3BookmarkRepository) DoesExist(ctx context.Context, domainMode
 		return
 	}
 
-	return BookmarkExists(ctx, repo.db, repoModel.ID)
+	return BookmarkExists(ctx, repo.executor(ctx), repoModel.ID)
 }
 
 func (repo *Sqlite3BookmarkRepository) DoesExistWhere(ctx context.Context, domainColumnFilter *domain.BookmarkFilter) (doesExist bool, err error) {
@@ -1242,9 +1601,14 @@ func (repo *Sqlite3BookmarkRepository) DoesExistWhere(ctx context.Context, domai
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
-	return Bookmarks(queryFilters...).Exists(ctx, repo.db)
+	return Bookmarks(queryFilters...).Exists(ctx, repo.executor(ctx))
 }
 
 func (repo *Sqlite3BookmarkRepository) GetWhere(ctx context.Context, domainColumnFilter *domain.BookmarkFilter) (records []*domain.Bookmark, err error) {
@@ -1272,10 +1636,15 @@ func (repo *Sqlite3BookmarkRepository) GetWhere(ctx context.Context, domainColum
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryModels BookmarkSlice
-	repositoryModels, err = Bookmarks(queryFilters...).All(ctx, repo.db)
+	repositoryModels, err = Bookmarks(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1290,7 +1659,7 @@ func (repo *Sqlite3BookmarkRepository) GetWhere(ctx context.Context, domainColum
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1306,7 +1675,7 @@ func (repo *Sqlite3BookmarkRepository) GetWhere(ctx context.Context, domainColum
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1331,6 +1700,10 @@ func (repo *Sqlite3BookmarkRepository) GetWhere(ctx context.Context, domainColum
 }
 
 func (repo *Sqlite3BookmarkRepository) GetFirstWhere(ctx context.Context, domainColumnFilter *domain.BookmarkFilter) (record *domain.Bookmark, err error) {
+	return repo.GetFirstWhereSelected(ctx, domainColumnFilter, nil)
+}
+
+func (repo *Sqlite3BookmarkRepository) GetFirstWhereSelected(ctx context.Context, domainColumnFilter *domain.BookmarkFilter, domainSelector domain.BookmarkMemberSelector) (record *domain.Bookmark, err error) {
 	if domainColumnFilter == nil {
 		err = helper.NilInputError{}
 		repo.Logger.Error(err)
@@ -1355,10 +1728,36 @@ func (repo *Sqlite3BookmarkRepository) GetFirstWhere(ctx context.Context, domain
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositorySelector any
+	repositorySelector, err = repo.BookmarkDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*BookmarkSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *BookmarkSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryFilters = append(queryFilters, qm.Select(selection.Columns...))
+	}
 
 	var repositoryModel *Bookmark
-	repositoryModel, err = Bookmarks(queryFilters...).One(ctx, repo.db)
+	repositoryModel, err = Bookmarks(queryFilters...).One(ctx, repo.executor(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1369,21 +1768,22 @@ func (repo *Sqlite3BookmarkRepository) GetFirstWhere(ctx context.Context, domain
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repo.LoadEntityRelations(ctx, tx, repositoryModel)
+	err = repo.loadSelectedEntityRelations(ctx, tx, repositoryModel, selection)
 	if err != nil {
 		repo.Logger.Error(err)
+		repoCommon.RollbackTx(ctx, tx)
 
 		return
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1397,7 +1797,7 @@ func (repo *Sqlite3BookmarkRepository) GetFirstWhere(ctx context.Context, domain
 
 func (repo *Sqlite3BookmarkRepository) GetAll(ctx context.Context) (records []*domain.Bookmark, err error) {
 	var repositoryModels BookmarkSlice
-	repositoryModels, err = Bookmarks().All(ctx, repo.db)
+	repositoryModels, err = Bookmarks().All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1411,7 +1811,7 @@ func (repo *Sqlite3BookmarkRepository) GetAll(ctx context.Context) (records []*d
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1427,7 +1827,7 @@ func (repo *Sqlite3BookmarkRepository) GetAll(ctx context.Context) (records []*d
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1451,11 +1851,24 @@ func (repo *Sqlite3BookmarkRepository) GetAll(ctx context.Context) (records []*d
 	return
 }
 
-func (repo *Sqlite3BookmarkRepository) GetFromIDs(ctx context.Context, IDs []int64) (records []*domain.Bookmark, err error) {
-	filter := &domain.BookmarkFilter{ID: optional.Make(model.FilterOperation[int64]{Operand: model.ListOperand[int64]{IDs}, Operator: model.FilterIn})}
+func (repo *Sqlite3BookmarkRepository) GetWhereSorted(ctx context.Context, domainColumnFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	return repo.GetWhereSelected(ctx, domainColumnFilter, domainSorter, limiter, nil)
+}
+
+func (repo *Sqlite3BookmarkRepository) GetAllSorted(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter) (records []*domain.Bookmark, err error) {
+	return repo.GetAllSelected(ctx, domainSorter, limiter, nil)
+}
+
+func (repo *Sqlite3BookmarkRepository) GetWhereSelected(ctx context.Context, domainColumnFilter *domain.BookmarkFilter, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	if domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+	}
 
 	var repositoryFilter any
-	repositoryFilter, err = repo.BookmarkDomainToRepositoryFilter(ctx, filter)
+	repositoryFilter, err = repo.BookmarkDomainToRepositoryFilter(ctx, domainColumnFilter)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1471,25 +1884,183 @@ func (repo *Sqlite3BookmarkRepository) GetFromIDs(ctx context.Context, IDs []int
 		return
 	}
 
-	queryFilters := buildQueryModListFromFilterBookmark(repoFilter)
-
-	var repositoryModels BookmarkSlice
-	repositoryModels, err = Bookmarks(queryFilters...).All(ctx, repo.db)
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	if len(repositoryModels) == 0 {
-		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+	return repo.getSelected(ctx, queryFilters, domainSorter, limiter, domainSelector)
+}
 
-		repo.Logger.Error(err)
+func (repo *Sqlite3BookmarkRepository) GetAllSelected(ctx context.Context, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	return repo.getSelected(ctx, queryModSliceBookmark{}, domainSorter, limiter, domainSelector)
+}
+
+func (repo *Sqlite3BookmarkRepository) getSelected(ctx context.Context, queryMods queryModSliceBookmark, domainSorter domain.BookmarkSorter, limiter *model.Limiter, domainSelector domain.BookmarkMemberSelector) (records []*domain.Bookmark, err error) {
+	var repositorySelector any
+	repositorySelector, err = repo.BookmarkDomainToRepositoryMemberSelector(ctx, domainSelector)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	selection, ok := repositorySelector.(*BookmarkSelection)
+	if !ok {
+		err = fmt.Errorf("expected type *BookmarkSelection but got %T", repositorySelector)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if selection != nil {
+		queryMods = append(queryMods, qm.Select(selection.Columns...))
+	}
+
+	var repositorySorter any
+	repositorySorter, err = repo.BookmarkDomainToRepositorySorter(ctx, domainSorter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	orderByClauses, ok := repositorySorter.([]string)
+	if !ok {
+		err = fmt.Errorf("expected type []string but got %T", repositorySorter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryMods = append(queryMods, qm.OrderBy(strings.Join(orderByClauses, ", ")))
+
+	if limiter != nil {
+		if limiter.Limit < 0 || limiter.Offset < 0 {
+			err = repoCommon.InvalidLimiterError{Limiter: *limiter}
+
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		if limiter.Limit > 0 {
+			queryMods = append(queryMods, qm.Limit(int(limiter.Limit)))
+		} else if limiter.Offset > 0 {
+			// Most DBMS' do not support an offset without a limit
+			queryMods = append(queryMods, qm.Limit(math.MaxInt32))
+		}
+
+		if limiter.Offset > 0 {
+			queryMods = append(queryMods, qm.Offset(int(limiter.Offset)))
+		}
+	}
+
+	var repositoryModels BookmarkSlice
+	repositoryModels, err = Bookmarks(queryMods...).All(ctx, repo.executor(ctx))
+	if err != nil {
+		repo.Logger.Error(err)
 
 		return
 	}
 
-	tx, err := repo.db.BeginTx(ctx, nil)
+	if len(repositoryModels) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	for _, repoModel := range repositoryModels {
+		err = repo.loadSelectedEntityRelations(ctx, tx, repoModel, selection)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+	}
+
+	err = repoCommon.CommitTx(ctx, tx)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	records = make([]*domain.Bookmark, 0, len(repositoryModels))
+
+	var domainModel *domain.Bookmark
+	for _, repoModel := range repositoryModels {
+		domainModel, err = repo.BookmarkRepositoryToDomainModel(ctx, repoModel)
+		if err != nil {
+			repo.Logger.Error(err)
+
+			return
+		}
+
+		records = append(records, domainModel)
+	}
+
+	return
+}
+
+func (repo *Sqlite3BookmarkRepository) GetFromIDs(ctx context.Context, IDs []int64) (records []*domain.Bookmark, err error) {
+	filter := &domain.BookmarkFilter{ID: optional.Make(model.FilterOperation[int64]{Operand: model.ListOperand[int64]{IDs}, Operator: model.FilterIn})}
+
+	var repositoryFilter any
+	repositoryFilter, err = repo.BookmarkDomainToRepositoryFilter(ctx, filter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	repoFilter, ok := repositoryFilter.(*BookmarkFilter)
+	if !ok {
+		err = fmt.Errorf("expected type *BookmarkFilter but got %T", repoFilter)
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	queryFilters, err := buildQueryModListFromFilterBookmark(repoFilter)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	var repositoryModels BookmarkSlice
+	repositoryModels, err = Bookmarks(queryFilters...).All(ctx, repo.executor(ctx))
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if len(repositoryModels) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1505,7 +2076,7 @@ func (repo *Sqlite3BookmarkRepository) GetFromIDs(ctx context.Context, IDs []int
 		}
 	}
 
-	err = tx.Commit()
+	err = repoCommon.CommitTx(ctx, tx)
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1543,7 +2114,7 @@ func (repo *Sqlite3BookmarkRepository) AddType(ctx context.Context, types []stri
 	for _, type_ := range types {
 		repositoryModel := BookmarkType{BookmarkType: type_}
 
-		err = repositoryModel.Insert(ctx, repo.db, boil.Infer())
+		err = repositoryModel.Insert(ctx, repo.executor(ctx), boil.Infer())
 		if err != nil {
 			if strings.Contains(err.Error(), "UNIQUE") {
 				err = helper.DuplicateInsertionError{Inner: err}
@@ -1571,7 +2142,7 @@ func (repo *Sqlite3BookmarkRepository) DeleteType(ctx context.Context, types []s
 
 	var numAffectedRecords int64
 
-	numAffectedRecords, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.IN(types)).DeleteAll(ctx, repo.db)
+	numAffectedRecords, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.IN(types)).DeleteAll(ctx, repo.executor(ctx))
 	if numAffectedRecords == 0 {
 		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
 
@@ -1585,7 +2156,7 @@ func (repo *Sqlite3BookmarkRepository) DeleteType(ctx context.Context, types []s
 
 func (repo *Sqlite3BookmarkRepository) UpdateType(ctx context.Context, oldType string, newType string) (err error) {
 	var repositoryModel *BookmarkType
-	repositoryModel, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(oldType)).One(ctx, repo.db)
+	repositoryModel, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(oldType)).One(ctx, repo.executor(ctx))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1599,7 +2170,7 @@ func (repo *Sqlite3BookmarkRepository) UpdateType(ctx context.Context, oldType s
 	repositoryModel.BookmarkType = newType
 
 	var numAffectedRecords int64
-	numAffectedRecords, err = repositoryModel.Update(ctx, repo.db, boil.Infer())
+	numAffectedRecords, err = repositoryModel.Update(ctx, repo.executor(ctx), boil.Infer())
 	if err != nil {
 		if strings.Contains(err.Error(), "UNIQUE") {
 			err = helper.DuplicateInsertionError{Inner: err}
@@ -1612,7 +2183,7 @@ func (repo *Sqlite3BookmarkRepository) UpdateType(ctx context.Context, oldType s
 
 	if numAffectedRecords == 0 {
 		var doesExist bool
-		doesExist, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(oldType)).Exists(ctx, repo.db)
+		doesExist, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(oldType)).Exists(ctx, repo.executor(ctx))
 		if err != nil {
 			repo.Logger.Error(err)
 
@@ -1633,7 +2204,7 @@ func (repo *Sqlite3BookmarkRepository) UpdateType(ctx context.Context, oldType s
 
 func (repo *Sqlite3BookmarkRepository) GetAllTypes(ctx context.Context) (records []string, err error) {
 	var repositoryModels []*BookmarkType
-	repositoryModels, err = BookmarkTypes().All(ctx, repo.db)
+	repositoryModels, err = BookmarkTypes().All(ctx, repo.executor(ctx))
 	if err != nil {
 		repo.Logger.Error(err)
 
@@ -1736,7 +2307,7 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryModel(ctx conte
 
 	if domainModel.TagIDs != nil {
 		for _, domainTagID := range domainModel.TagIDs {
-			repositoryTag, err = Tags(TagWhere.ID.EQ(domainTagID)).One(ctx, repo.db)
+			repositoryTag, err = Tags(TagWhere.ID.EQ(domainTagID)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1754,7 +2325,7 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryModel(ctx conte
 		var repositoryBookmarkType *BookmarkType
 
 		repositoryModelConcrete.R.BookmarkType = &BookmarkType{BookmarkType: domainModel.BookmarkType.Wrappee}
-		repositoryBookmarkType, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(domainModel.BookmarkType.Wrappee)).One(ctx, repo.db)
+		repositoryBookmarkType, err = BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(domainModel.BookmarkType.Wrappee)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1829,18 +2400,23 @@ func (repo *Sqlite3BookmarkRepository) BookmarkRepositoryToDomainModel(ctx conte
 
 	//**********************    Set Timestamps    **********************//
 
-	domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-	if err != nil {
-		repo.Logger.Error(err)
+	// Timestamps are empty if they were not selected
+	if repositoryModelConcrete.CreatedAt != "" {
+		domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+		if err != nil {
+			repo.Logger.Error(err)
 
-		return
+			return
+		}
 	}
 
-	domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-	if err != nil {
-		repo.Logger.Error(err)
+	if repositoryModelConcrete.UpdatedAt != "" {
+		domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+		if err != nil {
+			repo.Logger.Error(err)
 
-		return
+			return
+		}
 	}
 
 	if repositoryModelConcrete.DeletedAt.Valid {
@@ -2001,18 +2577,23 @@ func (repo *Sqlite3BookmarkRepository) BookmarkRepositoryToDomainModelTx(ctx con
 
 	//**********************    Set Timestamps    **********************//
 
-	domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-	if err != nil {
-		repo.Logger.Error(err)
+	// Timestamps are empty if they were not selected
+	if repositoryModelConcrete.CreatedAt != "" {
+		domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+		if err != nil {
+			repo.Logger.Error(err)
 
-		return
+			return
+		}
 	}
 
-	domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-	if err != nil {
-		repo.Logger.Error(err)
+	if repositoryModelConcrete.UpdatedAt != "" {
+		domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+		if err != nil {
+			repo.Logger.Error(err)
 
-		return
+			return
+		}
 	}
 
 	if repositoryModelConcrete.DeletedAt.Valid {
@@ -2138,7 +2719,8 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryFilter(ctx cont
 	if domainFilter.TagIDs.HasValue {
 		var convertedFilter model.FilterOperation[*Tag]
 
-		converter := func(tagID int64) (*Tag, error) { return Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db) }
+		// Only the IDs are needed to filter through the join tables
+		converter := func(tagID int64) (*Tag, error) { return &Tag{ID: tagID}, nil }
 		convertedFilter, err = model.ConvertFilter[*Tag, int64](domainFilter.TagIDs.Wrappee, converter)
 		if err != nil {
 			repo.Logger.Error(err)
@@ -2158,7 +2740,7 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryFilter(ctx cont
 				return null.NewInt64(-1, false), nil
 			}
 
-			bookmarkType, err := BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(type_.Wrappee)).One(ctx, repo.db)
+			bookmarkType, err := BookmarkTypes(BookmarkTypeWhere.BookmarkType.EQ(type_.Wrappee)).One(ctx, repo.executor(ctx))
 
 			return null.NewInt64(bookmarkType.ID, true), err
 		})
@@ -2171,11 +2753,65 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryFilter(ctx cont
 		repositoryFilterConcrete.BookmarkTypeID.Set(convertedTypeIDFilter)
 	}
 
+	err = repo.BookmarkDomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+	if err != nil {
+		return
+	}
+
 	repositoryFilter = repositoryFilterConcrete
 
 	return
 }
 
+// BookmarkDomainToRepositoryComposedFilters converts the And, Or and Not subfilters of domainFilter into repositoryFilter.
+func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryComposedFilters(ctx context.Context, domainFilter *domain.BookmarkFilter, repositoryFilter *BookmarkFilter) (err error) {
+	convertSubfilter := func(domainSubfilter *domain.BookmarkFilter) (*BookmarkFilter, error) {
+		if domainSubfilter == nil {
+			err := helper.NilInputError{}
+			repo.Logger.Error(err)
+
+			return nil, err
+		}
+
+		repositorySubfilter, err := repo.BookmarkDomainToRepositoryFilter(ctx, domainSubfilter)
+		if err != nil {
+			return nil, err
+		}
+
+		return repositorySubfilter.(*BookmarkFilter), nil
+	}
+
+	repositoryFilter.And = make([]*BookmarkFilter, 0, len(domainFilter.And))
+	for _, domainSubfilter := range domainFilter.And {
+		var repositorySubfilter *BookmarkFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.And = append(repositoryFilter.And, repositorySubfilter)
+	}
+
+	repositoryFilter.Or = make([]*BookmarkFilter, 0, len(domainFilter.Or))
+	for _, domainSubfilter := range domainFilter.Or {
+		var repositorySubfilter *BookmarkFilter
+
+		repositorySubfilter, err = convertSubfilter(domainSubfilter)
+		if err != nil {
+			return
+		}
+
+		repositoryFilter.Or = append(repositoryFilter.Or, repositorySubfilter)
+	}
+
+	if domainFilter.Not != nil {
+		repositoryFilter.Not, err = convertSubfilter(domainFilter.Not)
+	}
+
+	return
+}
+
 //******************************************************************//
 //                         Updater Converter                        //
 //******************************************************************//
@@ -2221,7 +2857,7 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryUpdater(ctx con
 			return
 		}
 
-		repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedUpdater})
+		repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedUpdater})
 
 	}
 
@@ -2246,7 +2882,7 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryUpdater(ctx con
 		convertedUpdater := make(TagSlice, 0, len(domainUpdater.TagIDs.Wrappee.Operand))
 
 		for _, tag := range domainUpdater.TagIDs.Wrappee.Operand {
-			rawTag, err = Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.db)
+			rawTag, err = Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				repo.Logger.Error(err)
 
@@ -2297,6 +2933,194 @@ func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryUpdater(ctx con
 
 }
 
+//******************************************************************//
+//                         Sorter Converter                         //
+//******************************************************************//
+
+var bookmarkSortExpressions = map[domain.BookmarkField]string{
+	"CreatedAt":    BookmarkTableColumns.CreatedAt,
+	"UpdatedAt":    BookmarkTableColumns.UpdatedAt,
+	"DeletedAt":    BookmarkTableColumns.DeletedAt,
+	"URL":          BookmarkTableColumns.URL,
+	"Title":        BookmarkTableColumns.Title,
+	"ID":           BookmarkTableColumns.ID,
+	"IsCollection": BookmarkTableColumns.IsCollection,
+	"IsRead":       BookmarkTableColumns.IsRead,
+	"BookmarkType": "(SELECT " + BookmarkTypeTableColumns.BookmarkType + " FROM " + TableNames.BookmarkTypes + " WHERE " + BookmarkTypeTableColumns.ID + " = " + BookmarkTableColumns.BookmarkTypeID + ")",
+}
+
+func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositorySorter(ctx context.Context, domainSorter domain.BookmarkSorter) (repositorySorter any, err error) {
+	orderByClauses := make([]string, 0, len(domainSorter)+1)
+	isSortedByID := false
+
+	for _, sortKey := range domainSorter {
+		expression, ok := bookmarkSortExpressions[sortKey.Field]
+		if !ok {
+			err = repoCommon.UnsortableFieldError{Field: string(sortKey.Field)}
+
+			return
+		}
+
+		isSortedByID = isSortedByID || sortKey.Field == "ID"
+
+		if sortKey.Direction == model.SortDescending {
+			orderByClauses = append(orderByClauses, expression+" DESC")
+		} else {
+			orderByClauses = append(orderByClauses, expression+" ASC")
+		}
+	}
+
+	// Breaking ties by the primary key gives a total order, which is needed for stable pagination
+	if !isSortedByID {
+		orderByClauses = append(orderByClauses, BookmarkTableColumns.ID+" ASC")
+	}
+
+	repositorySorter = orderByClauses
+
+	return
+}
+
+//******************************************************************//
+//                     Member Selector Converter                    //
+//******************************************************************//
+
+// BookmarkSelection holds the columns and relations to load for a domain.BookmarkMemberSelector.
+type BookmarkSelection struct {
+	Columns   []string
+	Relations []string
+}
+
+var bookmarkMemberSelections = map[domain.BookmarkField]BookmarkSelection{
+	"CreatedAt":    {Columns: []string{BookmarkTableColumns.CreatedAt}},
+	"UpdatedAt":    {Columns: []string{BookmarkTableColumns.UpdatedAt}},
+	"DeletedAt":    {Columns: []string{BookmarkTableColumns.DeletedAt}},
+	"URL":          {Columns: []string{BookmarkTableColumns.URL}},
+	"Title":        {Columns: []string{BookmarkTableColumns.Title}},
+	"ID":           {Columns: []string{BookmarkTableColumns.ID}},
+	"IsCollection": {Columns: []string{BookmarkTableColumns.IsCollection}},
+	"IsRead":       {Columns: []string{BookmarkTableColumns.IsRead}},
+	"TagIDs":       {Relations: []string{BookmarkRels.Tags}},
+	"BookmarkType": {Columns: []string{BookmarkTableColumns.BookmarkTypeID}, Relations: []string{BookmarkRels.BookmarkType}},
+}
+
+func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.BookmarkMemberSelector) (repositorySelector any, err error) {
+	if len(domainSelector) == 0 {
+		repositorySelector = (*BookmarkSelection)(nil)
+
+		return
+	}
+
+	// The primary key is always needed to load relations
+	selection := &BookmarkSelection{Columns: []string{BookmarkTableColumns.ID}}
+	isSelected := map[string]bool{BookmarkTableColumns.ID: true}
+
+	for _, field := range domainSelector {
+		fieldSelection, ok := bookmarkMemberSelections[field]
+		if !ok {
+			err = repoCommon.UnselectableFieldError{Field: string(field)}
+
+			return
+		}
+
+		for _, column := range fieldSelection.Columns {
+			if !isSelected[column] {
+				isSelected[column] = true
+				selection.Columns = append(selection.Columns, column)
+			}
+		}
+
+		for _, relation := range fieldSelection.Relations {
+			if !isSelected[relation] {
+				isSelected[relation] = true
+				selection.Relations = append(selection.Relations, relation)
+			}
+		}
+	}
+
+	repositorySelector = selection
+
+	return
+}
+
+//******************************************************************//
+//                         Grouper Converter                        //
+//******************************************************************//
+
+// BookmarkGrouping holds the SQL expressions needed to group by a domain.BookmarkGrouper.
+// Key is selected as the groups' key and must be functionally dependent on GroupBy,
+// Join is left joined to the bookmarks if set.
+type BookmarkGrouping struct {
+	GroupBy string
+	Key     string
+	Join    string
+}
+
+var bookmarkGroupings = map[domain.BookmarkField]BookmarkGrouping{
+	"IsCollection": {GroupBy: BookmarkTableColumns.IsCollection},
+	"IsRead":       {GroupBy: BookmarkTableColumns.IsRead},
+	"TagIDs":       {GroupBy: TableNames.BookmarkContexts + ".tag_id", Join: TableNames.BookmarkContexts + " ON " + TableNames.BookmarkContexts + ".bookmark_id = " + BookmarkTableColumns.ID},
+	"BookmarkType": {GroupBy: BookmarkTableColumns.BookmarkTypeID, Key: "(SELECT " + BookmarkTypeTableColumns.BookmarkType + " FROM " + TableNames.BookmarkTypes + " WHERE " + BookmarkTypeTableColumns.ID + " = " + BookmarkTableColumns.BookmarkTypeID + ")"},
+}
+
+var bookmarkTimestampColumns = map[domain.BookmarkField]string{
+	"CreatedAt": BookmarkTableColumns.CreatedAt,
+	"UpdatedAt": BookmarkTableColumns.UpdatedAt,
+	"DeletedAt": BookmarkTableColumns.DeletedAt,
+}
+
+func bookmarkTimeBucketExpression(column string, bucket model.TimeBucket) (expression string, ok bool) {
+	switch bucket {
+	case 0, model.BucketDay:
+		return "date(" + column + ")", true
+	case model.BucketWeek:
+		return "date(" + column + ", '-6 days', 'weekday 1')", true
+	case model.BucketMonth:
+		return "strftime('%Y-%m-01', " + column + ")", true
+	case model.BucketYear:
+		return "strftime('%Y-01-01', " + column + ")", true
+	default:
+		return "", false
+	}
+}
+
+func (repo *Sqlite3BookmarkRepository) BookmarkDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.BookmarkGrouper) (repositoryGrouper any, err error) {
+	if domainGrouper == nil {
+		err = helper.NilInputError{}
+
+		return
+	}
+
+	grouping := new(BookmarkGrouping)
+
+	if column, isTimestamp := bookmarkTimestampColumns[domainGrouper.Field]; isTimestamp {
+		var ok bool
+
+		grouping.GroupBy, ok = bookmarkTimeBucketExpression(column, domainGrouper.Bucket)
+		if !ok {
+			err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+			return
+		}
+	} else {
+		fieldGrouping, ok := bookmarkGroupings[domainGrouper.Field]
+		if !ok || domainGrouper.Bucket != 0 {
+			err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+			return
+		}
+
+		*grouping = fieldGrouping
+	}
+
+	if grouping.Key == "" {
+		grouping.Key = grouping.GroupBy
+	}
+
+	repositoryGrouper = grouping
+
+	return
+}
+
 func (repo *Sqlite3BookmarkRepository) UpdateRelatedEntities(ctx context.Context, tx *sql.Tx, repositoryModel *Bookmark) error {
 	var err error
 
@@ -2329,15 +3153,42 @@ func (repo *Sqlite3BookmarkRepository) LoadEntityRelations(ctx context.Context,
 		repoModel.R = repoModel.R.NewStruct()
 	}
 
-	err = repoModel.L.LoadTags(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
 	if err != nil {
 		repo.Logger.Error(err)
 
 		return
 	}
 
-	err = repoModel.L.LoadBookmarkType(ctx, repo.db, true, repoModel, nil)
+	err = repoModel.L.LoadBookmarkType(ctx, repo.executor(ctx), true, repoModel, nil)
 
 	return
 
 }
+
+// loadSelectedEntityRelations loads only the relations of selection, all of them if selection is nil.
+func (repo *Sqlite3BookmarkRepository) loadSelectedEntityRelations(ctx context.Context, tx *sql.Tx, repoModel *Bookmark, selection *BookmarkSelection) (err error) {
+	if selection == nil {
+		return repo.LoadEntityRelations(ctx, tx, repoModel)
+	}
+
+	if repoModel.R == nil {
+		repoModel.R = repoModel.R.NewStruct()
+	}
+
+	for _, relation := range selection.Relations {
+		switch relation {
+		case BookmarkRels.Tags:
+			err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
+		case BookmarkRels.BookmarkType:
+			err = repoModel.L.LoadBookmarkType(ctx, repo.executor(ctx), true, repoModel, nil)
+		}
+		if err != nil {
+			repo.Logger.Error(err)
+
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,132 @@
+//go:build !sqlite_fts5
+
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	repoCommon "github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// FTS4 is always available but lacks a builtin ranking function,
+// so results are ranked with Okapi BM25 computed from matchinfo().
+const documentContentSearchTableSchema = `CREATE VIRTUAL TABLE IF NOT EXISTS ` + documentContentSearchTable + ` USING fts4(path, content, notindexed=path)`
+
+var documentContentSearchQuery = fmt.Sprintf(
+	"SELECT path, snippet(%[1]s, '%[2]s', '%[3]s', '%[4]s', 1, %[5]d), matchinfo(%[1]s, 'pcnalx') FROM %[1]s WHERE %[1]s MATCH ?",
+	documentContentSearchTable,
+	SnippetMatchStart,
+	SnippetMatchEnd,
+	SnippetEllipsis,
+	SnippetNumTokens,
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+	// Index of the content column in the search table.
+	documentContentSearchContentColumn = 1
+)
+
+func (repo *Sqlite3DocumentContentSearchRepository) search(ctx context.Context, query string, paths []string) (results []repoCommon.DocumentContentSearchResult, err error) {
+	sqlQuery, args := searchQueryWithPathRestriction(documentContentSearchQuery, query, paths)
+
+	rows, err := repoCommon.Executor(ctx, repo.db).QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result repoCommon.DocumentContentSearchResult
+		var matchinfo []byte
+
+		err = rows.Scan(&result.Path, &result.Snippet, &matchinfo)
+		if err != nil {
+			return
+		}
+
+		result.Rank = bm25FromMatchinfo(matchinfo, documentContentSearchContentColumn)
+
+		results = append(results, result)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Rank > results[j].Rank })
+
+	return
+}
+
+// bm25FromMatchinfo computes the BM25 score of a row for a single column from the output of matchinfo(table, 'pcnalx').
+func bm25FromMatchinfo(matchinfo []byte, column int) (score float64) {
+	// NOTE: matchinfo() returns an array of unsigned 32 bit integers in native byte order, all supported platforms are little endian.
+	values := make([]uint32, len(matchinfo)/4)
+	for i := range values {
+		values[i] = binary.LittleEndian.Uint32(matchinfo[i*4:])
+	}
+
+	if len(values) < 2 {
+		return
+	}
+
+	numPhrases := int(values[0])
+	numColumns := int(values[1])
+
+	// Ignore unexpected or truncated output instead of reading past its end
+	if column >= numColumns || len(values) < 3+2*numColumns+3*numPhrases*numColumns {
+		return
+	}
+
+	numRows := float64(values[2])
+	averageLength := float64(values[3+column])
+	length := float64(values[3+numColumns+column])
+	hits := values[3+2*numColumns:]
+
+	if averageLength == 0 {
+		averageLength = 1
+	}
+
+	for phrase := 0; phrase < numPhrases; phrase++ {
+		offset := 3 * (phrase*numColumns + column)
+
+		hitsInRow := float64(hits[offset])
+		numRowsWithHits := float64(hits[offset+2])
+
+		inverseDocumentFrequency := math.Log((numRows - numRowsWithHits + 0.5) / (numRowsWithHits + 0.5))
+		if inverseDocumentFrequency <= 0 {
+			inverseDocumentFrequency = 1e-6
+		}
+
+		score += inverseDocumentFrequency * (hitsInRow * (bm25K1 + 1)) / (hitsInRow + bm25K1*(1-bm25B+bm25B*length/averageLength))
+	}
+
+	return
+}
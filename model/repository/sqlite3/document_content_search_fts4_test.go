@@ -0,0 +1,70 @@
+//go:build !sqlite_fts5
+
+package repository
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBM25FromMatchinfo(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []uint32
+		column     int
+		isZero     bool
+		isPositive bool
+	}{
+		{
+			name:   "Empty matchinfo",
+			values: []uint32{},
+			isZero: true,
+		},
+		{
+			name:   "Header only",
+			values: []uint32{1, 2},
+			isZero: true,
+		},
+		{
+			name:   "Truncated hits",
+			values: []uint32{1, 2, 10, 5, 5, 5, 5, 1, 1},
+			isZero: true,
+		},
+		{
+			name:   "Column out of range",
+			values: []uint32{1, 2, 10, 5, 5, 5, 5, 1, 1, 1, 1, 1, 1},
+			column: 2,
+			isZero: true,
+		},
+		{
+			name:       "One phrase, two columns",
+			values:     []uint32{1, 2, 10, 5, 5, 5, 5, 0, 0, 0, 3, 3, 2},
+			column:     1,
+			isPositive: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			matchinfo := make([]byte, 4*len(test.values))
+			for i, value := range test.values {
+				binary.LittleEndian.PutUint32(matchinfo[i*4:], value)
+			}
+
+			var score float64
+			assert.NotPanics(t, func() { score = bm25FromMatchinfo(matchinfo, test.column) }, test.name+", assert no panic")
+
+			if test.isZero {
+				assert.Zero(t, score, test.name+", assert score is zero")
+			}
+			if test.isPositive {
+				assert.Positive(t, score, test.name+", assert score is positive")
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+//go:build sqlite_fts5
+
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	repoCommon "github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+const documentContentSearchTableSchema = `CREATE VIRTUAL TABLE IF NOT EXISTS ` + documentContentSearchTable + ` USING fts5(path UNINDEXED, content)`
+
+var documentContentSearchQuery = fmt.Sprintf(
+	"SELECT path, snippet(%[1]s, 1, '%[2]s', '%[3]s', '%[4]s', %[5]d), bm25(%[1]s) FROM %[1]s WHERE %[1]s MATCH ?",
+	documentContentSearchTable,
+	SnippetMatchStart,
+	SnippetMatchEnd,
+	SnippetEllipsis,
+	SnippetNumTokens,
+)
+
+func (repo *Sqlite3DocumentContentSearchRepository) search(ctx context.Context, query string, paths []string) (results []repoCommon.DocumentContentSearchResult, err error) {
+	sqlQuery, args := searchQueryWithPathRestriction(documentContentSearchQuery, query, paths)
+
+	// bm25() returns lower values for better matches.
+	rows, err := repoCommon.Executor(ctx, repo.db).QueryContext(ctx, sqlQuery+" ORDER BY bm25("+documentContentSearchTable+")", args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result repoCommon.DocumentContentSearchResult
+
+		err = rows.Scan(&result.Path, &result.Snippet, &result.Rank)
+		if err != nil {
+			return
+		}
+
+		result.Rank = -result.Rank
+
+		results = append(results, result)
+	}
+
+	err = rows.Err()
+
+	return
+}
@@ -0,0 +1,228 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	repoCommon "github.com/JonasMuehlmann/bntp.go/model/repository"
+	"github.com/barweiss/go-tuple"
+	log "github.com/sirupsen/logrus"
+)
+
+//******************************************************************//
+//                        Types and constants                       //
+//******************************************************************//
+
+// The sqlite3 driver only ships FTS5 when built with the sqlite_fts5 tag,
+// the table schema and search query for each variant are defined in document_content_search_fts{4,5}.go.
+const documentContentSearchTable = "document_contents_search"
+
+const (
+	// SnippetMatchStart marks the start of a match inside a search result snippet.
+	SnippetMatchStart = "**"
+	// SnippetMatchEnd marks the end of a match inside a search result snippet.
+	SnippetMatchEnd = "**"
+	// SnippetEllipsis marks omitted text at the start or end of a search result snippet.
+	SnippetEllipsis = "..."
+	// SnippetNumTokens is the maximum number of tokens in a search result snippet.
+	SnippetNumTokens = 16
+)
+
+type Sqlite3DocumentContentSearchRepositoryConstructorArgs struct {
+	DB     *sql.DB
+	Logger *log.Logger
+}
+
+type Sqlite3DocumentContentSearchRepository struct {
+	db     *sql.DB
+	Logger *log.Logger
+}
+
+//******************************************************************//
+//                              Methods                             //
+//******************************************************************//
+
+func (repo *Sqlite3DocumentContentSearchRepository) New(args any) (newRepo repoCommon.DocumentContentSearchRepository, err error) {
+	constructorArgs, ok := args.(Sqlite3DocumentContentSearchRepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", Sqlite3DocumentContentSearchRepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.db = constructorArgs.DB
+	repo.Logger = constructorArgs.Logger
+
+	_, err = repo.db.Exec(documentContentSearchTableSchema)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *Sqlite3DocumentContentSearchRepository) Index(ctx context.Context, pathContents []tuple.T2[string, string]) (err error) {
+	if len(pathContents) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	for _, pathContent := range pathContents {
+		_, err = tx.ExecContext(ctx, "DELETE FROM "+documentContentSearchTable+" WHERE path = ?", pathContent.V1)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO "+documentContentSearchTable+" (path, content) VALUES (?, ?)", pathContent.V1, pathContent.V2)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+	}
+
+	return repoCommon.CommitTx(ctx, tx)
+}
+
+func (repo *Sqlite3DocumentContentSearchRepository) Move(ctx context.Context, pathChanges []tuple.T2[string, string]) (err error) {
+	if len(pathChanges) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	tx, err := repoCommon.BeginTx(ctx, repo.db)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	for _, pathChange := range pathChanges {
+		_, err = tx.ExecContext(ctx, "UPDATE "+documentContentSearchTable+" SET path = ? WHERE path = ?", pathChange.V2, pathChange.V1)
+		if err != nil {
+			repo.Logger.Error(err)
+			repoCommon.RollbackTx(ctx, tx)
+
+			return
+		}
+	}
+
+	return repoCommon.CommitTx(ctx, tx)
+}
+
+func (repo *Sqlite3DocumentContentSearchRepository) Delete(ctx context.Context, paths []string) (err error) {
+	if len(paths) == 0 {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	query := "DELETE FROM " + documentContentSearchTable + " WHERE path IN (" + sqlPlaceholders(len(paths)) + ")"
+
+	args := make([]any, 0, len(paths))
+	for _, path := range paths {
+		args = append(args, path)
+	}
+
+	_, err = repoCommon.Executor(ctx, repo.db).ExecContext(ctx, query, args...)
+	if err != nil {
+		repo.Logger.Error(err)
+	}
+
+	return
+}
+
+func (repo *Sqlite3DocumentContentSearchRepository) DeleteAll(ctx context.Context) (err error) {
+	_, err = repoCommon.Executor(ctx, repo.db).ExecContext(ctx, "DELETE FROM "+documentContentSearchTable)
+	if err != nil {
+		repo.Logger.Error(err)
+	}
+
+	return
+}
+
+func (repo *Sqlite3DocumentContentSearchRepository) Search(ctx context.Context, query string, paths []string) (results []repoCommon.DocumentContentSearchResult, err error) {
+	if query == "" || (paths != nil && len(paths) == 0) {
+		repo.Logger.Debug(helper.LogMessageEmptyInput)
+
+		return nil, helper.IneffectiveOperationError{Inner: helper.EmptyInputError{}}
+	}
+
+	results, err = repo.search(ctx, query, paths)
+	if err != nil {
+		repo.Logger.Error(err)
+
+		return
+	}
+
+	if len(results) == 0 {
+		err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+		repo.Logger.Error(err)
+	}
+
+	return
+}
+
+//******************************************************************//
+//                              Helpers                             //
+//******************************************************************//
+
+// searchQueryWithPathRestriction appends a restriction to the given paths to a search query, if there are any.
+func searchQueryWithPathRestriction(query string, searchQuery string, paths []string) (string, []any) {
+	args := []any{searchQuery}
+
+	if paths != nil {
+		query += " AND path IN (" + sqlPlaceholders(len(paths)) + ")"
+
+		for _, path := range paths {
+			args = append(args, path)
+		}
+	}
+
+	return query, args
+}
+
+func sqlPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,160 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JonasMuehlmann/bntp.go/internal/helper"
+	repository "github.com/JonasMuehlmann/bntp.go/model/repository/sqlite3"
+	testCommon "github.com/JonasMuehlmann/bntp.go/test"
+	"github.com/barweiss/go-tuple"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLDocumentContentSearchRepositorySearchTest(t *testing.T) {
+	tests := []struct {
+		err          error
+		name         string
+		query        string
+		pathContents []tuple.T2[string, string]
+		pathChanges  []tuple.T2[string, string]
+		deletedPaths []string
+		paths        []string
+		resultPaths  []string
+		snippet      string
+	}{
+		{
+			name: "Empty query", query: "", err: helper.IneffectiveOperationError{},
+		},
+		{
+			name: "Empty index", query: "foo", err: helper.IneffectiveOperationError{},
+		},
+		{
+			name:  "No matches",
+			query: "baz",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo bar"},
+			},
+			err: helper.IneffectiveOperationError{},
+		},
+		{
+			name:  "Matches ranked",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "bar.md", V2: "bar bar bar bar bar bar foo"},
+				{V1: "foo.md", V2: "foo foo bar"},
+				{V1: "baz.md", V2: "baz"},
+			},
+			resultPaths: []string{"foo.md", "bar.md"},
+			snippet:     "**foo** **foo** bar",
+		},
+		{
+			name:  "Reindexed content",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo"},
+				{V1: "bar.md", V2: "bar"},
+				{V1: "foo.md", V2: "bar"},
+				{V1: "bar.md", V2: "foo"},
+			},
+			resultPaths: []string{"bar.md"},
+		},
+		{
+			name:  "Moved content",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo"},
+			},
+			pathChanges: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "bar.md"},
+			},
+			resultPaths: []string{"bar.md"},
+		},
+		{
+			name:  "Deleted content",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo"},
+				{V1: "bar.md", V2: "foo"},
+			},
+			deletedPaths: []string{"foo.md"},
+			resultPaths:  []string{"bar.md"},
+		},
+		{
+			name:  "Restricted to paths",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo"},
+				{V1: "bar.md", V2: "foo"},
+			},
+			paths:       []string{"bar.md", "baz.md"},
+			resultPaths: []string{"bar.md"},
+		},
+		{
+			name:  "Restricted to no paths",
+			query: "foo",
+			pathContents: []tuple.T2[string, string]{
+				{V1: "foo.md", V2: "foo"},
+			},
+			paths: []string{},
+			err:   helper.IneffectiveOperationError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			repo := new(repository.Sqlite3DocumentContentSearchRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3DocumentContentSearchRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3DocumentContentSearchRepository)
+
+			for _, pathContent := range test.pathContents {
+				err = repo.Index(context.Background(), []tuple.T2[string, string]{pathContent})
+				require.NoErrorf(t, err, test.name+", indexing contents")
+			}
+
+			if test.pathChanges != nil {
+				err = repo.Move(context.Background(), test.pathChanges)
+				require.NoErrorf(t, err, test.name+", moving contents")
+			}
+
+			if test.deletedPaths != nil {
+				err = repo.Delete(context.Background(), test.deletedPaths)
+				require.NoErrorf(t, err, test.name+", deleting contents")
+			}
+
+			results, err := repo.Search(context.Background(), test.query, test.paths)
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+			} else {
+				assert.ErrorAsf(t, err, &test.err, test.name)
+			}
+
+			if test.resultPaths != nil {
+				resultPaths := make([]string, 0, len(results))
+				for _, result := range results {
+					resultPaths = append(resultPaths, result.Path)
+				}
+
+				assert.Equal(t, test.resultPaths, resultPaths, test.name+", assert result order")
+			}
+
+			if test.snippet != "" {
+				assert.Equal(t, test.snippet, results[0].Snippet, test.name+", assert snippet")
+			}
+		})
+	}
+}
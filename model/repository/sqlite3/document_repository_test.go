@@ -122,6 +122,40 @@ func TestSQLDocumentRepositoryAddTest(t *testing.T) {
 	}
 }
 
+func TestSQLDocumentRepositoryAddGeneratedIDsTest(t *testing.T) {
+	db, err := testCommon.GetDB()
+	require.NoError(t, err, "db open")
+	defer db.Close()
+
+	repo := new(repository.Sqlite3DocumentRepository)
+
+	repoAbstract, err := repo.New(repository.Sqlite3DocumentRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+	require.NoError(t, err, "repository creation")
+
+	repo = repoAbstract.(*repository.Sqlite3DocumentRepository)
+
+	// The models have no IDs, so the database generates them
+	models := []*domain.Document{{Path: "Programming.md"}, {Path: "Operating Systems.md"}}
+
+	err = repo.Add(context.Background(), models)
+	require.NoError(t, err, "adding models")
+
+	ids := make([]int64, 0, len(models))
+	for _, model := range models {
+		assert.NotZero(t, model.ID, "generated ID is written back")
+		assert.NotContains(t, ids, model.ID, "generated IDs are unique")
+
+		ids = append(ids, model.ID)
+	}
+
+	storedModels, err := repo.GetFromIDs(context.Background(), ids)
+	require.NoError(t, err, "getting added models by their generated IDs")
+
+	for i, storedModel := range storedModels {
+		assert.Equal(t, models[i].Path, storedModel.Path, "stored model matches added one")
+	}
+}
+
 func TestSQLDocumentRepositoryReplaceTest(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -1561,6 +1595,98 @@ func TestSQLDocumentRepositoryGetWhereTest(t *testing.T) {
 	}
 }
 
+func TestSQLDocumentRepositoryGetWhereSetFilterTest(t *testing.T) {
+	models := []*domain.Document{
+		{Path: "foo.md", TagIDs: []int64{1}, ID: 1},
+		{Path: "bar.md", ID: 2},
+		{Path: "baz.md", ID: 3},
+	}
+
+	tests := []struct {
+		filter   *domain.DocumentFilter
+		name     string
+		expected []int64
+	}{
+		{
+			name: "Links to document",
+			filter: &domain.DocumentFilter{LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ScalarOperand[int64]{Operand: 2},
+			})},
+			expected: []int64{1},
+		},
+		{
+			name: "Linked from document",
+			filter: &domain.DocumentFilter{BacklinkedDocumentsIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{2, 3},
+		},
+		{
+			name: "Without links",
+			filter: &domain.DocumentFilter{LinkedDocumentIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterEmpty,
+			})},
+			expected: []int64{2, 3},
+		},
+		{
+			name:     "Predefined untagged",
+			filter:   domain.PredefinedDocumentFilters[domain.DocumentFilterUntagged],
+			expected: []int64{2, 3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3DocumentRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3DocumentRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3DocumentRepository)
+
+			err = tagRepo.Add(context.Background(), []*domain.Tag{{Tag: "foo", ID: 1}})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			linkingDocument := *models[0]
+			linkingDocument.LinkedDocumentIDs = []int64{2, 3}
+
+			err = repo.Replace(context.Background(), []*domain.Document{&linkingDocument})
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetWhere(context.Background(), test.filter)
+			assert.NoErrorf(t, err, test.name)
+
+			ids := make([]int64, 0, len(records))
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+
+			assert.ElementsMatchf(t, test.expected, ids, test.name)
+		})
+	}
+}
+
 func TestSQLDocumentRepositoryGetFirstWhereTest(t *testing.T) {
 	tests := []struct {
 		err               error
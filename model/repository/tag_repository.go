@@ -25,6 +25,7 @@ package repository
 import (
 	"context"
 
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 )
 
@@ -40,11 +41,18 @@ type TagRepository interface {
 	DeleteWhere(ctx context.Context, domainFilter *domain.TagFilter) (numAffectedRecords int64, err error)
 	CountWhere(ctx context.Context, domainFilter *domain.TagFilter) (numRecords int64, err error)
 	CountAll(ctx context.Context) (numRecords int64, err error)
+	CountGroupedWhere(ctx context.Context, domainFilter *domain.TagFilter, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error)
+	CountGroupedAll(ctx context.Context, domainGrouper *domain.TagGrouper) (groups []*model.Group, err error)
 	DoesExist(ctx context.Context, domainModel *domain.Tag) (doesExist bool, err error)
 	DoesExistWhere(ctx context.Context, domainFilter *domain.TagFilter) (doesExist bool, err error)
 	GetWhere(ctx context.Context, domainFilter *domain.TagFilter) (records []*domain.Tag, err error)
 	GetFirstWhere(ctx context.Context, domainFilter *domain.TagFilter) (record *domain.Tag, err error)
 	GetAll(ctx context.Context) (records []*domain.Tag, err error)
+	GetWhereSorted(ctx context.Context, domainFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error)
+	GetAllSorted(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter) (records []*domain.Tag, err error)
+	GetWhereSelected(ctx context.Context, domainFilter *domain.TagFilter, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error)
+	GetAllSelected(ctx context.Context, domainSorter domain.TagSorter, limiter *model.Limiter, domainSelector domain.TagMemberSelector) (records []*domain.Tag, err error)
+	GetFirstWhereSelected(ctx context.Context, domainFilter *domain.TagFilter, domainSelector domain.TagMemberSelector) (record *domain.Tag, err error)
 	GetFromIDs(ctx context.Context, ids []int64) (records []*domain.Tag, err error)
 
 	TagRepositoryToDomainModel(ctx context.Context, repositoryModel any) (domainModel *domain.Tag, err error)
@@ -52,4 +60,7 @@ type TagRepository interface {
 
 	TagDomainToRepositoryFilter(ctx context.Context, domainFilter *domain.TagFilter) (repositoryFilter any, err error)
 	TagDomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.TagUpdater) (repositoryUpdater any, err error)
+	TagDomainToRepositorySorter(ctx context.Context, domainSorter domain.TagSorter) (repositorySorter any, err error)
+	TagDomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.TagMemberSelector) (repositorySelector any, err error)
+	TagDomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.TagGrouper) (repositoryGrouper any, err error)
 }
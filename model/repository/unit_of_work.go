@@ -0,0 +1,334 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// UnitOfWork groups operations across repositories, which are committed or rolled back together.
+// Repositories take part in the unit of work stored in the context passed to them (see ContextWithUnitOfWork),
+// the SQL repositories share a transaction per database and file based ones stage their changes until the commit.
+type UnitOfWork struct {
+	mutex           sync.Mutex
+	resourceKeys    []any
+	resources       map[any]UnitOfWorkResource
+	afterCompletion []func()
+	isCompleted     bool
+}
+
+// UnitOfWorkResource is the state a repository keeps during a unit of work, e.g. a transaction.
+type UnitOfWorkResource interface {
+	Commit() error
+	Rollback() error
+}
+
+// RevertibleResource can undo its commit.
+// Revertible resources are committed before the other ones, so they can be reverted if a later commit fails.
+type RevertibleResource interface {
+	UnitOfWorkResource
+	Revert() error
+}
+
+func NewUnitOfWork() *UnitOfWork {
+	return &UnitOfWork{resources: make(map[any]UnitOfWorkResource)}
+}
+
+type unitOfWorkContextKey struct{}
+
+// ContextWithUnitOfWork makes repositories called with the returned context take part in unitOfWork.
+func ContextWithUnitOfWork(ctx context.Context, unitOfWork *UnitOfWork) context.Context {
+	return context.WithValue(ctx, unitOfWorkContextKey{}, unitOfWork)
+}
+
+// UnitOfWorkFromContext returns the unit of work operations in ctx take part in.
+func UnitOfWorkFromContext(ctx context.Context) (*UnitOfWork, bool) {
+	unitOfWork, ok := ctx.Value(unitOfWorkContextKey{}).(*UnitOfWork)
+
+	return unitOfWork, ok
+}
+
+// Join returns the resource stored under key, it is created by begin if the unit of work does not hold one yet.
+func (unitOfWork *UnitOfWork) Join(key any, begin func() (UnitOfWorkResource, error)) (UnitOfWorkResource, error) {
+	unitOfWork.mutex.Lock()
+	defer unitOfWork.mutex.Unlock()
+
+	if unitOfWork.isCompleted {
+		return nil, UnitOfWorkCompletedError{}
+	}
+
+	if resource, ok := unitOfWork.resources[key]; ok {
+		return resource, nil
+	}
+
+	resource, err := begin()
+	if err != nil {
+		return nil, err
+	}
+
+	unitOfWork.resources[key] = resource
+	unitOfWork.resourceKeys = append(unitOfWork.resourceKeys, key)
+
+	return resource, nil
+}
+
+// Get returns the resource stored under key without creating it.
+func (unitOfWork *UnitOfWork) Get(key any) (resource UnitOfWorkResource, ok bool) {
+	unitOfWork.mutex.Lock()
+	defer unitOfWork.mutex.Unlock()
+
+	resource, ok = unitOfWork.resources[key]
+
+	return
+}
+
+// AfterCompletion registers f to be called after the unit of work was committed or rolled back.
+func (unitOfWork *UnitOfWork) AfterCompletion(f func()) {
+	unitOfWork.mutex.Lock()
+	defer unitOfWork.mutex.Unlock()
+
+	unitOfWork.afterCompletion = append(unitOfWork.afterCompletion, f)
+}
+
+// Commit commits all resources, the revertible ones first.
+// If a resource fails to commit, the remaining ones are rolled back and the committed ones reverted if possible.
+func (unitOfWork *UnitOfWork) Commit() (err error) {
+	resources, err := unitOfWork.complete()
+	if err != nil {
+		return
+	}
+
+	defer unitOfWork.runAfterCompletion()
+
+	ordered := make([]UnitOfWorkResource, 0, len(resources))
+
+	for _, resource := range resources {
+		if _, ok := resource.(RevertibleResource); ok {
+			ordered = append(ordered, resource)
+		}
+	}
+
+	for _, resource := range resources {
+		if _, ok := resource.(RevertibleResource); !ok {
+			ordered = append(ordered, resource)
+		}
+	}
+
+	for i, resource := range ordered {
+		err = resource.Commit()
+		if err == nil {
+			continue
+		}
+
+		for _, uncommitted := range ordered[i+1:] {
+			rollbackErr := uncommitted.Rollback()
+			if rollbackErr != nil {
+				err = multierror.Append(err, rollbackErr)
+			}
+		}
+
+		for j := i - 1; j >= 0; j-- {
+			if revertible, ok := ordered[j].(RevertibleResource); ok {
+				revertErr := revertible.Revert()
+				if revertErr != nil {
+					err = multierror.Append(err, revertErr)
+				}
+			}
+		}
+
+		return
+	}
+
+	return
+}
+
+// Rollback rolls back all resources.
+// It does nothing if the unit of work is already completed, so it can be deferred right after beginning the unit of work.
+func (unitOfWork *UnitOfWork) Rollback() (err error) {
+	resources, err := unitOfWork.complete()
+	if err != nil {
+		return nil
+	}
+
+	defer unitOfWork.runAfterCompletion()
+
+	for _, resource := range resources {
+		rollbackErr := resource.Rollback()
+		if rollbackErr != nil {
+			err = multierror.Append(err, rollbackErr)
+		}
+	}
+
+	return
+}
+
+func (unitOfWork *UnitOfWork) complete() ([]UnitOfWorkResource, error) {
+	unitOfWork.mutex.Lock()
+	defer unitOfWork.mutex.Unlock()
+
+	if unitOfWork.isCompleted {
+		return nil, UnitOfWorkCompletedError{}
+	}
+
+	unitOfWork.isCompleted = true
+
+	resources := make([]UnitOfWorkResource, 0, len(unitOfWork.resourceKeys))
+	for _, key := range unitOfWork.resourceKeys {
+		resources = append(resources, unitOfWork.resources[key])
+	}
+
+	return resources, nil
+}
+
+func (unitOfWork *UnitOfWork) runAfterCompletion() {
+	unitOfWork.mutex.Lock()
+	afterCompletion := unitOfWork.afterCompletion
+	unitOfWork.mutex.Unlock()
+
+	for _, f := range afterCompletion {
+		f()
+	}
+}
+
+//******************************************************************//
+//                         SQL transactions                         //
+//******************************************************************//
+
+type sqlTxResource struct {
+	tx *sql.Tx
+}
+
+func (resource sqlTxResource) Commit() error {
+	return resource.tx.Commit()
+}
+
+func (resource sqlTxResource) Rollback() error {
+	err := resource.tx.Rollback()
+	if errors.Is(err, sql.ErrTxDone) {
+		return nil
+	}
+
+	return err
+}
+
+// BeginTx returns the transaction of the unit of work in ctx for db or begins a new one.
+// Transactions have to be committed through CommitTx, which leaves committing the ones of a unit of work to it.
+func BeginTx(ctx context.Context, db *sql.DB) (*sql.Tx, error) {
+	unitOfWork, ok := UnitOfWorkFromContext(ctx)
+	if !ok {
+		return db.BeginTx(ctx, nil)
+	}
+
+	resource, err := unitOfWork.Join(db, func() (UnitOfWorkResource, error) {
+		tx, err := db.BeginTx(ctx, nil)
+
+		return sqlTxResource{tx: tx}, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.(sqlTxResource).tx, nil
+}
+
+// CommitTx commits tx if it was not begun for a unit of work.
+func CommitTx(ctx context.Context, tx *sql.Tx) error {
+	if isUnitOfWorkTx(ctx, tx) {
+		return nil
+	}
+
+	return tx.Commit()
+}
+
+// RollbackTx rolls back tx if it was not begun for a unit of work, which is then rolled back as a whole by its user.
+func RollbackTx(ctx context.Context, tx *sql.Tx) error {
+	if isUnitOfWorkTx(ctx, tx) {
+		return nil
+	}
+
+	return tx.Rollback()
+}
+
+func isUnitOfWorkTx(ctx context.Context, tx *sql.Tx) bool {
+	unitOfWork, ok := UnitOfWorkFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	unitOfWork.mutex.Lock()
+	defer unitOfWork.mutex.Unlock()
+
+	for _, resource := range unitOfWork.resources {
+		if txResource, ok := resource.(sqlTxResource); ok && txResource.tx == tx {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Executor returns the transaction of the unit of work in ctx for db if it has begun one, otherwise db.
+func Executor(ctx context.Context, db *sql.DB) boil.ContextExecutor {
+	if unitOfWork, ok := UnitOfWorkFromContext(ctx); ok {
+		if resource, ok := unitOfWork.Get(db); ok {
+			return resource.(sqlTxResource).tx
+		}
+	}
+
+	return db
+}
+
+//******************************************************************//
+//                     UnitOfWorkCompletedError                     //
+//******************************************************************//
+
+type UnitOfWorkCompletedError struct{}
+
+func (err UnitOfWorkCompletedError) Error() string {
+	return "The unit of work is already committed or rolled back"
+}
+
+func (err UnitOfWorkCompletedError) Is(other error) bool {
+	switch other.(type) {
+	case UnitOfWorkCompletedError:
+		return true
+	default:
+		return false
+	}
+}
+
+func (err UnitOfWorkCompletedError) As(target any) bool {
+	switch target.(type) {
+	case UnitOfWorkCompletedError:
+		reflect.Indirect(reflect.ValueOf(target)).Set(reflect.ValueOf(err))
+
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type SortDirection int
+
+const (
+	SortAscending SortDirection = iota + 1
+	SortDescending
+)
+
+func (d SortDirection) String() string {
+	switch d {
+	case SortAscending:
+		return "SortAscending"
+	case SortDescending:
+		return "SortDescending"
+	default:
+		return ""
+	}
+}
+
+func SortDirectionFromString(s string) SortDirection {
+	switch s {
+	case "SortAscending":
+		return SortAscending
+	case "SortDescending":
+		return SortDescending
+	default:
+		return 0
+	}
+}
+
+func (d SortDirection) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString(`"`)
+	buffer.WriteString(d.String())
+	buffer.WriteString(`"`)
+
+	return buffer.Bytes(), nil
+}
+
+func (d *SortDirection) UnmarshalJSON(b []byte) error {
+	var j string
+
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+
+	*d = SortDirectionFromString(j)
+
+	return nil
+}
+
+// SortKey sorts entities by one of their fields.
+// An unset direction sorts in ascending order.
+type SortKey[TField ~string] struct {
+	Field     TField        `json:"field" toml:"field" yaml:"field"`
+	Direction SortDirection `json:"direction,omitempty" toml:"direction,omitempty" yaml:"direction,omitempty"`
+}
+
+// Limiter restricts the entities returned by a query to a window of the (sorted) result.
+// A limit of 0 does not restrict the number of returned entities.
+type Limiter struct {
+	Limit  int64 `json:"limit,omitempty" toml:"limit,omitempty" yaml:"limit,omitempty"`
+	Offset int64 `json:"offset,omitempty" toml:"offset,omitempty" yaml:"offset,omitempty"`
+}
@@ -90,6 +90,11 @@ type {{.StructName}}Filter struct {
     {{.FieldName}} optional.Optional[model.FilterOperation[{{Unslice (UnaliasSQLBoilerSlice .FieldType)}}]]`json:"{{LowercaseBeginning .FieldName}},omitempty" toml:"{{LowercaseBeginning .FieldName}},omitempty" yaml:"{{LowercaseBeginning .FieldName}},omitempty"`
 
     {{end}}
+
+    // And, Or and Not compose whole filters, they are combined with the field filters by AND.
+    And []*{{.StructName}}Filter `json:"and,omitempty" toml:"and,omitempty" yaml:"and,omitempty"`
+    Or  []*{{.StructName}}Filter `json:"or,omitempty" toml:"or,omitempty" yaml:"or,omitempty"`
+    Not *{{.StructName}}Filter   `json:"not,omitempty" toml:"not,omitempty" yaml:"not,omitempty"`
 }
 
 func (filter *{{.StructName}}Filter) IsDefault() bool {
@@ -99,6 +104,10 @@ func (filter *{{.StructName}}Filter) IsDefault() bool {
     }
     {{end}}
 
+    if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+        return false
+    }
+
     return true
 }
 
@@ -118,11 +127,22 @@ func (updater *{{.StructName}}Updater) IsDefault() bool {
     return true
 }
 
+// {{.StructName}}Sorter sorts by its keys in order, later keys break ties of earlier ones.
+type {{.StructName}}Sorter []model.SortKey[{{.StructName}}Field]
+
+// {{.StructName}}MemberSelector selects the fields to load, an empty selector selects all fields.
+type {{.StructName}}MemberSelector []{{.StructName}}Field
+
+// {{.StructName}}Grouper groups bookmarks by one of their fields for aggregate queries.
+type {{.StructName}}Grouper model.GroupKey[{{.StructName}}Field]
+
 const (
     {{.StructName}}FilterUntitled = "{{.StructName}}FilterUntitled"
     {{.StructName}}FilterUntagged = "{{.StructName}}FilterUntagged"
     {{.StructName}}FilterInboxed = "{{.StructName}}FilterInboxed"
     {{.StructName}}FilterDeleted = "{{.StructName}}FilterDeleted"
+    {{.StructName}}FilterNotDeleted = "{{.StructName}}FilterNotDeleted"
+    {{.StructName}}FilterUnread = "{{.StructName}}FilterUnread"
 )
 
 var Predefined{{.StructName}}Filters = map[string]*{{.StructName}}Filter {
@@ -132,14 +152,9 @@ var Predefined{{.StructName}}Filters = map[string]*{{.StructName}}Filter {
         },
         Operator: model.FilterEqual,
     })},
-    // FIX: This operating on int64s instead of the slice is nonsense, right?
     {{.StructName}}FilterUntagged: {TagIDs: optional.Make(model.FilterOperation[int64]{
-        Operand: model.ScalarOperand[int64]{
-            Operand: -1,
-        },
-        Operator: model.FilterEqual,
+        Operator: model.FilterEmpty,
     })},
-    // FIX: This operating on int64s instead of the slice is nonsense, right?
     {{.StructName}}FilterInboxed: {
         Title: optional.Make(model.FilterOperation[optional.Optional[string]]{
             Operand: model.ScalarOperand[optional.Optional[string]]{
@@ -148,15 +163,24 @@ var Predefined{{.StructName}}Filters = map[string]*{{.StructName}}Filter {
             Operator: model.FilterEqual,
         }),
         TagIDs: optional.Make(model.FilterOperation[int64]{
-            Operand: model.ScalarOperand[int64]{
-                Operand: -1,
-            },
-            Operator: model.FilterEqual,
-    })},
+            Operator: model.FilterEmpty,
+        })},
     {{.StructName}}FilterDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
         Operand: model.ScalarOperand[optional.Optional[time.Time]]{
             Operand: optional.Optional[time.Time]{},
         },
+        Operator: model.FilterNEqual,
+    })},
+    {{.StructName}}FilterNotDeleted: {DeletedAt: optional.Make(model.FilterOperation[optional.Optional[time.Time]]{
+        Operand: model.ScalarOperand[optional.Optional[time.Time]]{
+            Operand: optional.Optional[time.Time]{},
+        },
+        Operator: model.FilterEqual,
+    })},
+    {{.StructName}}FilterUnread: {IsRead: optional.Make(model.FilterOperation[bool]{
+        Operand: model.ScalarOperand[bool]{
+            Operand: false,
+        },
         Operator: model.FilterEqual,
     })},
 }
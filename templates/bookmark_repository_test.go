@@ -134,6 +134,40 @@ func TestSQLBookmarkRepositoryAddTest(t *testing.T) {
 	}
 }
 
+func TestSQLBookmarkRepositoryAddGeneratedIDsTest(t *testing.T) {
+	db, err := testCommon.GetDB()
+	require.NoError(t, err, "db open")
+	defer db.Close()
+
+	repo := new(repository.Sqlite3BookmarkRepository)
+
+	repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+	require.NoError(t, err, "repository creation")
+
+	repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+	// The models have no IDs, so the database generates them
+	models := []*domain.Bookmark{{URL: "https://example.com"}, {URL: "https://example.org"}}
+
+	err = repo.Add(context.Background(), models)
+	require.NoError(t, err, "adding models")
+
+	ids := make([]int64, 0, len(models))
+	for _, model := range models {
+		assert.NotZero(t, model.ID, "generated ID is written back")
+		assert.NotContains(t, ids, model.ID, "generated IDs are unique")
+
+		ids = append(ids, model.ID)
+	}
+
+	storedModels, err := repo.GetFromIDs(context.Background(), ids)
+	require.NoError(t, err, "getting added models by their generated IDs")
+
+	for i, storedModel := range storedModels {
+		assert.Equal(t, models[i].URL, storedModel.URL, "stored model matches added one")
+	}
+}
+
 func TestSQLBookmarkRepositoryReplaceTest(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -1999,6 +2033,581 @@ func TestSQLBookmarkRepositoryGetAllTest(t *testing.T) {
 	}
 }
 
+func TestSQLBookmarkRepositoryGetAllSortedTest(t *testing.T) {
+	models := []*domain.Bookmark{
+		{URL: "https://example.com/1", Title: optional.Make("b"), BookmarkType: optional.Make("bar"), IsRead: true, ID: 1},
+		{URL: "https://example.com/2", Title: optional.Make("c"), BookmarkType: optional.Make("foo"), ID: 2},
+		{URL: "https://example.com/3", Title: optional.Make("a"), IsRead: true, ID: 3},
+		{URL: "https://example.com/4", Title: optional.Make("d"), BookmarkType: optional.Make("baz"), ID: 4},
+	}
+
+	tests := []struct {
+		err         error
+		limiter     *model.Limiter
+		name        string
+		sorter      domain.BookmarkSorter
+		expectedIDs []int64
+	}{
+		{
+			name:        "No sorter, sorted by ID",
+			expectedIDs: []int64{1, 2, 3, 4},
+		},
+		{
+			name:        "Sort by title descending",
+			sorter:      domain.BookmarkSorter{{Field: "Title", Direction: model.SortDescending}},
+			expectedIDs: []int64{4, 2, 1, 3},
+		},
+		{
+			name:        "Sort by is read, ties broken by ID",
+			sorter:      domain.BookmarkSorter{{Field: "IsRead"}},
+			expectedIDs: []int64{2, 4, 1, 3},
+		},
+		{
+			name:        "Sort by is read and ID descending",
+			sorter:      domain.BookmarkSorter{{Field: "IsRead"}, {Field: "ID", Direction: model.SortDescending}},
+			expectedIDs: []int64{4, 2, 3, 1},
+		},
+		{
+			name:        "Sort by joined type",
+			sorter:      domain.BookmarkSorter{{Field: "BookmarkType", Direction: model.SortDescending}},
+			expectedIDs: []int64{2, 4, 1, 3},
+		},
+		{
+			name:        "Limit and offset",
+			sorter:      domain.BookmarkSorter{{Field: "Title"}},
+			limiter:     &model.Limiter{Limit: 2, Offset: 1},
+			expectedIDs: []int64{1, 2},
+		},
+		{
+			name:        "Offset without limit",
+			limiter:     &model.Limiter{Offset: 3},
+			expectedIDs: []int64{4},
+		},
+		{
+			name:    "Offset past end",
+			limiter: &model.Limiter{Offset: 4},
+			err:     helper.IneffectiveOperationError{},
+		},
+		{
+			name:    "Negative limit",
+			limiter: &model.Limiter{Limit: -1},
+			err:     repositoryCommon.InvalidLimiterError{},
+		},
+		{
+			name:   "Unsortable field",
+			sorter: domain.BookmarkSorter{{Field: "TagIDs"}},
+			err:    repositoryCommon.UnsortableFieldError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3BookmarkRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+			err = repo.AddType(context.Background(), []string{"foo", "bar", "baz"})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetAllSorted(context.Background(), test.sorter, test.limiter)
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+			} else {
+				assert.ErrorIsf(t, err, test.err, test.name)
+			}
+
+			ids := make([]int64, 0, len(records))
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+
+			if test.expectedIDs == nil {
+				assert.Emptyf(t, ids, test.name)
+			} else {
+				assert.Equalf(t, test.expectedIDs, ids, test.name)
+			}
+		})
+	}
+}
+func TestSQLBookmarkRepositoryGetAllSelectedTest(t *testing.T) {
+	models := []*domain.Bookmark{
+		{URL: "https://example.com/1", Title: optional.Make("a"), BookmarkType: optional.Make("foo"), TagIDs: []int64{1}, IsRead: true, ID: 1},
+		{URL: "https://example.com/2", Title: optional.Make("b"), ID: 2},
+	}
+
+	tests := []struct {
+		err      error
+		name     string
+		selector domain.BookmarkMemberSelector
+		expected []*domain.Bookmark
+	}{
+		{
+			name:     "Select URL and title",
+			selector: domain.BookmarkMemberSelector{"URL", "Title"},
+			expected: []*domain.Bookmark{
+				{URL: "https://example.com/1", Title: optional.Make("a"), ID: 1},
+				{URL: "https://example.com/2", Title: optional.Make("b"), ID: 2},
+			},
+		},
+		{
+			name:     "Select joined type and tags",
+			selector: domain.BookmarkMemberSelector{"BookmarkType", "TagIDs"},
+			expected: []*domain.Bookmark{
+				{BookmarkType: optional.Make("foo"), TagIDs: []int64{1}, ID: 1},
+				{ID: 2},
+			},
+		},
+		{
+			name:     "Select is read twice",
+			selector: domain.BookmarkMemberSelector{"IsRead", "IsRead"},
+			expected: []*domain.Bookmark{
+				{IsRead: true, ID: 1},
+				{ID: 2},
+			},
+		},
+		{
+			name:     "Unselectable field",
+			selector: domain.BookmarkMemberSelector{"Foo"},
+			err:      repositoryCommon.UnselectableFieldError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3BookmarkRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+			err = tagRepo.Add(context.Background(), []*domain.Tag{{Tag: "foo", ID: 1}})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.AddType(context.Background(), []string{"foo"})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetAllSelected(context.Background(), nil, nil, test.selector)
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+				assert.Equalf(t, test.expected, records, test.name)
+			} else {
+				assert.ErrorIsf(t, err, test.err, test.name)
+			}
+		})
+	}
+}
+func TestSQLBookmarkRepositoryCountGroupedTest(t *testing.T) {
+	models := []*domain.Bookmark{
+		{URL: "https://example.com/1", BookmarkType: optional.Make("foo"), TagIDs: []int64{1, 2}, CreatedAt: time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC), ID: 1},
+		{URL: "https://example.com/2", BookmarkType: optional.Make("foo"), TagIDs: []int64{1}, CreatedAt: time.Date(2022, 6, 5, 12, 0, 0, 0, time.UTC), IsRead: true, ID: 2},
+		{URL: "https://example.com/3", CreatedAt: time.Date(2022, 6, 6, 12, 0, 0, 0, time.UTC), ID: 3},
+	}
+
+	tests := []struct {
+		err      error
+		filter   *domain.BookmarkFilter
+		name     string
+		grouper  *domain.BookmarkGrouper
+		expected []*model.Group
+	}{
+		{
+			name:     "Group by joined type",
+			grouper:  &domain.BookmarkGrouper{Field: "BookmarkType"},
+			expected: []*model.Group{{Key: "", Count: 1}, {Key: "foo", Count: 2}},
+		},
+		{
+			name:     "Group by tags",
+			grouper:  &domain.BookmarkGrouper{Field: "TagIDs"},
+			expected: []*model.Group{{Key: "", Count: 1}, {Key: "1", Count: 2}, {Key: "2", Count: 1}},
+		},
+		{
+			name:     "Group unread by tags",
+			filter:   domain.PredefinedBookmarkFilters[domain.BookmarkFilterUnread],
+			grouper:  &domain.BookmarkGrouper{Field: "TagIDs"},
+			expected: []*model.Group{{Key: "", Count: 1}, {Key: "1", Count: 1}, {Key: "2", Count: 1}},
+		},
+		{
+			name:     "Group by creation week",
+			grouper:  &domain.BookmarkGrouper{Field: "CreatedAt", Bucket: model.BucketWeek},
+			expected: []*model.Group{{Key: "2022-05-30", Count: 2}, {Key: "2022-06-06", Count: 1}},
+		},
+		{
+			name:     "Group by creation day",
+			grouper:  &domain.BookmarkGrouper{Field: "CreatedAt"},
+			expected: []*model.Group{{Key: "2022-06-01", Count: 1}, {Key: "2022-06-05", Count: 1}, {Key: "2022-06-06", Count: 1}},
+		},
+		{
+			name:    "Bucket of non-timestamp",
+			grouper: &domain.BookmarkGrouper{Field: "IsRead", Bucket: model.BucketWeek},
+			err:     repositoryCommon.UngroupableFieldError{},
+		},
+		{
+			name:    "Ungroupable field",
+			grouper: &domain.BookmarkGrouper{Field: "URL"},
+			err:     repositoryCommon.UngroupableFieldError{},
+		},
+		{
+			name: "Nil grouper",
+			err:  helper.NilInputError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3BookmarkRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+			err = tagRepo.Add(context.Background(), []*domain.Tag{{Tag: "foo", ID: 1}, {Tag: "bar", ID: 2}})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.AddType(context.Background(), []string{"foo"})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			var groups []*model.Group
+			if test.filter == nil {
+				groups, err = repo.CountGroupedAll(context.Background(), test.grouper)
+			} else {
+				groups, err = repo.CountGroupedWhere(context.Background(), test.filter, test.grouper)
+			}
+
+			if test.err == nil {
+				assert.NoErrorf(t, err, test.name)
+				assert.Equalf(t, test.expected, groups, test.name)
+			} else {
+				assert.ErrorIsf(t, err, test.err, test.name)
+			}
+		})
+	}
+}
+
+func TestSQLBookmarkRepositoryGetWhereSetFilterTest(t *testing.T) {
+	models := []*domain.Bookmark{
+		{URL: "https://example.com/1", Title: optional.Make("My first bookmark"), TagIDs: []int64{1, 2}, ID: 1},
+		{URL: "https://example.com/2", Title: optional.Make("My second bookmark"), TagIDs: []int64{1}, ID: 2},
+		{URL: "https://example.com/3", ID: 3},
+		{URL: "https://example.com/4", TagIDs: []int64{2}, ID: 4},
+	}
+
+	tests := []struct {
+		filter   *domain.BookmarkFilter
+		name     string
+		expected []int64
+	}{
+		{
+			name: "Contains all",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ListOperand[int64]{Operands: []int64{1, 2}},
+			})},
+			expected: []int64{1},
+		},
+		{
+			name: "Contains single",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{1, 2},
+		},
+		{
+			name: "Contains any, including non-existing tag",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContainsAny,
+				Operand:  model.ListOperand[int64]{Operands: []int64{2, 3}},
+			})},
+			expected: []int64{1, 4},
+		},
+		{
+			name: "Contains any, combined with other field",
+			filter: &domain.BookmarkFilter{
+				TagIDs: optional.Make(model.FilterOperation[int64]{
+					Operator: model.FilterContainsAny,
+					Operand:  model.ListOperand[int64]{Operands: []int64{1, 2}},
+				}),
+				URL: optional.Make(model.FilterOperation[string]{
+					Operator: model.FilterEqual,
+					Operand:  model.ScalarOperand[string]{Operand: "https://example.com/2"},
+				}),
+			},
+			expected: []int64{2},
+		},
+		{
+			name: "Contains none",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterNotContains,
+				Operand:  model.ListOperand[int64]{Operands: []int64{2}},
+			})},
+			expected: []int64{2, 3},
+		},
+		{
+			name: "Not empty",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterNotEmpty,
+			})},
+			expected: []int64{1, 2, 4},
+		},
+		{
+			name: "Contains recursive",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContainsRecursive,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{1, 2, 4},
+		},
+		{
+			name: "Contains recursive, leaf tag",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContainsRecursive,
+				Operand:  model.ListOperand[int64]{Operands: []int64{2}},
+			})},
+			expected: []int64{1, 4},
+		},
+		{
+			name: "Contains none recursive",
+			filter: &domain.BookmarkFilter{TagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterNotContainsRecursive,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{3},
+		},
+		{
+			name:     "Predefined untagged",
+			filter:   domain.PredefinedBookmarkFilters[domain.BookmarkFilterUntagged],
+			expected: []int64{3},
+		},
+		{
+			name:     "Predefined inboxed",
+			filter:   domain.PredefinedBookmarkFilters[domain.BookmarkFilterInboxed],
+			expected: []int64{3},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3BookmarkRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+			err = tagRepo.Add(context.Background(), []*domain.Tag{{Tag: "foo", SubtagIDs: []int64{2}, ID: 1}, {Tag: "bar", ParentPathIDs: []int64{1}, ID: 2}})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetWhere(context.Background(), test.filter)
+			assert.NoErrorf(t, err, test.name)
+
+			ids := make([]int64, 0, len(records))
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+
+			assert.ElementsMatchf(t, test.expected, ids, test.name)
+		})
+	}
+}
+
+func TestSQLBookmarkRepositoryGetWhereComposedFilterTest(t *testing.T) {
+	models := []*domain.Bookmark{
+		{URL: "https://example.com/1", Title: optional.Make("a"), BookmarkType: optional.Make("video"), TagIDs: []int64{1}, ID: 1},
+		{URL: "https://example.com/2", ID: 2},
+		{URL: "https://example.com/3", BookmarkType: optional.Make("video"), TagIDs: []int64{1}, ID: 3},
+		{URL: "https://example.com/4", Title: optional.Make("b"), BookmarkType: optional.Make("text"), ID: 4},
+	}
+
+	isVideo := &domain.BookmarkFilter{BookmarkType: optional.Make(model.FilterOperation[optional.Optional[string]]{
+		Operator: model.FilterEqual,
+		Operand:  model.ScalarOperand[optional.Optional[string]]{Operand: optional.Make("video")},
+	})}
+
+	tests := []struct {
+		err      error
+		filter   *domain.BookmarkFilter
+		name     string
+		expected []int64
+	}{
+		{
+			name:     "Or",
+			filter:   &domain.BookmarkFilter{Or: []*domain.BookmarkFilter{domain.PredefinedBookmarkFilters[domain.BookmarkFilterInboxed], isVideo}},
+			expected: []int64{1, 2, 3},
+		},
+		{
+			name:     "Or with empty subfilter",
+			filter:   &domain.BookmarkFilter{Or: []*domain.BookmarkFilter{{}, isVideo}},
+			expected: []int64{1, 2, 3, 4},
+		},
+		{
+			name:     "Not",
+			filter:   &domain.BookmarkFilter{Not: isVideo},
+			expected: []int64{2, 4},
+		},
+		{
+			name:   "Not empty subfilter",
+			filter: &domain.BookmarkFilter{Not: &domain.BookmarkFilter{}},
+			err:    helper.IneffectiveOperationError{},
+		},
+		{
+			name: "And with not",
+			filter: &domain.BookmarkFilter{And: []*domain.BookmarkFilter{
+				domain.PredefinedBookmarkFilters[domain.BookmarkFilterUntagged],
+				{Not: domain.PredefinedBookmarkFilters[domain.BookmarkFilterUntitled]},
+			}},
+			expected: []int64{4},
+		},
+		{
+			name: "Not of or, combined with field filter",
+			filter: &domain.BookmarkFilter{
+				Not: &domain.BookmarkFilter{Or: []*domain.BookmarkFilter{isVideo, domain.PredefinedBookmarkFilters[domain.BookmarkFilterUntitled]}},
+				TagIDs: optional.Make(model.FilterOperation[int64]{
+					Operator: model.FilterEmpty,
+				}),
+			},
+			expected: []int64{4},
+		},
+		{
+			name:   "Nil subfilter",
+			filter: &domain.BookmarkFilter{And: []*domain.BookmarkFilter{nil}},
+			err:    helper.NilInputError{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			tagRepo := new(repository.Sqlite3TagRepository)
+
+			tagRepoAbstract, err := tagRepo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+			assert.NoErrorf(t, err, test.name)
+
+			tagRepo = tagRepoAbstract.(*repository.Sqlite3TagRepository)
+
+			repo := new(repository.Sqlite3BookmarkRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3BookmarkRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger(), TagRepository: tagRepo})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3BookmarkRepository)
+
+			err = tagRepo.Add(context.Background(), []*domain.Tag{{Tag: "foo", ID: 1}})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.AddType(context.Background(), []string{"video", "text"})
+			assert.NoErrorf(t, err, test.name)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetWhere(context.Background(), test.filter)
+			if test.err != nil {
+				assert.ErrorIsf(t, err, test.err, test.name)
+
+				return
+			}
+
+			assert.NoErrorf(t, err, test.name)
+
+			ids := make([]int64, 0, len(records))
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+
+			assert.ElementsMatchf(t, test.expected, ids, test.name)
+		})
+	}
+}
+
 func TestSQLBookmarkRepositoryGetFromIDsTest(t *testing.T) {
 	tests := []struct {
 		err               error
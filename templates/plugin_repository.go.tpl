@@ -0,0 +1,257 @@
+// Copyright © 2021-2022 Jonas Muehlmann
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the "Software"),
+// to deal in the Software without restriction, including without limitation
+// the rights to use, copy, modify, merge, publish, distribute, sublicense,
+// and/or sell copies of the Software, and to permit persons to whom the
+// Software is furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included
+// in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES
+// OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+// IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM,
+// DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+// TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE
+// OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+// THIS CODE IS GENERATED BY GO GENERATE, IT'S TEMPLATE IS /templates/plugin_repository.go.tpl
+
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JonasMuehlmann/bntp.go/model"
+	"github.com/JonasMuehlmann/bntp.go/model/domain"
+	"github.com/JonasMuehlmann/bntp.go/model/repository"
+)
+
+// Plugin{{.EntityName}}Repository is a {{.EntityName}}Repository provided by a plugin.
+type Plugin{{.EntityName}}Repository struct {
+	client *RPCClient
+    {{- if or (eq .EntityName "Bookmark") (eq .EntityName "Document")}}
+	tagRepository repository.TagRepository
+    {{- end}}
+}
+
+type Plugin{{.EntityName}}RepositoryConstructorArgs struct {
+	Client *RPCClient
+    {{- if or (eq .EntityName "Bookmark") (eq .EntityName "Document")}}
+	TagRepository repository.TagRepository
+    {{- end}}
+}
+
+func (repo *Plugin{{.EntityName}}Repository) New(args any) (newRepo repository.{{.EntityName}}Repository, err error) {
+	constructorArgs, ok := args.(Plugin{{.EntityName}}RepositoryConstructorArgs)
+	if !ok {
+		err = fmt.Errorf("expected type %T but got %T", Plugin{{.EntityName}}RepositoryConstructorArgs{}, args)
+
+		return
+	}
+
+	repo.client = constructorArgs.Client
+    {{- if or (eq .EntityName "Bookmark") (eq .EntityName "Document")}}
+	repo.tagRepository = constructorArgs.TagRepository
+    {{- end}}
+
+	newRepo = repo
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) Add(ctx context.Context, domainModels []*domain.{{.EntityName}}) error {
+	return repo.client.Call(ctx, "Add", nil, domainModels)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) Replace(ctx context.Context, domainModels []*domain.{{.EntityName}}) error {
+	return repo.client.Call(ctx, "Replace", nil, domainModels)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) Upsert(ctx context.Context, domainModels []*domain.{{.EntityName}}) error {
+	return repo.client.Call(ctx, "Upsert", nil, domainModels)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) Update(ctx context.Context, domainModels []*domain.{{.EntityName}}, domainUpdaters *domain.{{.EntityName}}Updater) error {
+	return repo.client.Call(ctx, "Update", nil, domainModels, domainUpdaters)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) UpdateWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainUpdaters *domain.{{.EntityName}}Updater) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "UpdateWhere", []any{&numAffectedRecords}, domainFilter, domainUpdaters)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) Delete(ctx context.Context, domainModels []*domain.{{.EntityName}}) error {
+	return repo.client.Call(ctx, "Delete", nil, domainModels)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) DeleteWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (numAffectedRecords int64, err error) {
+	err = repo.client.Call(ctx, "DeleteWhere", []any{&numAffectedRecords}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) CountWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountWhere", []any{&numRecords}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) CountAll(ctx context.Context) (numRecords int64, err error) {
+	err = repo.client.Call(ctx, "CountAll", []any{&numRecords})
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) CountGroupedWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainGrouper *domain.{{.EntityName}}Grouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedWhere", []any{&groups}, domainFilter, domainGrouper)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) CountGroupedAll(ctx context.Context, domainGrouper *domain.{{.EntityName}}Grouper) (groups []*model.Group, err error) {
+	err = repo.client.Call(ctx, "CountGroupedAll", []any{&groups}, domainGrouper)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) DoesExist(ctx context.Context, domainModel *domain.{{.EntityName}}) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExist", []any{&doesExist}, domainModel)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) DoesExistWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (doesExist bool, err error) {
+	err = repo.client.Call(ctx, "DoesExistWhere", []any{&doesExist}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetWhere", []any{&records}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetFirstWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (record *domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhere", []any{&record}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetAll(ctx context.Context) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetAll", []any{&records})
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetWhereSorted(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetWhereSorted", []any{&records}, domainFilter, domainSorter, limiter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetAllSorted(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetAllSorted", []any{&records}, domainSorter, limiter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetWhereSelected(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{.EntityName}}MemberSelector) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetWhereSelected", []any{&records}, domainFilter, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetAllSelected(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{.EntityName}}MemberSelector) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetAllSelected", []any{&records}, domainSorter, limiter, domainSelector)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetFirstWhereSelected(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSelector domain.{{.EntityName}}MemberSelector) (record *domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetFirstWhereSelected", []any{&record}, domainFilter, domainSelector)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetFromIDs(ctx context.Context, ids []int64) (records []*domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "GetFromIDs", []any{&records}, ids)
+
+	return
+}
+{{if or (eq .EntityName "Bookmark") (eq .EntityName "Document")}}
+func (repo *Plugin{{.EntityName}}Repository) AddType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "AddType", nil, types)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) DeleteType(ctx context.Context, types []string) error {
+	return repo.client.Call(ctx, "DeleteType", nil, types)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) UpdateType(ctx context.Context, oldType string, newType string) error {
+	return repo.client.Call(ctx, "UpdateType", nil, oldType, newType)
+}
+
+func (repo *Plugin{{.EntityName}}Repository) GetAllTypes(ctx context.Context) (types []string, err error) {
+	err = repo.client.Call(ctx, "GetAllTypes", []any{&types})
+
+	return
+}
+
+// GetTagRepository returns the tag repository of bntp.go, not the one used by the plugin.
+func (repo *Plugin{{.EntityName}}Repository) GetTagRepository() repository.TagRepository {
+	return repo.tagRepository
+}
+{{end}}
+//******************************************************************//
+//                            Converters                            //
+//******************************************************************//
+// The repository specific values are received as decoded JSON.
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}RepositoryToDomainModel(ctx context.Context, repositoryModel any) (domainModel *domain.{{.EntityName}}, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}RepositoryToDomainModel", []any{&domainModel}, repositoryModel)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositoryModel(ctx context.Context, domainModel *domain.{{.EntityName}}) (repositoryModel any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositoryModel", []any{&repositoryModel}, domainModel)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositoryFilter(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (repositoryFilter any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositoryFilter", []any{&repositoryFilter}, domainFilter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.{{.EntityName}}Updater) (repositoryUpdater any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositoryUpdater", []any{&repositoryUpdater}, domainUpdater)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositorySorter(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter) (repositorySorter any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositorySorter", []any{&repositorySorter}, domainSorter)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.{{.EntityName}}MemberSelector) (repositorySelector any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositoryMemberSelector", []any{&repositorySelector}, domainSelector)
+
+	return
+}
+
+func (repo *Plugin{{.EntityName}}Repository) {{.EntityName}}DomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.{{.EntityName}}Grouper) (repositoryGrouper any, err error) {
+	err = repo.client.Call(ctx, "{{.EntityName}}DomainToRepositoryGrouper", []any{&repositoryGrouper}, domainGrouper)
+
+	return
+}
@@ -24,6 +24,7 @@ package repository
 
 import (
     "context"
+	"github.com/JonasMuehlmann/bntp.go/model"
 	"github.com/JonasMuehlmann/bntp.go/model/domain"
 )
 
@@ -39,11 +40,18 @@ type {{.EntityName}}Repository interface {
 	DeleteWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (numAffectedRecords int64, err error)
 	CountWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (numRecords int64, err error)
 	CountAll(ctx context.Context) (numRecords int64, err error)
+	CountGroupedWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainGrouper *domain.{{.EntityName}}Grouper) (groups []*model.Group, err error)
+	CountGroupedAll(ctx context.Context, domainGrouper *domain.{{.EntityName}}Grouper) (groups []*model.Group, err error)
 	DoesExist(ctx context.Context, domainModel *domain.{{.EntityName}}) (doesExist bool, err error)
 	DoesExistWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (doesExist bool, err error)
 	GetWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (records []*domain.{{.EntityName}}, err error)
 	GetFirstWhere(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (record *domain.{{.EntityName}}, err error)
 	GetAll(ctx context.Context) (records []*domain.{{.EntityName}}, err error)
+	GetWhereSorted(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{.EntityName}}, err error)
+	GetAllSorted(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{.EntityName}}, err error)
+	GetWhereSelected(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{.EntityName}}MemberSelector) (records []*domain.{{.EntityName}}, err error)
+	GetAllSelected(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{.EntityName}}MemberSelector) (records []*domain.{{.EntityName}}, err error)
+	GetFirstWhereSelected(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter, domainSelector domain.{{.EntityName}}MemberSelector) (record *domain.{{.EntityName}}, err error)
 	GetFromIDs(ctx context.Context, ids []int64) (records []*domain.{{.EntityName}}, err error)
     {{if or (eq .EntityName "Bookmark") (eq .EntityName "Document")}}
     AddType(ctx context.Context, types  []string) error
@@ -59,4 +67,7 @@ type {{.EntityName}}Repository interface {
 
     {{.EntityName}}DomainToRepositoryFilter(ctx context.Context, domainFilter *domain.{{.EntityName}}Filter) (repositoryFilter any, err error)
     {{.EntityName}}DomainToRepositoryUpdater(ctx context.Context, domainUpdater *domain.{{.EntityName}}Updater) (repositoryUpdater any, err error)
+    {{.EntityName}}DomainToRepositorySorter(ctx context.Context, domainSorter domain.{{.EntityName}}Sorter) (repositorySorter any, err error)
+    {{.EntityName}}DomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.{{.EntityName}}MemberSelector) (repositorySelector any, err error)
+    {{.EntityName}}DomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.{{.EntityName}}Grouper) (repositoryGrouper any, err error)
 }
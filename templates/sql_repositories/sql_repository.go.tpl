@@ -46,6 +46,7 @@ import (
     log "github.com/sirupsen/logrus"
 	"github.com/stoewer/go-strcase"
     "strings"
+    "math"
     {{ if eq $EntityName "Tag" }}
     "strconv"
     {{ end }}
@@ -105,6 +106,10 @@ type {{$EntityName}}Filter struct {
     {{.FieldName}} optional.Optional[model.FilterOperation[{{Unslice (UnaliasSQLBoilerSlice .FieldType)}}]]
     {{- end -}}
     {{end}}
+
+    And []*{{$EntityName}}Filter
+    Or  []*{{$EntityName}}Filter
+    Not *{{$EntityName}}Filter
 }
 
 type {{$EntityName}}Updater struct {
@@ -233,7 +238,95 @@ func TryUnwrapOptional{{$EntityName}}(o any) any {
     }
 }
 
-func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field, filterOperation model.FilterOperation[T]) queryModSlice{{$EntityName}} {
+// TryUnwrapModelID{{$EntityName}} returns the ID of related models and the input otherwise.
+func TryUnwrapModelID{{$EntityName}}(o any) any {
+    switch t := o.(type) {
+        case *Tag:
+            return t.ID
+        case *Document:
+            return t.ID
+    default:
+        return o
+    }
+}
+
+// {{$EntityName}}SetFilter holds the SQL conditions needed to filter a slice field by its members.
+// Member and RecursiveMember bind a single member, Empty binds nothing.
+// RecursiveMember also matches descendants of the member and is only set for hierarchical members.
+type {{$EntityName}}SetFilter struct {
+    Member          string
+    RecursiveMember string
+    Empty           string
+}
+{{if eq $EntityName "Tag"}}
+{{else if eq .DatabaseName "mssql"}}
+// {{LowercaseBeginning $EntityName}}TagPathContains matches tags whose path contains the bound tag ID.
+var {{LowercaseBeginning $EntityName}}TagPathContains = "';' + " + TagTableColumns.Path + " + ';' LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'"
+{{else}}
+// {{LowercaseBeginning $EntityName}}TagPathContains matches tags whose path contains the bound tag ID.
+var {{LowercaseBeginning $EntityName}}TagPathContains = "';' || " + TagTableColumns.Path + " || ';' LIKE '%;' || CAST(? AS VARCHAR(20)) || ';%'"
+{{end}}
+{{if eq $EntityName "Bookmark"}}
+var {{LowercaseBeginning $EntityName}}SetFilters = map[{{$EntityName}}Field]{{$EntityName}}SetFilter{
+    "Tags": {
+        Member:          {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + " WHERE " + TableNames.BookmarkContexts + ".tag_id = ?)",
+        RecursiveMember: {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + " INNER JOIN " + TableNames.Tags + " ON " + TagTableColumns.ID + " = " + TableNames.BookmarkContexts + ".tag_id WHERE " + {{LowercaseBeginning $EntityName}}TagPathContains + ")",
+        Empty:           {{$EntityName}}TableColumns.ID + " NOT IN (SELECT " + TableNames.BookmarkContexts + ".bookmark_id FROM " + TableNames.BookmarkContexts + ")",
+    },
+}
+{{end}}
+{{if eq $EntityName "Document"}}
+var {{LowercaseBeginning $EntityName}}SetFilters = map[{{$EntityName}}Field]{{$EntityName}}SetFilter{
+    "Tags": {
+        Member:          {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + " WHERE " + TableNames.DocumentContexts + ".tag_id = ?)",
+        RecursiveMember: {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + " INNER JOIN " + TableNames.Tags + " ON " + TagTableColumns.ID + " = " + TableNames.DocumentContexts + ".tag_id WHERE " + {{LowercaseBeginning $EntityName}}TagPathContains + ")",
+        Empty:           {{$EntityName}}TableColumns.ID + " NOT IN (SELECT " + TableNames.DocumentContexts + ".document_id FROM " + TableNames.DocumentContexts + ")",
+    },
+    "DestinationDocuments": {
+        Member: {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.Links + ".source_id FROM " + TableNames.Links + " WHERE " + TableNames.Links + ".destination_id = ?)",
+        Empty:  {{$EntityName}}TableColumns.ID + " NOT IN (SELECT " + TableNames.Links + ".source_id FROM " + TableNames.Links + ")",
+    },
+    "SourceDocuments": {
+        Member: {{$EntityName}}TableColumns.ID + " IN (SELECT " + TableNames.Links + ".destination_id FROM " + TableNames.Links + " WHERE " + TableNames.Links + ".source_id = ?)",
+        Empty:  {{$EntityName}}TableColumns.ID + " NOT IN (SELECT " + TableNames.Links + ".destination_id FROM " + TableNames.Links + ")",
+    },
+}
+{{end}}
+{{if eq $EntityName "Tag"}}
+// Paths and children are stored as ";" separated IDs, paths end with the tag itself
+var {{LowercaseBeginning $EntityName}}SetFilters = map[{{$EntityName}}Field]{{$EntityName}}SetFilter{
+{{- if eq .DatabaseName "mssql"}}
+    "Path": {
+        Member: "';' + " + {{$EntityName}}TableColumns.Path + " LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'",
+        Empty:  {{$EntityName}}TableColumns.ParentTag + " IS NULL",
+    },
+    "Children": {
+        Member: "';' + " + {{$EntityName}}TableColumns.Children + " + ';' LIKE '%;' + CAST(? AS VARCHAR(20)) + ';%'",
+        Empty:  {{$EntityName}}TableColumns.Children + " = ''",
+    },
+{{- else}}
+    "Path": {
+        Member: "';' || " + {{$EntityName}}TableColumns.Path + " LIKE '%;' || ? || ';%'",
+        Empty:  {{$EntityName}}TableColumns.ParentTag + " IS NULL",
+    },
+    "Children": {
+        Member: "';' || " + {{$EntityName}}TableColumns.Children + " || ';' LIKE '%;' || ? || ';%'",
+        Empty:  {{$EntityName}}TableColumns.Children + " = ''",
+    },
+{{- end}}
+}
+{{end}}
+
+func getSetFilter{{$EntityName}}(filterField {{$EntityName}}Field, filterOperator model.FilterOperator) ({{$EntityName}}SetFilter, error) {
+    setFilter, ok := {{LowercaseBeginning $EntityName}}SetFilters[filterField]
+    if !ok {
+        return setFilter, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on slice fields"}
+    }
+
+    return setFilter, nil
+}
+
+func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field, filterOperation model.FilterOperation[T]) (queryModSlice{{$EntityName}}, error) {
     var newQueryMod queryModSlice{{$EntityName}}
 
     filterOperator := filterOperation.Operator
@@ -242,7 +335,7 @@ func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field,
     case model.FilterEqual:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterEqual operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         if IsUnsetOptional{{$EntityName}}(filterOperand.Operand) {
@@ -253,7 +346,7 @@ func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field,
     case model.FilterNEqual:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterNEqual operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         if IsUnsetOptional{{$EntityName}}(filterOperand.Operand) {
@@ -264,35 +357,35 @@ func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field,
     case model.FilterGreaterThan:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterGreaterThan operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" > ?", TryUnwrapOptional{{$EntityName}}(filterOperand.Operand)))
     case model.FilterGreaterThanEqual:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterGreaterThanEqual operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" >= ?", TryUnwrapOptional{{$EntityName}}(filterOperand.Operand)))
     case model.FilterLessThan:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterLessThan operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" < ?", TryUnwrapOptional{{$EntityName}}(filterOperand.Operand)))
     case model.FilterLessThanEqual:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterLessThanEqual operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" <= ?", TryUnwrapOptional{{$EntityName}}(filterOperand.Operand)))
     case model.FilterIn:
         filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
         if !ok {
-            panic("expected a list operand for FilterIn operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
         }
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -301,7 +394,7 @@ func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field,
     case model.FilterNotIn:
         filterOperand, ok := filterOperation.Operand.(model.ListOperand[T])
         if !ok {
-            panic("expected a list operand for FilterNotIn operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a list operand"}
         }
 
 		whereArgs, _ := goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return any(a) })
@@ -310,64 +403,236 @@ func buildQueryModFilter{{$EntityName}}[T any](filterField {{$EntityName}}Field,
     case model.FilterBetween:
         filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterBetween operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
     case model.FilterNotBetween:
         filterOperand, ok := filterOperation.Operand.(model.RangeOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterNotBetween operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a range operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT BETWEEN ? AND ?", filterOperand.Start, filterOperand.End))
     case model.FilterLike:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterLike operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" LIKE ?", filterOperand.Operand))
     case model.FilterNotLike:
         filterOperand, ok := filterOperation.Operand.(model.ScalarOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterLike operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar operand"}
         }
 
         newQueryMod = append(newQueryMod, qm.Where(strcase.SnakeCase(string(filterField))+" NOT LIKE ?", filterOperand.Operand))
     case model.FilterOr:
         filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterOr operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
+        }
+
+        lhsQueryMods, err := buildQueryModFilter{{$EntityName}}(filterField, filterOperand.LHS)
+        if err != nil {
+            return nil, err
         }
-        newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilter{{$EntityName}}(filterField, filterOperand.LHS)))
-        newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(buildQueryModFilter{{$EntityName}}(filterField, filterOperand.RHS))))
+
+        rhsQueryMods, err := buildQueryModFilter{{$EntityName}}(filterField, filterOperand.RHS)
+        if err != nil {
+            return nil, err
+        }
+
+        newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+        newQueryMod = append(newQueryMod, qm.Or2(qm.Expr(rhsQueryMods)))
     case model.FilterAnd:
         filterOperand, ok := filterOperation.Operand.(model.CompoundOperand[T])
         if !ok {
-            panic("expected a scalar operand for FilterAnd operator")
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a compound operand"}
         }
 
-        newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilter{{$EntityName}}(filterField, filterOperand.LHS)))
-        newQueryMod = append(newQueryMod, qm.Expr(buildQueryModFilter{{$EntityName}}(filterField, filterOperand.RHS)))
+
+        lhsQueryMods, err := buildQueryModFilter{{$EntityName}}(filterField, filterOperand.LHS)
+        if err != nil {
+            return nil, err
+        }
+
+        rhsQueryMods, err := buildQueryModFilter{{$EntityName}}(filterField, filterOperand.RHS)
+        if err != nil {
+            return nil, err
+        }
+
+        newQueryMod = append(newQueryMod, qm.Expr(lhsQueryMods))
+        newQueryMod = append(newQueryMod, qm.Expr(rhsQueryMods))
+    case model.FilterContains, model.FilterContainsAny, model.FilterNotContains,
+        model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+        setFilter, err := getSetFilter{{$EntityName}}(filterField, filterOperator)
+        if err != nil {
+            return nil, err
+        }
+
+        memberCondition := setFilter.Member
+
+        switch filterOperator {
+        case model.FilterContainsRecursive, model.FilterContainsAnyRecursive, model.FilterNotContainsRecursive:
+            if setFilter.RecursiveMember == "" {
+                return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "operator is only supported on hierarchical slice fields"}
+            }
+
+            memberCondition = setFilter.RecursiveMember
+        }
+
+        var members []any
+
+        switch filterOperand := filterOperation.Operand.(type) {
+        case model.ScalarOperand[T]:
+            members = append(members, TryUnwrapModelID{{$EntityName}}(filterOperand.Operand))
+        case model.ListOperand[T]:
+            members, _ = goaoi.TransformCopySliceUnsafe(filterOperand.Operands, func(a T) any { return TryUnwrapModelID{{$EntityName}}(a) })
+        default:
+            return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "expected a scalar or list operand"}
+        }
+
+        var anyMemberQueryMod queryModSlice{{$EntityName}}
+
+        for _, member := range members {
+            switch {
+            case filterOperator == model.FilterContains, filterOperator == model.FilterContainsRecursive:
+                newQueryMod = append(newQueryMod, qm.Where(memberCondition, member))
+            case filterOperator == model.FilterNotContains, filterOperator == model.FilterNotContainsRecursive:
+                newQueryMod = append(newQueryMod, qm.Where("NOT ("+memberCondition+")", member))
+            case len(anyMemberQueryMod) == 0:
+                anyMemberQueryMod = append(anyMemberQueryMod, qm.Where(memberCondition, member))
+            default:
+                anyMemberQueryMod = append(anyMemberQueryMod, qm.Or(memberCondition, member))
+            }
+        }
+
+        if len(anyMemberQueryMod) > 0 {
+            newQueryMod = append(newQueryMod, qm.Expr(anyMemberQueryMod...))
+        }
+    case model.FilterEmpty:
+        setFilter, err := getSetFilter{{$EntityName}}(filterField, filterOperator)
+        if err != nil {
+            return nil, err
+        }
+
+        newQueryMod = append(newQueryMod, qm.Where(setFilter.Empty))
+    case model.FilterNotEmpty:
+        setFilter, err := getSetFilter{{$EntityName}}(filterField, filterOperator)
+        if err != nil {
+            return nil, err
+        }
+
+        newQueryMod = append(newQueryMod, qm.Where("NOT ("+setFilter.Empty+")"))
     default:
-        panic("Unhandled FilterOperator")
+        return nil, repoCommon.UnsupportedFilterOperationError{Field: string(filterField), Operator: filterOperator, Reason: "unknown operator"}
     }
 
-    return newQueryMod
+    return newQueryMod, nil
 }
 
-func buildQueryModListFromFilter{{$EntityName}}(filter *{{$EntityName}}Filter) queryModSlice{{$EntityName}} {
+func buildQueryModListFromFilter{{$EntityName}}(filter *{{$EntityName}}Filter) (queryModSlice{{$EntityName}}, error) {
 	queryModList := make(queryModSlice{{$EntityName}}, 0, {{len .StructFields}})
 
     {{range $field := .StructFields -}}
     if filter.{{.FieldName}}.HasValue {
-        newQueryMod := buildQueryModFilter{{$EntityName}}("{{.FieldName}}", filter.{{.FieldName}}.Wrappee)
+        newQueryMod, err := buildQueryModFilter{{$EntityName}}("{{.FieldName}}", filter.{{.FieldName}}.Wrappee)
+        if err != nil {
+            return nil, err
+        }
+
+        queryModList = append(queryModList, newQueryMod...)
+    }
+    {{end}}
+    {{range $relation := .RelationFields -}}
+    {{- if eq .FieldName "BookmarkType"}}
+    {{- else if eq .FieldName "DocumentType"}}
+    {{- else if eq .FieldName "ParentTagTags"}}
+    {{- else if eq .FieldName "ParentTagTag"}}
+    {{- else if eq .FieldName "Bookmarks"}}
+    {{- else if eq .FieldName "Documents"}}
+    {{- else }}
+    if filter.{{.FieldName}}.HasValue {
+        newQueryMod, err := buildQueryModFilter{{$EntityName}}("{{.FieldName}}", filter.{{.FieldName}}.Wrappee)
+        if err != nil {
+            return nil, err
+        }
+
         queryModList = append(queryModList, newQueryMod...)
     }
+    {{- end -}}
     {{end}}
 
-	return queryModList
+    //*********************    Composed Filters    *********************//
+    for _, subfilter := range filter.And {
+        subfilterQueryMods, err := buildQueryModListFromFilter{{$EntityName}}(subfilter)
+        if err != nil {
+            return nil, err
+        }
+
+        if len(subfilterQueryMods) > 0 {
+            queryModList = append(queryModList, qm.Expr(subfilterQueryMods...))
+        }
+    }
+
+    if len(filter.Or) > 0 {
+        anyQueryMods := make(queryModSlice{{$EntityName}}, 0, len(filter.Or))
+
+        for _, subfilter := range filter.Or {
+            subfilterQueryMods, err := buildQueryModListFromFilter{{$EntityName}}(subfilter)
+            if err != nil {
+                return nil, err
+            }
+
+            // An empty subfilter matches everything, so does the whole disjunction
+            if len(subfilterQueryMods) == 0 {
+                anyQueryMods = nil
+
+                break
+            }
+
+            if len(anyQueryMods) == 0 {
+                anyQueryMods = append(anyQueryMods, qm.Expr(subfilterQueryMods...))
+            } else {
+                anyQueryMods = append(anyQueryMods, qm.Or2(qm.Expr(subfilterQueryMods...)))
+            }
+        }
+
+        if len(anyQueryMods) > 0 {
+            queryModList = append(queryModList, qm.Expr(anyQueryMods...))
+        }
+    }
+
+    if filter.Not != nil {
+        notQueryMods, err := buildQueryModListFromFilter{{$EntityName}}(filter.Not)
+        if err != nil {
+            return nil, err
+        }
+
+        queryModList = append(queryModList, buildQueryModNot{{$EntityName}}(notQueryMods))
+    }
+
+	return queryModList, nil
+}
+
+// buildQueryModNot{{$EntityName}} negates queryMods by excluding the IDs they select in a subquery,
+// query mods can not be wrapped in a NOT directly.
+func buildQueryModNot{{$EntityName}}(queryMods queryModSlice{{$EntityName}}) qm.QueryMod {
+    // Placeholders are numbered once the outer query is built
+    subqueryDialect := dialect
+    subqueryDialect.UseIndexPlaceholders = false
+
+    subquery := &queries.Query{}
+    queries.SetDialect(subquery, &subqueryDialect)
+    queries.SetFrom(subquery, TableNames.{{$EntityName}}s)
+    queries.SetSelect(subquery, []string{ {{$EntityName}}TableColumns.ID })
+    qm.Apply(subquery, queryMods...)
+
+    subquerySQL, args := queries.BuildQuery(subquery)
+
+    return qm.Where({{$EntityName}}TableColumns.ID+" NOT IN ("+strings.TrimSuffix(subquerySQL, ";")+")", args...)
 }
 
 
@@ -401,6 +666,11 @@ return
 }
 
 
+// executor returns the transaction of the unit of work in ctx if there is one.
+func (repo *{{$StructName}}) executor(ctx context.Context) boil.ContextExecutor {
+    return repoCommon.Executor(ctx, repo.db)
+}
+
 //******************************************************************//
 //                              Methods                             //
 //******************************************************************//
@@ -425,7 +695,7 @@ return
 
     var tx *sql.Tx
 
-    tx, err = repo.db.BeginTx(ctx, nil)
+    tx, err = repoCommon.BeginTx(ctx, repo.db)
     if err != nil {
         repo.Logger.Error(err)
 
@@ -447,7 +717,7 @@ return err
         return err
     }
 
-    tx.Commit()
+    repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -473,7 +743,7 @@ return
 
     var commitHere bool
     if tx == nil {
-        tx, err = repo.db.BeginTx(ctx, nil)
+        tx, err = repoCommon.BeginTx(ctx, repo.db)
         if err != nil {
 repo.Logger.Error(err)
 
@@ -488,7 +758,7 @@ return
 		return
 	}
 
-	for _, repositoryModel := range repositoryModels {
+	for i, repositoryModel := range repositoryModels {
         repoModel, ok := repositoryModel.(*{{$EntityName}})
         if !ok {
             err = fmt.Errorf("expected type *{{$EntityName}} but got %T", repoModel)
@@ -506,10 +776,13 @@ return
 
 			return
 		}
+
+		// Inserting fills in generated IDs, which later operations on the domain models depend on
+		domainModels[i].ID = repoModel.ID
 	}
 
     if commitHere {
-        tx.Commit()
+        repoCommon.CommitTx(ctx, tx)
     }
 
     return
@@ -537,7 +810,7 @@ return
 
     var commitHere bool
     if tx == nil {
-        tx, err = repo.db.BeginTx(ctx, nil)
+        tx, err = repoCommon.BeginTx(ctx, repo.db)
         if err != nil {
 repo.Logger.Error(err)
 
@@ -599,7 +872,7 @@ return
 	}
 
     if commitHere {
-    tx.Commit()
+    repoCommon.CommitTx(ctx, tx)
     }
 
     return
@@ -633,7 +906,7 @@ return
 
     var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -687,7 +960,7 @@ return err
 
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -719,7 +992,7 @@ return
 
     var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -755,7 +1028,7 @@ return err
         }
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -809,7 +1082,7 @@ return
 
     var tx *sql.Tx
 
-   	tx, err = repo.db.BeginTx(ctx, nil)
+   	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -862,7 +1135,7 @@ return err
         }
     }
 
-    err = tx.Commit()
+    err = repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -928,9 +1201,14 @@ return
 
 
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
 
-	modelsToUpdate, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.db)
+	    return
+	}
+
+	modelsToUpdate, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.executor(ctx))
 	if err != nil {
 		return
 	}
@@ -945,7 +1223,7 @@ return
 
     var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -972,7 +1250,7 @@ return
 
     }
 
-    tx.Commit()
+    repoCommon.CommitTx(ctx, tx)
 
     numAffectedRecords = int64(len(modelsToUpdate))
 
@@ -1006,7 +1284,7 @@ return
 
     var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
@@ -1044,7 +1322,7 @@ return err
         }
 	}
 
-	tx.Commit()
+	repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -1076,18 +1354,23 @@ return
 
 
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
 
     var tx *sql.Tx
 
-	tx, err = repo.db.BeginTx(ctx, nil)
+	tx, err = repoCommon.BeginTx(ctx, repo.db)
 	if err != nil {
 		return
 	}
 
 	numAffectedRecords, err = {{$EntityName}}s(queryFilters...).DeleteAll(ctx, tx)
 
-    tx.Commit()
+    repoCommon.CommitTx(ctx, tx)
 
     return
 }
@@ -1119,13 +1402,113 @@ return
 
 
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
 
-	return {{$EntityName}}s(queryFilters...).Count(ctx, repo.db)
+	    return
+	}
+
+	return {{$EntityName}}s(queryFilters...).Count(ctx, repo.executor(ctx))
 }
 
 func (repo *{{$StructName}}) CountAll(ctx context.Context) (numRecords int64, err error) {
-	return {{$EntityName}}s().Count(ctx, repo.db)
+	return {{$EntityName}}s().Count(ctx, repo.executor(ctx))
+}
+
+func (repo *{{$StructName}}) CountGroupedWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter, domainGrouper *domain.{{$EntityName}}Grouper) (groups []*model.Group, err error) {
+	if  domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+    }
+
+    var repositoryFilter any
+    repositoryFilter, err = repo.{{$EntityName}}DomainToRepositoryFilter(ctx, domainColumnFilter)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    repoFilter, ok := repositoryFilter.(*{{$EntityName}}Filter)
+    if !ok {
+        err = fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
+
+repo.Logger.Error(err)
+
+return
+    }
+
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
+
+    return repo.countGrouped(ctx, queryFilters, domainGrouper)
+}
+
+func (repo *{{$StructName}}) CountGroupedAll(ctx context.Context, domainGrouper *domain.{{$EntityName}}Grouper) (groups []*model.Group, err error) {
+    return repo.countGrouped(ctx, queryModSlice{{$EntityName}}{}, domainGrouper)
+}
+
+func (repo *{{$StructName}}) countGrouped(ctx context.Context, queryMods queryModSlice{{$EntityName}}, domainGrouper *domain.{{$EntityName}}Grouper) (groups []*model.Group, err error) {
+	if  domainGrouper == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+    }
+
+    var repositoryGrouper any
+    repositoryGrouper, err = repo.{{$EntityName}}DomainToRepositoryGrouper(ctx, domainGrouper)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    grouping, ok := repositoryGrouper.(*{{$EntityName}}Grouping)
+    if !ok {
+        err = fmt.Errorf("expected type *{{$EntityName}}Grouping but got %T", repositoryGrouper)
+
+repo.Logger.Error(err)
+
+return
+    }
+
+    if grouping.Join != "" {
+        queryMods = append(queryMods, qm.LeftOuterJoin(grouping.Join))
+    }
+
+    queryMods = append(queryMods,
+        qm.Select(grouping.Key+" AS group_key", "COUNT(DISTINCT "+{{$EntityName}}TableColumns.ID+") AS group_count"),
+        qm.GroupBy(grouping.GroupBy),
+        qm.OrderBy("group_key"),
+    )
+
+    var rows []struct {
+        Key   null.String `boil:"group_key"`
+        Count int64       `boil:"group_count"`
+    }
+
+    err = {{$EntityName}}s(queryMods...).Bind(ctx, repo.executor(ctx), &rows)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    groups = make([]*model.Group, 0, len(rows))
+
+    for _, row := range rows {
+        groups = append(groups, &model.Group{Key: row.Key.String, Count: row.Count})
+    }
+
+    return
 }
 
 func (repo *{{$StructName}}) DoesExist(ctx context.Context, domainModel *domain.{{$EntityName}}) (doesExist bool, err error) {
@@ -1154,7 +1537,7 @@ return
     }
 
 
-	return {{$EntityName}}Exists(ctx, repo.db, repoModel.ID)
+	return {{$EntityName}}Exists(ctx, repo.executor(ctx), repoModel.ID)
 }
 
 func (repo *{{$StructName}}) DoesExistWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter) (doesExist bool, err error) {
@@ -1184,59 +1567,224 @@ return
 
 
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
-
-	return {{$EntityName}}s(queryFilters...).Exists(ctx, repo.db)
-}
-
-func (repo *{{$StructName}}) GetWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter) (records []*domain.{{$EntityName}}, err error) {
-	if  domainColumnFilter == nil {
-		err = helper.NilInputError{}
-		repo.Logger.Error(err)
-
-		return
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
+
+	return {{$EntityName}}s(queryFilters...).Exists(ctx, repo.executor(ctx))
+}
+
+func (repo *{{$StructName}}) GetWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter) (records []*domain.{{$EntityName}}, err error) {
+	if  domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+    }
+
+    var repositoryFilter any
+    repositoryFilter, err = repo.{{$EntityName}}DomainToRepositoryFilter(ctx, domainColumnFilter)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    repoFilter, ok := repositoryFilter.(*{{$EntityName}}Filter)
+    if !ok {
+        err = fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
+
+repo.Logger.Error(err)
+
+return
+    }
+
+
+
+
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
+
+    var repositoryModels {{$EntityName}}Slice
+    repositoryModels, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.executor(ctx))
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    if len(repositoryModels) == 0 {
+    err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+
+repo.Logger.Error(err)
+
+return
+    }
+
+
+    tx, err := repoCommon.BeginTx(ctx, repo.db)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    for _, repoModel := range repositoryModels {
+        err = repo.LoadEntityRelations(ctx, tx, repoModel)
+        if err != nil {
+repo.Logger.Error(err)
+
+return
+        }
+    }
+
+    err = repoCommon.CommitTx(ctx, tx)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    records = make([]*domain.{{$EntityName}}, 0, len(repositoryModels))
+
+    var domainModel *domain.{{$EntityName}}
+    for _, repoModel := range repositoryModels {
+        domainModel, err = repo.{{$EntityName}}RepositoryToDomainModel(ctx, repoModel)
+        if err != nil {
+repo.Logger.Error(err)
+
+return
+        }
+
+        records = append(records, domainModel)
+    }
+
+    return
+}
+
+func (repo *{{$StructName}}) GetFirstWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter) (record *domain.{{$EntityName}}, err error) {
+    return repo.GetFirstWhereSelected(ctx, domainColumnFilter, nil)
+}
+
+func (repo *{{$StructName}}) GetFirstWhereSelected(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter, domainSelector domain.{{$EntityName}}MemberSelector) (record *domain.{{$EntityName}}, err error) {
+	if  domainColumnFilter == nil {
+		err = helper.NilInputError{}
+		repo.Logger.Error(err)
+
+		return
+    }
+
+    var repositoryFilter any
+    repositoryFilter, err = repo.{{$EntityName}}DomainToRepositoryFilter(ctx, domainColumnFilter)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    repoFilter, ok := repositoryFilter.(*{{$EntityName}}Filter)
+    if !ok {
+        err =  fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
+
+repo.Logger.Error(err)
+
+return
+    }
+
+
+
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
+
+    var repositorySelector any
+    repositorySelector, err = repo.{{$EntityName}}DomainToRepositoryMemberSelector(ctx, domainSelector)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
+    }
+
+    selection, ok := repositorySelector.(*{{$EntityName}}Selection)
+    if !ok {
+        err = fmt.Errorf("expected type *{{$EntityName}}Selection but got %T", repositorySelector)
+
+repo.Logger.Error(err)
+
+return
+    }
+
+    if selection != nil {
+        queryFilters = append(queryFilters, qm.Select(selection.Columns...))
+    }
+
+    var repositoryModel *{{$EntityName}}
+    repositoryModel, err = {{$EntityName}}s(queryFilters...).One(ctx, repo.executor(ctx))
+    if err != nil {
+        if  errors.Is(err, sql.ErrNoRows){
+            err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+        }
+
+repo.Logger.Error(err)
+
+return
+    }
+
+
+    tx, err := repoCommon.BeginTx(ctx, repo.db)
+    if err != nil {
+repo.Logger.Error(err)
+
+return
     }
 
-    var repositoryFilter any
-    repositoryFilter, err = repo.{{$EntityName}}DomainToRepositoryFilter(ctx, domainColumnFilter)
+    err = repo.loadSelectedEntityRelations(ctx, tx, repositoryModel, selection)
     if err != nil {
 repo.Logger.Error(err)
+repoCommon.RollbackTx(ctx, tx)
 
 return
     }
 
-    repoFilter, ok := repositoryFilter.(*{{$EntityName}}Filter)
-    if !ok {
-        err = fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
-
+    err = repoCommon.CommitTx(ctx, tx)
+    if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
+    record , err =repo.{{$EntityName}}RepositoryToDomainModel(ctx, repositoryModel)
 
+    return
+}
 
-
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
-
+func (repo *{{$StructName}}) GetAll(ctx context.Context) (records []*domain.{{$EntityName}}, err error) {
     var repositoryModels {{$EntityName}}Slice
-    repositoryModels, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.db)
+    repositoryModels, err = {{$EntityName}}s().All(ctx, repo.executor(ctx))
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
-
     if len(repositoryModels) == 0 {
-    err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+        err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
 
 repo.Logger.Error(err)
 
 return
     }
 
-
-    tx, err := repo.db.BeginTx(ctx, nil)
+    tx, err := repoCommon.BeginTx(ctx, repo.db)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1252,7 +1800,7 @@ return
         }
     }
 
-    err = tx.Commit()
+    err = repoCommon.CommitTx(ctx, tx)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1276,7 +1824,15 @@ return
     return
 }
 
-func (repo *{{$StructName}}) GetFirstWhere(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter) (record *domain.{{$EntityName}}, err error) {
+func (repo *{{$StructName}}) GetWhereSorted(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter, domainSorter domain.{{$EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{$EntityName}}, err error) {
+    return repo.GetWhereSelected(ctx, domainColumnFilter, domainSorter, limiter, nil)
+}
+
+func (repo *{{$StructName}}) GetAllSorted(ctx context.Context, domainSorter domain.{{$EntityName}}Sorter, limiter *model.Limiter) (records []*domain.{{$EntityName}}, err error) {
+    return repo.GetAllSelected(ctx, domainSorter, limiter, nil)
+}
+
+func (repo *{{$StructName}}) GetWhereSelected(ctx context.Context, domainColumnFilter *domain.{{$EntityName}}Filter, domainSorter domain.{{$EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{$EntityName}}MemberSelector) (records []*domain.{{$EntityName}}, err error) {
 	if  domainColumnFilter == nil {
 		err = helper.NilInputError{}
 		repo.Logger.Error(err)
@@ -1294,73 +1850,106 @@ return
 
     repoFilter, ok := repositoryFilter.(*{{$EntityName}}Filter)
     if !ok {
-        err =  fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
+        err = fmt.Errorf("expected type *{{$EntityName}}Filter but got %T", repoFilter)
 
 repo.Logger.Error(err)
 
 return
     }
 
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
 
+    return repo.getSelected(ctx, queryFilters, domainSorter, limiter, domainSelector)
+}
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+func (repo *{{$StructName}}) GetAllSelected(ctx context.Context, domainSorter domain.{{$EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{$EntityName}}MemberSelector) (records []*domain.{{$EntityName}}, err error) {
+    return repo.getSelected(ctx, queryModSlice{{$EntityName}}{}, domainSorter, limiter, domainSelector)
+}
 
-    var repositoryModel *{{$EntityName}}
-    repositoryModel, err = {{$EntityName}}s(queryFilters...).One(ctx, repo.db)
+func (repo *{{$StructName}}) getSelected(ctx context.Context, queryMods queryModSlice{{$EntityName}}, domainSorter domain.{{$EntityName}}Sorter, limiter *model.Limiter, domainSelector domain.{{$EntityName}}MemberSelector) (records []*domain.{{$EntityName}}, err error) {
+    var repositorySelector any
+    repositorySelector, err = repo.{{$EntityName}}DomainToRepositoryMemberSelector(ctx, domainSelector)
     if err != nil {
-        if  errors.Is(err, sql.ErrNoRows){
-            err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
-        }
-
 repo.Logger.Error(err)
 
 return
     }
 
+    selection, ok := repositorySelector.(*{{$EntityName}}Selection)
+    if !ok {
+        err = fmt.Errorf("expected type *{{$EntityName}}Selection but got %T", repositorySelector)
 
-    tx, err := repo.db.BeginTx(ctx, nil)
-    if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repo.LoadEntityRelations(ctx, tx, repositoryModel)
+    if selection != nil {
+        queryMods = append(queryMods, qm.Select(selection.Columns...))
+    }
+
+    var repositorySorter any
+    repositorySorter, err = repo.{{$EntityName}}DomainToRepositorySorter(ctx, domainSorter)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = tx.Commit()
-    if err != nil {
+    orderByClauses, ok := repositorySorter.([]string)
+    if !ok {
+        err = fmt.Errorf("expected type []string but got %T", repositorySorter)
+
 repo.Logger.Error(err)
 
 return
     }
 
-    record , err =repo.{{$EntityName}}RepositoryToDomainModel(ctx, repositoryModel)
+    queryMods = append(queryMods, qm.OrderBy(strings.Join(orderByClauses, ", ")))
 
-    return
-}
+    if limiter != nil {
+        if limiter.Limit < 0 || limiter.Offset < 0 {
+            err = repoCommon.InvalidLimiterError{Limiter: *limiter}
+
+repo.Logger.Error(err)
+
+return
+        }
+
+        if limiter.Limit > 0 {
+            queryMods = append(queryMods, qm.Limit(int(limiter.Limit)))
+        } else if limiter.Offset > 0 {
+            // Most DBMS' do not support an offset without a limit
+            queryMods = append(queryMods, qm.Limit(math.MaxInt32))
+        }
+
+        if limiter.Offset > 0 {
+            queryMods = append(queryMods, qm.Offset(int(limiter.Offset)))
+        }
+    }
 
-func (repo *{{$StructName}}) GetAll(ctx context.Context) (records []*domain.{{$EntityName}}, err error) {
     var repositoryModels {{$EntityName}}Slice
-    repositoryModels, err = {{$EntityName}}s().All(ctx, repo.db)
+    repositoryModels, err = {{$EntityName}}s(queryMods...).All(ctx, repo.executor(ctx))
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
+
     if len(repositoryModels) == 0 {
-        err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
+    err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
 
 repo.Logger.Error(err)
 
 return
     }
 
-    tx, err := repo.db.BeginTx(ctx, nil)
+    tx, err := repoCommon.BeginTx(ctx, repo.db)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1368,15 +1957,16 @@ return
     }
 
     for _, repoModel := range repositoryModels {
-        err = repo.LoadEntityRelations(ctx, tx, repoModel)
+        err = repo.loadSelectedEntityRelations(ctx, tx, repoModel, selection)
         if err != nil {
 repo.Logger.Error(err)
+repoCommon.RollbackTx(ctx, tx)
 
 return
         }
     }
 
-    err = tx.Commit()
+    err = repoCommon.CommitTx(ctx, tx)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1423,10 +2013,15 @@ return
 
 
 
-	queryFilters := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	queryFilters, err := buildQueryModListFromFilter{{$EntityName}}(repoFilter)
+	if err != nil {
+	    repo.Logger.Error(err)
+
+	    return
+	}
 
     var repositoryModels {{$EntityName}}Slice
-    repositoryModels, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.db)
+    repositoryModels, err = {{$EntityName}}s(queryFilters...).All(ctx, repo.executor(ctx))
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1441,7 +2036,7 @@ repo.Logger.Error(err)
 return
     }
 
-    tx, err := repo.db.BeginTx(ctx, nil)
+    tx, err := repoCommon.BeginTx(ctx, repo.db)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1457,7 +2052,7 @@ return
         }
     }
 
-    err = tx.Commit()
+    err = repoCommon.CommitTx(ctx, tx)
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1496,7 +2091,7 @@ return
     for _, type_ := range types {
         repositoryModel := {{$EntityName}}Type{{"{"}}{{$EntityName}}Type: type_}
 
-        err = repositoryModel.Insert(ctx, repo.db, boil.Infer())
+        err = repositoryModel.Insert(ctx, repo.executor(ctx), boil.Infer())
         if err != nil {
             if strings.Contains(err.Error(), "UNIQUE") {
                 err = helper.DuplicateInsertionError{Inner: err}
@@ -1524,7 +2119,7 @@ return
 
     var numAffectedRecords int64
 
-    numAffectedRecords, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.IN(types)).DeleteAll(ctx, repo.db)
+    numAffectedRecords, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.IN(types)).DeleteAll(ctx, repo.executor(ctx))
     if numAffectedRecords == 0 {
         err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
 
@@ -1538,7 +2133,7 @@ return
 
 func (repo *{{$StructName}}) UpdateType(ctx context.Context, oldType string, newType string)  (err error){
     var repositoryModel *{{$EntityName}}Type
-    repositoryModel, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(oldType)).One(ctx, repo.db)
+    repositoryModel, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(oldType)).One(ctx, repo.executor(ctx))
     if err != nil {
         if  errors.Is(err, sql.ErrNoRows){
             err = helper.IneffectiveOperationError{Inner: helper.NonExistentPrimaryDataError{}}
@@ -1552,7 +2147,7 @@ return
     repositoryModel.{{$EntityName}}Type = newType
 
     var numAffectedRecords int64
-    numAffectedRecords, err = repositoryModel.Update(ctx, repo.db, boil.Infer())
+    numAffectedRecords, err = repositoryModel.Update(ctx, repo.executor(ctx), boil.Infer())
     if err != nil {
         if strings.Contains(err.Error(), "UNIQUE") {
             err = helper.DuplicateInsertionError{Inner: err}
@@ -1565,7 +2160,7 @@ return
 
     if numAffectedRecords == 0 {
         var doesExist bool
-        doesExist, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(oldType)).Exists(ctx, repo.db)
+        doesExist, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(oldType)).Exists(ctx, repo.executor(ctx))
         if err != nil {
             repo.Logger.Error(err)
 
@@ -1586,7 +2181,7 @@ return
 
 func (repo *{{$StructName}}) GetAllTypes(ctx context.Context) (records []string, err error) {
     var repositoryModels []*{{$EntityName}}Type
-    repositoryModels, err = {{$EntityName}}Types().All(ctx, repo.db)
+    repositoryModels, err = {{$EntityName}}Types().All(ctx, repo.executor(ctx))
     if err != nil {
 repo.Logger.Error(err)
 
@@ -1713,7 +2308,7 @@ return
 
     if domainModel.TagIDs != nil {
         for _,  domainTagID := range domainModel.TagIDs {
-            repositoryTag, err = Tags(TagWhere.ID.EQ(domainTagID)).One(ctx, repo.db)
+            repositoryTag, err = Tags(TagWhere.ID.EQ(domainTagID)).One(ctx, repo.executor(ctx))
             if err != nil {
                 err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1732,7 +2327,7 @@ return
         var repository{{$EntityName}}Type *{{$EntityName}}Type
 
         repositoryModelConcrete.R.{{$EntityName}}Type = &{{$EntityName}}Type{{"{"}}{{$EntityName}}Type: domainModel.{{$EntityName}}Type.Wrappee}
-		repository{{$EntityName}}Type, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(domainModel.{{$EntityName}}Type.Wrappee)).One(ctx, repo.db)
+		repository{{$EntityName}}Type, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(domainModel.{{$EntityName}}Type.Wrappee)).One(ctx, repo.executor(ctx))
 		if err != nil {
             err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1828,18 +2423,23 @@ func (repo *{{$StructName}}) {{$EntityName}}RepositoryToDomainModel(ctx context.
 
     //**********************    Set Timestamps    **********************//
     {{ if eq .DatabaseName "sqlite3"}}
-    domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-    if err != nil {
+    // Timestamps are empty if they were not selected
+    if repositoryModelConcrete.CreatedAt != "" {
+        domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
-    domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-    if err != nil {
+    if repositoryModelConcrete.UpdatedAt != "" {
+        domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
     if repositoryModelConcrete.DeletedAt.Valid {
@@ -1917,7 +2517,7 @@ return
     var repositoryTag *Tag
 
 	for _, modelTagID := range domainModel.TagIDs {
-		repositoryTag, err = Tags(TagWhere.ID.EQ(modelTagID)).One(ctx, repo.db)
+		repositoryTag, err = Tags(TagWhere.ID.EQ(modelTagID)).One(ctx, repo.executor(ctx))
 		if err != nil {
             err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1932,7 +2532,7 @@ return
 
 	if domainModel.{{$EntityName}}Type.HasValue {
         repositoryModelConcrete.R.{{$EntityName}}Type = &{{$EntityName}}Type{{"{"}}{{$EntityName}}Type: domainModel.{{$EntityName}}Type.Wrappee}
-		repository{{$EntityName}}Type, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(domainModel.{{$EntityName}}Type.Wrappee)).One(ctx, repo.db)
+		repository{{$EntityName}}Type, err = {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(domainModel.{{$EntityName}}Type.Wrappee)).One(ctx, repo.executor(ctx))
 		if err != nil {
             err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1952,7 +2552,7 @@ return
     var repository{{$EntityName}}Raw any
 
     for _ , link := range domainModel.Linked{{$EntityName}}IDs {
-        repository{{$EntityName}}Raw, err = Documents(DocumentWhere.ID.EQ(link)).One(ctx, repo.db)
+        repository{{$EntityName}}Raw, err = Documents(DocumentWhere.ID.EQ(link)).One(ctx, repo.executor(ctx))
         if err != nil {
             err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -1965,7 +2565,7 @@ return
     }
 
     for _ , backlink := range domainModel.Backlinked{{$EntityName}}sIDs {
-        repository{{$EntityName}}Raw, err = Documents(DocumentWhere.ID.EQ(backlink)).One(ctx, repo.db)
+        repository{{$EntityName}}Raw, err = Documents(DocumentWhere.ID.EQ(backlink)).One(ctx, repo.executor(ctx))
         if err != nil {
             err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2039,18 +2639,23 @@ func (repo *{{$StructName}}) {{$EntityName}}RepositoryToDomainModel(ctx context.
 
     //**********************    Set Timestamps    **********************//
     {{ if eq .DatabaseName "sqlite3"}}
-    domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-    if err != nil {
+    // Timestamps are empty if they were not selected
+    if repositoryModelConcrete.CreatedAt != "" {
+        domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
-    domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-    if err != nil {
+    if repositoryModelConcrete.UpdatedAt != "" {
+        domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
     var t time.Time
@@ -2106,7 +2711,7 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryModel(ctx context.
 		var repositoryParentTag *Tag
 
 		domainParentTagID := domainModel.ParentPathIDs[len(domainModel.ParentPathIDs)-1]
-		repositoryParentTag, err = Tags(TagWhere.ID.EQ(domainParentTagID)).One(ctx, repo.db)
+		repositoryParentTag, err = Tags(TagWhere.ID.EQ(domainParentTagID)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2120,7 +2725,7 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryModel(ctx context.
 	if len(domainModel.ParentPathIDs) > 0 {
 		var repositoryParentTag *Tag
 		for _, tagID := range domainModel.ParentPathIDs[:len(domainModel.ParentPathIDs)] {
-			repositoryParentTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)
+			repositoryParentTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2137,7 +2742,7 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryModel(ctx context.
 	if len(domainModel.SubtagIDs) > 0 {
 		var repositoryChildTag *Tag
 		for _, tagID := range domainModel.SubtagIDs[:len(domainModel.SubtagIDs)-1] {
-			repositoryChildTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)
+			repositoryChildTag, err = Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.executor(ctx))
 			if err != nil {
 				err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2147,7 +2752,7 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryModel(ctx context.
 			repositoryModelConcrete.Children += strconv.FormatInt(repositoryChildTag.ID, 10) + ";"
 		}
 		lastChildID := domainModel.SubtagIDs[len(domainModel.SubtagIDs)-1]
-		repositoryChildTag, err = Tags(TagWhere.ID.EQ(lastChildID)).One(ctx, repo.db)
+		repositoryChildTag, err = Tags(TagWhere.ID.EQ(lastChildID)).One(ctx, repo.executor(ctx))
 		if err != nil {
 			err = repoCommon.ReferenceToNonExistentDependencyError{Inner: err}
 
@@ -2401,18 +3006,23 @@ func (repo *{{$StructName}}) {{$EntityName}}RepositoryToDomainModelTx(ctx contex
 
     //**********************    Set Timestamps    **********************//
     {{ if eq .DatabaseName "sqlite3"}}
-    domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-    if err != nil {
+    // Timestamps are empty if they were not selected
+    if repositoryModelConcrete.CreatedAt != "" {
+        domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
-    domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-    if err != nil {
+    if repositoryModelConcrete.UpdatedAt != "" {
+        domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
     if repositoryModelConcrete.DeletedAt.Valid {
@@ -2612,18 +3222,23 @@ func (repo *{{$StructName}}) {{$EntityName}}RepositoryToDomainModelTx(ctx contex
 
     //**********************    Set Timestamps    **********************//
     {{ if eq .DatabaseName "sqlite3"}}
-    domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
-    if err != nil {
+    // Timestamps are empty if they were not selected
+    if repositoryModelConcrete.CreatedAt != "" {
+        domainModel.CreatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.CreatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
-    domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
-    if err != nil {
+    if repositoryModelConcrete.UpdatedAt != "" {
+        domainModel.UpdatedAt, err = time.Parse(helper.DateFormat, repositoryModelConcrete.UpdatedAt)
+        if err != nil {
 repo.Logger.Error(err)
 
 return
+        }
     }
 
     var t time.Time
@@ -2917,7 +3532,8 @@ return
     if domainFilter.TagIDs.HasValue {
         var convertedFilter model.FilterOperation[*Tag]
 
-        converter := func (tagID int64) (*Tag, error) {return Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)}
+        // Only the IDs are needed to filter through the join tables
+        converter := func (tagID int64) (*Tag, error) {return &Tag{ID: tagID}, nil}
         convertedFilter, err = model.ConvertFilter[*Tag, int64](domainFilter.TagIDs.Wrappee, converter)
         if err != nil {
 repo.Logger.Error(err)
@@ -2938,7 +3554,7 @@ return
             }
 
 
-            bookmarkType, err := {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(type_.Wrappee)).One(ctx, repo.db)
+            bookmarkType, err := {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(type_.Wrappee)).One(ctx, repo.executor(ctx))
 
             return null.NewInt64(bookmarkType.ID, true), err
         })
@@ -2952,6 +3568,11 @@ return
         repositoryFilterConcrete.{{$EntityName}}TypeID.Set(convertedTypeIDFilter)
     }
 
+    err = repo.{{$EntityName}}DomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+    if err != nil {
+        return
+    }
+
     repositoryFilter = repositoryFilterConcrete
 
     return
@@ -3026,7 +3647,8 @@ return
     if domainFilter.TagIDs.HasValue {
         var convertedFilter model.FilterOperation[*Tag]
 
-        converter := func (tagID int64) (*Tag, error) {return Tags(TagWhere.ID.EQ(tagID)).One(ctx, repo.db)}
+        // Only the IDs are needed to filter through the join tables
+        converter := func (tagID int64) (*Tag, error) {return &Tag{ID: tagID}, nil}
         convertedFilter, err = model.ConvertFilter[*Tag, int64](domainFilter.TagIDs.Wrappee, converter)
         if err != nil {
 repo.Logger.Error(err)
@@ -3047,7 +3669,7 @@ return
             }
 
 
-            bookmarkType, err := {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(type_.Wrappee)).One(ctx, repo.db)
+            bookmarkType, err := {{$EntityName}}Types({{$EntityName}}TypeWhere.{{$EntityName}}Type.EQ(type_.Wrappee)).One(ctx, repo.executor(ctx))
 
             return null.NewInt64(bookmarkType.ID, true), err
         })
@@ -3066,7 +3688,7 @@ return
     if domainFilter.Linked{{$EntityName}}IDs.HasValue {
         var convertedFilter model.FilterOperation[*{{$EntityName}}]
 
-        converter := func (documentID int64) (*Document, error) {return Documents(DocumentWhere.ID.EQ(documentID)).One(ctx, repo.db)}
+        converter := func (documentID int64) (*Document, error) {return &Document{ID: documentID}, nil}
         convertedFilter, err = model.ConvertFilter[*{{$EntityName}},int64](domainFilter.Linked{{$EntityName}}IDs.Wrappee, converter)
         if err != nil {
 repo.Logger.Error(err)
@@ -3074,12 +3696,12 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryFilterConcrete.Source{{$EntityName}}s.Set(convertedFilter)
+        repositoryFilterConcrete.Destination{{$EntityName}}s.Set(convertedFilter)
     }
     if domainFilter.Backlinked{{$EntityName}}sIDs.HasValue {
         var convertedFilter model.FilterOperation[*{{$EntityName}}]
 
-        converter := func (documentID int64) (*Document, error) {return Documents(DocumentWhere.ID.EQ(documentID)).One(ctx, repo.db)}
+        converter := func (documentID int64) (*Document, error) {return &Document{ID: documentID}, nil}
         convertedFilter, err = model.ConvertFilter[*{{$EntityName}},int64](domainFilter.Backlinked{{$EntityName}}sIDs.Wrappee, converter)
         if err != nil {
 repo.Logger.Error(err)
@@ -3087,7 +3709,12 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryFilterConcrete.Destination{{$EntityName}}s.Set(convertedFilter)
+        repositoryFilterConcrete.Source{{$EntityName}}s.Set(convertedFilter)
+    }
+
+    err = repo.{{$EntityName}}DomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+    if err != nil {
+        return
     }
 
     repositoryFilter = repositoryFilterConcrete
@@ -3126,11 +3753,65 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryFilter(ctx context
 		repositoryFilterConcrete.Children.Set(convertedFilter)
 	}
 
+    err = repo.{{$EntityName}}DomainToRepositoryComposedFilters(ctx, domainFilter, repositoryFilterConcrete)
+    if err != nil {
+        return
+    }
+
     repositoryFilter = repositoryFilterConcrete
 
 	return
 }
 {{end}}
+
+// {{$EntityName}}DomainToRepositoryComposedFilters converts the And, Or and Not subfilters of domainFilter into repositoryFilter.
+func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryComposedFilters(ctx context.Context, domainFilter *domain.{{$EntityName}}Filter, repositoryFilter *{{$EntityName}}Filter) (err error)  {
+    convertSubfilter := func(domainSubfilter *domain.{{$EntityName}}Filter) (*{{$EntityName}}Filter, error) {
+        if domainSubfilter == nil {
+            err := helper.NilInputError{}
+            repo.Logger.Error(err)
+
+            return nil, err
+        }
+
+        repositorySubfilter, err := repo.{{$EntityName}}DomainToRepositoryFilter(ctx, domainSubfilter)
+        if err != nil {
+            return nil, err
+        }
+
+        return repositorySubfilter.(*{{$EntityName}}Filter), nil
+    }
+
+    repositoryFilter.And = make([]*{{$EntityName}}Filter, 0, len(domainFilter.And))
+    for _, domainSubfilter := range domainFilter.And {
+        var repositorySubfilter *{{$EntityName}}Filter
+
+        repositorySubfilter, err = convertSubfilter(domainSubfilter)
+        if err != nil {
+            return
+        }
+
+        repositoryFilter.And = append(repositoryFilter.And, repositorySubfilter)
+    }
+
+    repositoryFilter.Or = make([]*{{$EntityName}}Filter, 0, len(domainFilter.Or))
+    for _, domainSubfilter := range domainFilter.Or {
+        var repositorySubfilter *{{$EntityName}}Filter
+
+        repositorySubfilter, err = convertSubfilter(domainSubfilter)
+        if err != nil {
+            return
+        }
+
+        repositoryFilter.Or = append(repositoryFilter.Or, repositorySubfilter)
+    }
+
+    if domainFilter.Not != nil {
+        repositoryFilter.Not, err = convertSubfilter(domainFilter.Not)
+    }
+
+    return
+}
 //******************************************************************//
 //                         Updater Converter                        //
 //******************************************************************//
@@ -3180,7 +3861,7 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedUpdater})
+        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedUpdater})
         {{ else }}
         var convertedTime null.Time
         convertedTime, err = repoCommon.OptionalTimeToNullTime(domainUpdater.DeletedAt.Wrappee.Operand)
@@ -3190,7 +3871,7 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedTime})
+        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedTime})
         {{ end }}
     }
 
@@ -3215,7 +3896,7 @@ return
         convertedUpdater := make(TagSlice, 0, len(domainUpdater.TagIDs.Wrappee.Operand))
 
         for _, tag := range domainUpdater.TagIDs.Wrappee.Operand {
-            rawTag, err =  Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.db)
+            rawTag, err =  Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.executor(ctx))
             if err != nil {
 repo.Logger.Error(err)
 
@@ -3325,7 +4006,7 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedUpdater})
+        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.String]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedUpdater})
         {{ else }}
         var convertedTime null.Time
         convertedTime, err = repoCommon.OptionalTimeToNullTime(domainUpdater.DeletedAt.Wrappee.Operand)
@@ -3335,7 +4016,7 @@ repo.Logger.Error(err)
 return
         }
 
-        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.UpdatedAt.Wrappee.Operator, Operand: convertedTime})
+        repositoryUpdaterConcrete.DeletedAt.Set(model.UpdateOperation[null.Time]{Operator: domainUpdater.DeletedAt.Wrappee.Operator, Operand: convertedTime})
         {{ end }}
     }
 
@@ -3344,7 +4025,7 @@ return
         convertedUpdater := make(TagSlice, 0, len(domainUpdater.TagIDs.Wrappee.Operand))
 
         for _, tag := range domainUpdater.TagIDs.Wrappee.Operand {
-            rawTag, err =  Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.db)
+            rawTag, err =  Tags(TagWhere.ID.EQ(tag)).One(ctx, repo.executor(ctx))
             if err != nil {
 repo.Logger.Error(err)
 
@@ -3362,7 +4043,7 @@ return
         convertedUpdater := make({{$EntityName}}Slice, 0, len(domainUpdater.Linked{{$EntityName}}IDs.Wrappee.Operand))
 
         for _, document := range domainUpdater.Linked{{$EntityName}}IDs.Wrappee.Operand {
-            converted{{$EntityName}}Raw, err =  Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.db)
+            converted{{$EntityName}}Raw, err =  Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.executor(ctx))
             if err != nil {
 repo.Logger.Error(err)
 
@@ -3380,7 +4061,7 @@ return
         convertedUpdater := make({{$EntityName}}Slice, 0, len(domainUpdater.Backlinked{{$EntityName}}sIDs.Wrappee.Operand))
 
         for _, document := range domainUpdater.Backlinked{{$EntityName}}sIDs.Wrappee.Operand {
-            converted{{$EntityName}}Raw, err =  Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.db)
+            converted{{$EntityName}}Raw, err =  Documents(DocumentWhere.ID.EQ(document)).One(ctx, repo.executor(ctx))
             if err != nil {
 repo.Logger.Error(err)
 
@@ -3449,6 +4130,272 @@ func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryUpdater(ctx contex
 {{end}}
 
 
+//******************************************************************//
+//                         Sorter Converter                         //
+//******************************************************************//
+{{if eq $EntityName "Bookmark"}}
+var {{LowercaseBeginning $EntityName}}SortExpressions = map[domain.{{$EntityName}}Field]string{
+    "CreatedAt":    {{$EntityName}}TableColumns.CreatedAt,
+    "UpdatedAt":    {{$EntityName}}TableColumns.UpdatedAt,
+    "DeletedAt":    {{$EntityName}}TableColumns.DeletedAt,
+    "URL":          {{$EntityName}}TableColumns.URL,
+    "Title":        {{$EntityName}}TableColumns.Title,
+    "ID":           {{$EntityName}}TableColumns.ID,
+    "IsCollection": {{$EntityName}}TableColumns.IsCollection,
+    "IsRead":       {{$EntityName}}TableColumns.IsRead,
+    "BookmarkType": "(SELECT " + BookmarkTypeTableColumns.BookmarkType + " FROM " + TableNames.BookmarkTypes + " WHERE " + BookmarkTypeTableColumns.ID + " = " + {{$EntityName}}TableColumns.BookmarkTypeID + ")",
+}
+{{end}}
+{{if eq $EntityName "Document"}}
+var {{LowercaseBeginning $EntityName}}SortExpressions = map[domain.{{$EntityName}}Field]string{
+    "CreatedAt":    {{$EntityName}}TableColumns.CreatedAt,
+    "UpdatedAt":    {{$EntityName}}TableColumns.UpdatedAt,
+    "DeletedAt":    {{$EntityName}}TableColumns.DeletedAt,
+    "Path":         {{$EntityName}}TableColumns.Path,
+    "ID":           {{$EntityName}}TableColumns.ID,
+    "DocumentType": "(SELECT " + DocumentTypeTableColumns.DocumentType + " FROM " + TableNames.DocumentTypes + " WHERE " + DocumentTypeTableColumns.ID + " = " + {{$EntityName}}TableColumns.DocumentTypeID + ")",
+}
+{{end}}
+{{if eq $EntityName "Tag"}}
+var {{LowercaseBeginning $EntityName}}SortExpressions = map[domain.{{$EntityName}}Field]string{
+    "Tag": {{$EntityName}}TableColumns.Tag,
+    "ID":  {{$EntityName}}TableColumns.ID,
+}
+{{end}}
+
+func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositorySorter(ctx context.Context, domainSorter domain.{{$EntityName}}Sorter) (repositorySorter any, err error)  {
+    orderByClauses := make([]string, 0, len(domainSorter)+1)
+    isSortedByID := false
+
+    for _, sortKey := range domainSorter {
+        expression, ok := {{LowercaseBeginning $EntityName}}SortExpressions[sortKey.Field]
+        if !ok {
+            err = repoCommon.UnsortableFieldError{Field: string(sortKey.Field)}
+
+            return
+        }
+
+        isSortedByID = isSortedByID || sortKey.Field == "ID"
+
+        if sortKey.Direction == model.SortDescending {
+            orderByClauses = append(orderByClauses, expression+" DESC")
+        } else {
+            orderByClauses = append(orderByClauses, expression+" ASC")
+        }
+    }
+
+    // Breaking ties by the primary key gives a total order, which is needed for stable pagination
+    if !isSortedByID {
+        orderByClauses = append(orderByClauses, {{$EntityName}}TableColumns.ID+" ASC")
+    }
+
+    repositorySorter = orderByClauses
+
+	return
+}
+
+
+//******************************************************************//
+//                     Member Selector Converter                    //
+//******************************************************************//
+
+// {{$EntityName}}Selection holds the columns and relations to load for a domain.{{$EntityName}}MemberSelector.
+type {{$EntityName}}Selection struct {
+    Columns   []string
+    Relations []string
+}
+{{if eq $EntityName "Bookmark"}}
+var {{LowercaseBeginning $EntityName}}MemberSelections = map[domain.{{$EntityName}}Field]{{$EntityName}}Selection{
+    "CreatedAt":    {Columns: []string{ {{$EntityName}}TableColumns.CreatedAt }},
+    "UpdatedAt":    {Columns: []string{ {{$EntityName}}TableColumns.UpdatedAt }},
+    "DeletedAt":    {Columns: []string{ {{$EntityName}}TableColumns.DeletedAt }},
+    "URL":          {Columns: []string{ {{$EntityName}}TableColumns.URL }},
+    "Title":        {Columns: []string{ {{$EntityName}}TableColumns.Title }},
+    "ID":           {Columns: []string{ {{$EntityName}}TableColumns.ID }},
+    "IsCollection": {Columns: []string{ {{$EntityName}}TableColumns.IsCollection }},
+    "IsRead":       {Columns: []string{ {{$EntityName}}TableColumns.IsRead }},
+    "TagIDs":       {Relations: []string{ {{$EntityName}}Rels.Tags }},
+    "BookmarkType": {Columns: []string{ {{$EntityName}}TableColumns.BookmarkTypeID }, Relations: []string{ {{$EntityName}}Rels.BookmarkType }},
+}
+{{end}}
+{{if eq $EntityName "Document"}}
+var {{LowercaseBeginning $EntityName}}MemberSelections = map[domain.{{$EntityName}}Field]{{$EntityName}}Selection{
+    "CreatedAt":              {Columns: []string{ {{$EntityName}}TableColumns.CreatedAt }},
+    "UpdatedAt":              {Columns: []string{ {{$EntityName}}TableColumns.UpdatedAt }},
+    "DeletedAt":              {Columns: []string{ {{$EntityName}}TableColumns.DeletedAt }},
+    "Path":                   {Columns: []string{ {{$EntityName}}TableColumns.Path }},
+    "ID":                     {Columns: []string{ {{$EntityName}}TableColumns.ID }},
+    "TagIDs":                 {Relations: []string{ {{$EntityName}}Rels.Tags }},
+    "LinkedDocumentIDs":      {Relations: []string{ {{$EntityName}}Rels.DestinationDocuments }},
+    "BacklinkedDocumentsIDs": {Relations: []string{ {{$EntityName}}Rels.SourceDocuments }},
+    "DocumentType":           {Columns: []string{ {{$EntityName}}TableColumns.DocumentTypeID }, Relations: []string{ {{$EntityName}}Rels.DocumentType }},
+}
+{{end}}
+{{if eq $EntityName "Tag"}}
+var {{LowercaseBeginning $EntityName}}MemberSelections = map[domain.{{$EntityName}}Field]{{$EntityName}}Selection{
+    "Tag":           {Columns: []string{ {{$EntityName}}TableColumns.Tag }},
+    "ID":            {Columns: []string{ {{$EntityName}}TableColumns.ID }},
+    "ParentPathIDs": {Columns: []string{ {{$EntityName}}TableColumns.Path }},
+    "SubtagIDs":     {Columns: []string{ {{$EntityName}}TableColumns.Children }},
+}
+{{end}}
+
+func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryMemberSelector(ctx context.Context, domainSelector domain.{{$EntityName}}MemberSelector) (repositorySelector any, err error)  {
+    if len(domainSelector) == 0 {
+        repositorySelector = (*{{$EntityName}}Selection)(nil)
+
+        return
+    }
+
+    // The primary key is always needed to load relations
+    selection := &{{$EntityName}}Selection{Columns: []string{ {{$EntityName}}TableColumns.ID }}
+    isSelected := map[string]bool{ {{$EntityName}}TableColumns.ID: true}
+
+    for _, field := range domainSelector {
+        fieldSelection, ok := {{LowercaseBeginning $EntityName}}MemberSelections[field]
+        if !ok {
+            err = repoCommon.UnselectableFieldError{Field: string(field)}
+
+            return
+        }
+
+        for _, column := range fieldSelection.Columns {
+            if !isSelected[column] {
+                isSelected[column] = true
+                selection.Columns = append(selection.Columns, column)
+            }
+        }
+
+        for _, relation := range fieldSelection.Relations {
+            if !isSelected[relation] {
+                isSelected[relation] = true
+                selection.Relations = append(selection.Relations, relation)
+            }
+        }
+    }
+
+    repositorySelector = selection
+
+	return
+}
+
+//******************************************************************//
+//                         Grouper Converter                        //
+//******************************************************************//
+
+// {{$EntityName}}Grouping holds the SQL expressions needed to group by a domain.{{$EntityName}}Grouper.
+// Key is selected as the groups' key and must be functionally dependent on GroupBy,
+// Join is left joined to the {{LowercaseBeginning $EntityName}}s if set.
+type {{$EntityName}}Grouping struct {
+    GroupBy string
+    Key     string
+    Join    string
+}
+{{if eq $EntityName "Bookmark"}}
+var {{LowercaseBeginning $EntityName}}Groupings = map[domain.{{$EntityName}}Field]{{$EntityName}}Grouping{
+    "IsCollection": {GroupBy: {{$EntityName}}TableColumns.IsCollection},
+    "IsRead":       {GroupBy: {{$EntityName}}TableColumns.IsRead},
+    "TagIDs":       {GroupBy: TableNames.BookmarkContexts + ".tag_id", Join: TableNames.BookmarkContexts + " ON " + TableNames.BookmarkContexts + ".bookmark_id = " + {{$EntityName}}TableColumns.ID},
+    "BookmarkType": {GroupBy: {{$EntityName}}TableColumns.BookmarkTypeID, Key: "(SELECT " + BookmarkTypeTableColumns.BookmarkType + " FROM " + TableNames.BookmarkTypes + " WHERE " + BookmarkTypeTableColumns.ID + " = " + {{$EntityName}}TableColumns.BookmarkTypeID + ")"},
+}
+{{end}}
+{{if eq $EntityName "Document"}}
+var {{LowercaseBeginning $EntityName}}Groupings = map[domain.{{$EntityName}}Field]{{$EntityName}}Grouping{
+    "TagIDs":       {GroupBy: TableNames.DocumentContexts + ".tag_id", Join: TableNames.DocumentContexts + " ON " + TableNames.DocumentContexts + ".document_id = " + {{$EntityName}}TableColumns.ID},
+    "DocumentType": {GroupBy: {{$EntityName}}TableColumns.DocumentTypeID, Key: "(SELECT " + DocumentTypeTableColumns.DocumentType + " FROM " + TableNames.DocumentTypes + " WHERE " + DocumentTypeTableColumns.ID + " = " + {{$EntityName}}TableColumns.DocumentTypeID + ")"},
+}
+{{end}}
+{{if eq $EntityName "Tag"}}
+// Tags are grouped by their direct parent, not their whole path
+var {{LowercaseBeginning $EntityName}}Groupings = map[domain.{{$EntityName}}Field]{{$EntityName}}Grouping{
+    "ParentPathIDs": {GroupBy: {{$EntityName}}TableColumns.ParentTag},
+}
+{{end}}
+{{if ne $EntityName "Tag"}}
+var {{LowercaseBeginning $EntityName}}TimestampColumns = map[domain.{{$EntityName}}Field]string{
+    "CreatedAt": {{$EntityName}}TableColumns.CreatedAt,
+    "UpdatedAt": {{$EntityName}}TableColumns.UpdatedAt,
+    "DeletedAt": {{$EntityName}}TableColumns.DeletedAt,
+}
+
+func {{LowercaseBeginning $EntityName}}TimeBucketExpression(column string, bucket model.TimeBucket) (expression string, ok bool) {
+    switch bucket {
+{{- if eq .DatabaseName "sqlite3"}}
+    case 0, model.BucketDay:
+        return "date(" + column + ")", true
+    case model.BucketWeek:
+        return "date(" + column + ", '-6 days', 'weekday 1')", true
+    case model.BucketMonth:
+        return "strftime('%Y-%m-01', " + column + ")", true
+    case model.BucketYear:
+        return "strftime('%Y-01-01', " + column + ")", true
+{{- else if eq .DatabaseName "psql"}}
+    case 0, model.BucketDay:
+        return "to_char(date_trunc('day', " + column + "), 'YYYY-MM-DD')", true
+    case model.BucketWeek:
+        return "to_char(date_trunc('week', " + column + "), 'YYYY-MM-DD')", true
+    case model.BucketMonth:
+        return "to_char(date_trunc('month', " + column + "), 'YYYY-MM-DD')", true
+    case model.BucketYear:
+        return "to_char(date_trunc('year', " + column + "), 'YYYY-MM-DD')", true
+{{- else}}
+    case 0, model.BucketDay:
+        return "CONVERT(varchar(10), " + column + ", 23)", true
+    case model.BucketWeek:
+        // Day 0 is a monday
+        return "CONVERT(varchar(10), DATEADD(day, DATEDIFF(day, 0, " + column + ") / 7 * 7, 0), 23)", true
+    case model.BucketMonth:
+        return "CONVERT(varchar(10), DATEADD(month, DATEDIFF(month, 0, " + column + "), 0), 23)", true
+    case model.BucketYear:
+        return "CONVERT(varchar(10), DATEADD(year, DATEDIFF(year, 0, " + column + "), 0), 23)", true
+{{- end}}
+    default:
+        return "", false
+    }
+}
+{{end}}
+
+func (repo *{{$StructName}}) {{$EntityName}}DomainToRepositoryGrouper(ctx context.Context, domainGrouper *domain.{{$EntityName}}Grouper) (repositoryGrouper any, err error)  {
+    if domainGrouper == nil {
+        err = helper.NilInputError{}
+
+        return
+    }
+
+    grouping := new({{$EntityName}}Grouping)
+{{if ne $EntityName "Tag"}}
+    if column, isTimestamp := {{LowercaseBeginning $EntityName}}TimestampColumns[domainGrouper.Field]; isTimestamp {
+        var ok bool
+
+        grouping.GroupBy, ok = {{LowercaseBeginning $EntityName}}TimeBucketExpression(column, domainGrouper.Bucket)
+        if !ok {
+            err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+            return
+        }
+    } else {
+{{- end}}
+        fieldGrouping, ok := {{LowercaseBeginning $EntityName}}Groupings[domainGrouper.Field]
+        if !ok || domainGrouper.Bucket != 0 {
+            err = repoCommon.UngroupableFieldError{Field: string(domainGrouper.Field), Bucket: domainGrouper.Bucket}
+
+            return
+        }
+
+        *grouping = fieldGrouping
+{{- if ne $EntityName "Tag"}}
+    }
+{{- end}}
+
+    if grouping.Key == "" {
+        grouping.Key = grouping.GroupBy
+    }
+
+    repositoryGrouper = grouping
+
+	return
+}
+
 func (repo *{{$StructName}}) UpdateRelatedEntities(ctx context.Context, tx *sql.Tx, repositoryModel *{{$EntityName}}) error  {
 	var err error
 
@@ -3529,51 +4476,91 @@ func (repo *{{$StructName}}) LoadEntityRelations(ctx context.Context, tx *sql.Tx
     }
 
 {{if eq $EntityName "Bookmark"}}
-    err = repoModel.L.LoadTags(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repoModel.L.LoadBookmarkType(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadBookmarkType(ctx, repo.executor(ctx), true, repoModel, nil)
 
     return
 {{else if eq $EntityName "Document"}}
-    err = repoModel.L.LoadDestinationDocuments(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadDestinationDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repoModel.L.LoadSourceDocuments(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadSourceDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repoModel.L.LoadTags(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repoModel.L.LoadDocumentType(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadDocumentType(ctx, repo.executor(ctx), true, repoModel, nil)
 
     return
 {{else if eq $EntityName "Tag"}}
-    err = repoModel.L.LoadParentTagTag(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadParentTagTag(ctx, repo.executor(ctx), true, repoModel, nil)
     if err != nil {
 repo.Logger.Error(err)
 
 return
     }
 
-    err = repoModel.L.LoadParentTagTags(ctx, repo.db, true, repoModel, nil)
+    err = repoModel.L.LoadParentTagTags(ctx, repo.executor(ctx), true, repoModel, nil)
 
     return
 {{end}}
 }
+
+// loadSelectedEntityRelations loads only the relations of selection, all of them if selection is nil.
+func (repo *{{$StructName}}) loadSelectedEntityRelations(ctx context.Context, tx *sql.Tx, repoModel *{{$EntityName}}, selection *{{$EntityName}}Selection) (err error) {
+    if selection == nil {
+        return repo.LoadEntityRelations(ctx, tx, repoModel)
+    }
+
+    if repoModel.R == nil {
+        repoModel.R = repoModel.R.NewStruct()
+    }
+{{if eq $EntityName "Tag"}}
+    // None of the tag's relations are part of the domain model
+{{else}}
+    for _, relation := range selection.Relations {
+        switch relation {
+{{- if eq $EntityName "Bookmark"}}
+        case {{$EntityName}}Rels.Tags:
+            err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
+        case {{$EntityName}}Rels.BookmarkType:
+            err = repoModel.L.LoadBookmarkType(ctx, repo.executor(ctx), true, repoModel, nil)
+{{- else if eq $EntityName "Document"}}
+        case {{$EntityName}}Rels.Tags:
+            err = repoModel.L.LoadTags(ctx, repo.executor(ctx), true, repoModel, nil)
+        case {{$EntityName}}Rels.DestinationDocuments:
+            err = repoModel.L.LoadDestinationDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
+        case {{$EntityName}}Rels.SourceDocuments:
+            err = repoModel.L.LoadSourceDocuments(ctx, repo.executor(ctx), true, repoModel, nil)
+        case {{$EntityName}}Rels.DocumentType:
+            err = repoModel.L.LoadDocumentType(ctx, repo.executor(ctx), true, repoModel, nil)
+{{- end}}
+        }
+        if err != nil {
+repo.Logger.Error(err)
+
+return
+        }
+    }
+{{end}}
+    return
+}
@@ -54,6 +54,12 @@ func (t *{{.StructName}}) IsDefault() bool {
 
 type {{.StructName}}Field string
 
+var {{.StructName}}FieldsList = []{{.StructName}}Field{
+    {{range $field := .StructFields -}}
+    {{$StructName}}Field("{{.FieldName}}"),
+    {{end}}
+}
+
 var {{.StructName}}Fields = struct {
     {{range $field := .StructFields -}}
     {{.FieldName}}  {{$StructName}}Field
@@ -79,6 +85,11 @@ type {{.StructName}}Filter struct {
     {{range $field := .StructFields -}}
     {{.FieldName}} optional.Optional[model.FilterOperation[{{Unslice (UnaliasSQLBoilerSlice .FieldType)}}]]`json:"{{LowercaseBeginning .FieldName}},omitempty" toml:"{{LowercaseBeginning .FieldName}},omitempty" yaml:"{{LowercaseBeginning .FieldName}},omitempty"`
     {{end}}
+
+    // And, Or and Not compose whole filters, they are combined with the field filters by AND.
+    And []*{{.StructName}}Filter `json:"and,omitempty" toml:"and,omitempty" yaml:"and,omitempty"`
+    Or  []*{{.StructName}}Filter `json:"or,omitempty" toml:"or,omitempty" yaml:"or,omitempty"`
+    Not *{{.StructName}}Filter   `json:"not,omitempty" toml:"not,omitempty" yaml:"not,omitempty"`
 }
 
 func (filter *{{.StructName}}Filter) IsDefault() bool {
@@ -88,6 +99,10 @@ func (filter *{{.StructName}}Filter) IsDefault() bool {
     }
     {{end}}
 
+    if len(filter.And) > 0 || len(filter.Or) > 0 || filter.Not != nil {
+        return false
+    }
+
     return true
 }
 
@@ -108,24 +123,25 @@ func (updater *{{.StructName}}Updater) IsDefault() bool {
     return true
 }
 
+// {{.StructName}}Sorter sorts by its keys in order, later keys break ties of earlier ones.
+type {{.StructName}}Sorter []model.SortKey[{{.StructName}}Field]
+
+// {{.StructName}}MemberSelector selects the fields to load, an empty selector selects all fields.
+type {{.StructName}}MemberSelector []{{.StructName}}Field
+
+// {{.StructName}}Grouper groups tags by one of their fields for aggregate queries.
+type {{.StructName}}Grouper model.GroupKey[{{.StructName}}Field]
+
 const (
     {{.StructName}}FilterLeaf = "{{.StructName}}FilterLeaf"
     {{.StructName}}FilterRoot = "{{.StructName}}FilterRoot"
 )
 
 var Predefined{{.StructName}}Filters = map[string]*{{.StructName}}Filter {
-    // FIX: This operating on int64s instead of the slice is nonsense, right?
-    {{.StructName}}FilterLeaf: {ParentPathIDs: optional.Make(model.FilterOperation[int64]{
-        Operand: model.ScalarOperand[int64]{
-            Operand: -1,
-        },
-        Operator: model.FilterEqual,
+    {{.StructName}}FilterLeaf: {SubtagIDs: optional.Make(model.FilterOperation[int64]{
+        Operator: model.FilterEmpty,
     })},
-    // FIX: This operating on int64s instead of the slice is nonsense, right?
-    {{.StructName}}FilterRoot: {SubtagIDs: optional.Make(model.FilterOperation[int64]{
-        Operand: model.ScalarOperand[int64]{
-            Operand: -1,
-        },
-        Operator: model.FilterEqual,
+    {{.StructName}}FilterRoot: {ParentPathIDs: optional.Make(model.FilterOperation[int64]{
+        Operator: model.FilterEmpty,
     })},
 }
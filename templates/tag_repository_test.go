@@ -102,6 +102,40 @@ func TestSQLTagRepositoryAddTest(t *testing.T) {
 	}
 }
 
+func TestSQLTagRepositoryAddGeneratedIDsTest(t *testing.T) {
+	db, err := testCommon.GetDB()
+	require.NoError(t, err, "db open")
+	defer db.Close()
+
+	repo := new(repository.Sqlite3TagRepository)
+
+	repoAbstract, err := repo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+	require.NoError(t, err, "repository creation")
+
+	repo = repoAbstract.(*repository.Sqlite3TagRepository)
+
+	// The models have no IDs, so the database generates them
+	models := []*domain.Tag{{Tag: "Programming"}}
+
+	err = repo.Add(context.Background(), models)
+	require.NoError(t, err, "adding models")
+
+	ids := make([]int64, 0, len(models))
+	for _, model := range models {
+		assert.NotZero(t, model.ID, "generated ID is written back")
+		assert.NotContains(t, ids, model.ID, "generated IDs are unique")
+
+		ids = append(ids, model.ID)
+	}
+
+	storedModels, err := repo.GetFromIDs(context.Background(), ids)
+	require.NoError(t, err, "getting added models by their generated IDs")
+
+	for i, storedModel := range storedModels {
+		assert.Equal(t, models[i].Tag, storedModel.Tag, "stored model matches added one")
+	}
+}
+
 func TestSQLTagRepositoryReplaceTest(t *testing.T) {
 	tests := []struct {
 		err            error
@@ -1164,6 +1198,36 @@ func TestSQLTagRepositoryGetWhereTest(t *testing.T) {
 		{
 			name: "Nil input", filter: nil, err: helper.NilInputError{},
 		},
+		{
+			name: "Set operator on scalar field", err: repositoryCommon.UnsupportedFilterOperationError{},
+
+			filter: &domain.TagFilter{
+				Tag: optional.Make(model.FilterOperation[string]{
+					Operator: model.FilterContains,
+					Operand:  model.ScalarOperand[string]{Operand: "Programming"},
+				}),
+			},
+		},
+		{
+			name: "Scalar operand for range operator", err: repositoryCommon.UnsupportedFilterOperationError{},
+
+			filter: &domain.TagFilter{
+				ID: optional.Make(model.FilterOperation[int64]{
+					Operator: model.FilterBetween,
+					Operand:  model.ScalarOperand[int64]{Operand: 1},
+				}),
+			},
+		},
+		{
+			name: "Recursive operator on non-hierarchical slice field", err: repositoryCommon.UnsupportedFilterOperationError{},
+
+			filter: &domain.TagFilter{
+				SubtagIDs: optional.Make(model.FilterOperation[int64]{
+					Operator: model.FilterContainsRecursive,
+					Operand:  model.ScalarOperand[int64]{Operand: 1},
+				}),
+			},
+		},
 		{
 			name: "Empty result", err: helper.IneffectiveOperationError{},
 
@@ -1285,6 +1349,97 @@ func TestSQLTagRepositoryGetWhereTest(t *testing.T) {
 	}
 }
 
+func TestSQLTagRepositoryGetWhereSetFilterTest(t *testing.T) {
+	models := []*domain.Tag{
+		{Tag: "foo", SubtagIDs: []int64{2}, ID: 1},
+		{Tag: "bar", ParentPathIDs: []int64{1}, SubtagIDs: []int64{3}, ID: 2},
+		{Tag: "baz", ParentPathIDs: []int64{1, 2}, ID: 3},
+		{Tag: "qux", ID: 4},
+	}
+
+	tests := []struct {
+		filter   *domain.TagFilter
+		name     string
+		expected []int64
+	}{
+		{
+			name: "Parent path contains root",
+			filter: &domain.TagFilter{ParentPathIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{2, 3},
+		},
+		{
+			name: "Parent path contains all",
+			filter: &domain.TagFilter{ParentPathIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContains,
+				Operand:  model.ListOperand[int64]{Operands: []int64{1, 2}},
+			})},
+			expected: []int64{3},
+		},
+		{
+			name: "Parent path contains none",
+			filter: &domain.TagFilter{ParentPathIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterNotContains,
+				Operand:  model.ScalarOperand[int64]{Operand: 1},
+			})},
+			expected: []int64{1, 4},
+		},
+		{
+			name: "Subtags contain any",
+			filter: &domain.TagFilter{SubtagIDs: optional.Make(model.FilterOperation[int64]{
+				Operator: model.FilterContainsAny,
+				Operand:  model.ListOperand[int64]{Operands: []int64{2, 3}},
+			})},
+			expected: []int64{1, 2},
+		},
+		{
+			name:     "Predefined leaf",
+			filter:   domain.PredefinedTagFilters[domain.TagFilterLeaf],
+			expected: []int64{3, 4},
+		},
+		{
+			name:     "Predefined root",
+			filter:   domain.PredefinedTagFilters[domain.TagFilterRoot],
+			expected: []int64{1, 4},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			defer testCommon.HandlePanic(t, test.name)
+
+			db, err := testCommon.GetDB()
+			require.NoErrorf(t, err, test.name+", db open")
+			defer db.Close()
+
+			repo := new(repository.Sqlite3TagRepository)
+
+			repoAbstract, err := repo.New(repository.Sqlite3TagRepositoryConstructorArgs{DB: db, Logger: log.StandardLogger()})
+
+			assert.NoErrorf(t, err, test.name)
+
+			repo = repoAbstract.(*repository.Sqlite3TagRepository)
+
+			err = repo.Add(context.Background(), models)
+			assert.NoErrorf(t, err, test.name)
+
+			records, err := repo.GetWhere(context.Background(), test.filter)
+			assert.NoErrorf(t, err, test.name)
+
+			ids := make([]int64, 0, len(records))
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+
+			assert.ElementsMatchf(t, test.expected, ids, test.name)
+		})
+	}
+}
+
 func TestSQLTagRepositoryGetFirstWhereTest(t *testing.T) {
 	tests := []struct {
 		err               error